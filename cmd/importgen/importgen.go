@@ -0,0 +1,264 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"google.golang.org/api/cloudasset/v1"
+
+	"github.com/hashicorp/terraform-provider-google/google/provider"
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+)
+
+// readResourceTypes returns the resource types of the provider by asset type,
+// e.g. compute.googleapis.com/Instance, from the *_meta.yaml files of the
+// services under root.
+func readResourceTypes(root string) (map[string][]string, error) {
+	resourceTypes := make(map[string][]string)
+	err := filepath.WalkDir(filepath.Join(root, "google", "services"), func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(path, "_meta.yaml") {
+			return err
+		}
+		meta, err := readMeta(path)
+		if err != nil {
+			return err
+		}
+		if meta["resource"] == "" || meta["api_service_name"] == "" || meta["api_resource_type_kind"] == "" {
+			return nil
+		}
+		assetType := meta["api_service_name"] + "/" + meta["api_resource_type_kind"]
+		resourceTypes[assetType] = append(resourceTypes[assetType], meta["resource"])
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, types := range resourceTypes {
+		sort.Strings(types)
+	}
+	return resourceTypes, nil
+}
+
+// readMeta returns the top-level string values of a *_meta.yaml file.
+func readMeta(path string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	meta := make(map[string]string)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, " ") || strings.HasPrefix(line, "-") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		meta[strings.TrimSpace(key)] = strings.Trim(strings.TrimSpace(value), `'"`)
+	}
+	return meta, scanner.Err()
+}
+
+// searchAllResources returns the resources in scope matching query and of
+// the given asset types, or all types if none are given.
+func searchAllResources(ctx context.Context, service *cloudasset.Service, scope, query string, assetTypes []string) ([]*cloudasset.ResourceSearchResult, error) {
+	call := service.V1.SearchAllResources(scope).Query(query)
+	if len(assetTypes) > 0 {
+		call = call.AssetTypes(assetTypes...)
+	}
+	var assets []*cloudasset.ResourceSearchResult
+	err := call.Pages(ctx, func(resp *cloudasset.SearchAllResourcesResponse) error {
+		assets = append(assets, resp.Results...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error searching resources: %s", err)
+	}
+	return assets, nil
+}
+
+// customImportIds build the import ids of resource types whose importers don't
+// use tpgresource.ParseImportId, or whose asset names don't match their import
+// formats, from the relative names of their assets.
+var customImportIds = map[string]func(name string) (string, bool){
+	// Buckets are named by their name only, and imported by it.
+	"google_storage_bucket": func(name string) (string, bool) {
+		return name, name != "" && !strings.Contains(name, "/")
+	},
+	// The billing info of a project is named projects/{{project}}/billingInfo
+	// but imported as projects/{{project}}.
+	"google_billing_project_info": func(name string) (string, bool) {
+		project, ok := strings.CutSuffix(name, "/billingInfo")
+		return project, ok && strings.HasPrefix(project, "projects/")
+	},
+}
+
+// importBlock is an `import` block of a discovered resource, or a comment on
+// why a resource can't be imported when ResourceType is empty.
+type importBlock struct {
+	Asset        *cloudasset.ResourceSearchResult
+	ResourceType string
+	Name         string
+	Id           string
+	Comment      string
+}
+
+// generateImports returns the import blocks of the given assets, sorted by
+// resource address, followed by the comments on the assets that can't be
+// imported.
+func generateImports(assets []*cloudasset.ResourceSearchResult, resourceTypes map[string][]string) []importBlock {
+	var blocks, skipped []importBlock
+	names := make(map[string]bool)
+	for _, asset := range assets {
+		name := relativeName(asset.Name)
+		candidates := resourceTypes[asset.AssetType]
+		if len(candidates) == 0 {
+			skipped = append(skipped, importBlock{Asset: asset, Comment: "no resource type for " + asset.AssetType})
+			continue
+		}
+
+		var matches []importBlock
+		for _, resourceType := range candidates {
+			if id, ok := importId(resourceType, name); ok {
+				matches = append(matches, importBlock{Asset: asset, ResourceType: resourceType, Id: id})
+			}
+		}
+		if len(matches) == 0 {
+			skipped = append(skipped, importBlock{
+				Asset:   asset,
+				Comment: fmt.Sprintf("the name doesn't match the import formats of %s", strings.Join(candidates, ", ")),
+			})
+			continue
+		}
+
+		block := matches[0]
+		if len(matches) > 1 {
+			var others []string
+			for _, m := range matches[1:] {
+				others = append(others, m.ResourceType)
+			}
+			block.Comment = "could also be imported as " + strings.Join(others, ", ")
+		}
+		block.Name = uniqueName(block.ResourceType, localName(asset, name), names)
+		blocks = append(blocks, block)
+	}
+
+	sort.Slice(blocks, func(i, j int) bool {
+		if blocks[i].ResourceType != blocks[j].ResourceType {
+			return blocks[i].ResourceType < blocks[j].ResourceType
+		}
+		return blocks[i].Name < blocks[j].Name
+	})
+	sort.Slice(skipped, func(i, j int) bool {
+		return skipped[i].Asset.Name < skipped[j].Asset.Name
+	})
+	return append(blocks, skipped...)
+}
+
+// relativeName returns the name of an asset relative to its service, e.g.
+// projects/my-project/zones/us-central1-a/instances/my-instance for
+// //compute.googleapis.com/projects/my-project/zones/us-central1-a/instances/my-instance
+func relativeName(name string) string {
+	if !strings.HasPrefix(name, "//") {
+		return name
+	}
+	_, relative, _ := strings.Cut(strings.TrimPrefix(name, "//"), "/")
+	return relative
+}
+
+// importId returns the import id of the asset named name as a resource of
+// resourceType, in the first import format of the resource type. The name must
+// match one of the import formats entirely.
+func importId(resourceType, name string) (string, bool) {
+	if custom, ok := customImportIds[resourceType]; ok {
+		return custom(name)
+	}
+	formats := provider.ImportFormats(resourceType)
+
+	for _, format := range formats {
+		re, err := regexp.Compile("^(?:" + strings.TrimSuffix(strings.TrimPrefix(format, "^"), "$") + ")$")
+		if err != nil {
+			continue
+		}
+		matches := re.FindStringSubmatch(name)
+		if matches == nil {
+			continue
+		}
+		fields := make(map[string]string)
+		for i, field := range re.SubexpNames() {
+			if field != "" {
+				fields[field] = matches[i]
+			}
+		}
+		if id, err := tpgresource.ImportIdFromFields(formats[0], fields); err == nil {
+			return id, true
+		}
+		return name, true
+	}
+	return "", false
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// localName returns the name of the resource block of an asset, from its
+// display name or the last segment of its name.
+func localName(asset *cloudasset.ResourceSearchResult, name string) string {
+	base := asset.DisplayName
+	if base == "" {
+		base = name[strings.LastIndex(name, "/")+1:]
+	}
+	local := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(base), "_"), "_")
+	if local == "" || (local[0] >= '0' && local[0] <= '9') {
+		local = "r_" + local
+	}
+	return local
+}
+
+// uniqueName returns name, suffixed with a number if the resource type has a
+// resource with that name already.
+func uniqueName(resourceType, name string, names map[string]bool) string {
+	unique := name
+	for i := 2; names[resourceType+"."+unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	names[resourceType+"."+unique] = true
+	return unique
+}
+
+// writeImports writes the import blocks and comments, and returns the number
+// of import blocks.
+func writeImports(w io.Writer, blocks []importBlock) (int, error) {
+	imported := 0
+	for _, block := range blocks {
+		var err error
+		if block.ResourceType == "" {
+			_, err = fmt.Fprintf(w, "# %s (%s) can't be imported: %s\n", block.Asset.Name, block.Asset.AssetType, block.Comment)
+		} else {
+			imported++
+			if block.Comment != "" {
+				if _, err = fmt.Fprintf(w, "# %s\n", block.Comment); err != nil {
+					return imported, err
+				}
+			}
+			_, err = fmt.Fprintf(w, "import {\n  to = %s.%s\n  id = %q\n}\n\n", block.ResourceType, block.Name, block.Id)
+		}
+		if err != nil {
+			return imported, err
+		}
+	}
+	return imported, nil
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/api/cloudasset/v1"
+	"google.golang.org/api/option"
+)
+
+var update = flag.Bool("update", false, "update the expected import blocks in testdata")
+
+// TestImportgen generates the import blocks of a recorded search of the Cloud
+// Asset API in testdata, and compares them to testdata/imports.tf.
+func TestImportgen(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/projects/my-project:searchAllResources" {
+			http.NotFound(w, r)
+			return
+		}
+		fixture := "search_all_resources.json"
+		if r.URL.Query().Get("pageToken") == "page-2" {
+			fixture = "search_all_resources_page_2.json"
+		}
+		http.ServeFile(w, r, filepath.Join("testdata", fixture))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	service, err := cloudasset.NewService(ctx, option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assets, err := searchAllResources(ctx, service, "projects/my-project", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resourceTypes, err := readResourceTypes(filepath.Join("..", ".."))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got bytes.Buffer
+	imported, err := writeImports(&got, generateImports(assets, resourceTypes))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if imported != 11 {
+		t.Errorf("expected 11 of the %d resources to be imported, got %d", len(assets), imported)
+	}
+
+	golden := filepath.Join("testdata", "imports.tf")
+	if *update {
+		if err := os.WriteFile(golden, got.Bytes(), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.String() != string(want) {
+		t.Errorf("unexpected import blocks, run go test ./cmd/importgen -update if they are expected:\n%s", got.String())
+	}
+}
+
+func TestRelativeName(t *testing.T) {
+	cases := map[string]string{
+		"//compute.googleapis.com/projects/my-project/global/networks/default": "projects/my-project/global/networks/default",
+		"//storage.googleapis.com/my-bucket":                                   "my-bucket",
+		"projects/my-project/topics/orders":                                    "projects/my-project/topics/orders",
+	}
+	for name, want := range cases {
+		if got := relativeName(name); got != want {
+			t.Errorf("expected relative name of %s to be %q, got %q", name, want, got)
+		}
+	}
+}
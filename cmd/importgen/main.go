@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// importgen discovers the existing resources of a project, folder or
+// organization with the Cloud Asset API and writes `import` blocks for them,
+// with ids in the import formats of their resource types.
+//
+// Example usage, from the root of the repository:
+//
+//	go run ./cmd/importgen -scope projects/my-project -out imports.tf
+//	terraform plan -generate-config-out=generated.tf
+//
+// Asset types are mapped to resource types with the `api_service_name` and
+// `api_resource_type_kind` of the resources' *_meta.yaml files. Assets that
+// can't be mapped to a resource type, or whose names don't match the import
+// formats of their resource types, are listed in comments.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"google.golang.org/api/cloudasset/v1"
+	"google.golang.org/api/option"
+)
+
+func main() {
+	scope := flag.String("scope", "", "scope of the search, e.g. projects/my-project, folders/123 or organizations/123")
+	query := flag.String("query", "", "Cloud Asset search query restricting the resources to import, e.g. labels.env:prod")
+	assetTypes := flag.String("asset-types", "", "comma-separated asset types to import, e.g. compute.googleapis.com/Instance; all types if unset")
+	root := flag.String("root", ".", "path to the root of the provider repository, to read the *_meta.yaml files from")
+	out := flag.String("out", "", "file to write the import blocks to; standard output if unset")
+	flag.Parse()
+
+	if *scope == "" {
+		fmt.Fprintln(os.Stderr, "-scope must be set")
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	resourceTypes, err := readResourceTypes(*root)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx := context.Background()
+	var opts []option.ClientOption
+	if endpoint := os.Getenv("GOOGLE_CLOUD_ASSET_CUSTOM_ENDPOINT"); endpoint != "" {
+		opts = append(opts, option.WithEndpoint(endpoint))
+	}
+	service, err := cloudasset.NewService(ctx, opts...)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var types []string
+	if *assetTypes != "" {
+		types = strings.Split(*assetTypes, ",")
+	}
+	assets, err := searchAllResources(ctx, service, *scope, *query, types)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	w := os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		w = f
+	}
+	imported, err := writeImports(w, generateImports(assets, resourceTypes))
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Fprintf(os.Stderr, "Found %d resources, %d of which can be imported\n", len(assets), imported)
+}
@@ -0,0 +1,58 @@
+import {
+  to = google_billing_project_info.billinginfo
+  id = "projects/my-project"
+}
+
+import {
+  to = google_compute_address.web_ip
+  id = "projects/my-project/regions/us-central1/addresses/web-ip"
+}
+
+import {
+  to = google_compute_disk.web_1
+  id = "projects/my-project/zones/us-central1-a/disks/web-1"
+}
+
+import {
+  to = google_compute_global_address.lb_ip
+  id = "projects/my-project/global/addresses/lb-ip"
+}
+
+import {
+  to = google_compute_instance.web_1
+  id = "projects/my-project/zones/us-central1-a/instances/web-1"
+}
+
+import {
+  to = google_compute_instance.web_1_2
+  id = "projects/my-project/zones/us-central1-b/instances/web-1"
+}
+
+import {
+  to = google_compute_network.default
+  id = "projects/my-project/global/networks/default"
+}
+
+import {
+  to = google_pubsub_subscription.orders_worker
+  id = "projects/my-project/subscriptions/orders-worker"
+}
+
+import {
+  to = google_pubsub_topic.orders
+  id = "projects/my-project/topics/orders"
+}
+
+# could also be imported as google_sql_source_representation_instance
+import {
+  to = google_sql_database_instance.orders_db
+  id = "projects/my-project/instances/orders-db"
+}
+
+import {
+  to = google_storage_bucket.my_project_assets
+  id = "my-project-assets"
+}
+
+# //pubsub.googleapis.com/projects/my-project/snapshots/orders-backup (pubsub.googleapis.com/Snapshot) can't be imported: no resource type for pubsub.googleapis.com/Snapshot
+# //run.googleapis.com/projects/my-project/locations/us-central1/services/orders/revisions/orders-00001-abc (run.googleapis.com/Revision) can't be imported: no resource type for run.googleapis.com/Revision
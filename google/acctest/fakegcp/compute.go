@@ -0,0 +1,934 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package fakegcp
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// computeCollection is a collection of compute resources, in the global,
+// regions or zones scope.
+type computeCollection struct {
+	scope string
+	kind  string
+	// insert and remove are called when a resource of the collection is
+	// inserted and deleted, to set defaults and keep the references between
+	// resources, and may fail the request.
+	insert func(s *Server, project, location string, res map[string]interface{}) *apiError
+	remove func(s *Server, path string, res map[string]interface{}) *apiError
+	// methods are the custom methods of the resources of the collection, e.g.
+	// setLabels, which update res from the request.
+	methods map[string]computeMethod
+}
+
+type computeMethod func(s *Server, res, body map[string]interface{}, query url.Values) *apiError
+
+var computeCollections = map[string]computeCollection{
+	"networks": {
+		scope:  "global",
+		kind:   "compute#network",
+		insert: insertNetwork,
+		remove: removeNetwork,
+	},
+	"subnetworks": {
+		scope:  "regions",
+		kind:   "compute#subnetwork",
+		insert: insertSubnetwork,
+		remove: removeSubnetwork,
+		methods: map[string]computeMethod{
+			"expandIpCidrRange":        setField("ipCidrRange", "ipCidrRange", "fingerprint"),
+			"setPrivateIpGoogleAccess": setField("privateIpGoogleAccess", "privateIpGoogleAccess", "fingerprint"),
+		},
+	},
+	"instances": {
+		scope:  "zones",
+		kind:   "compute#instance",
+		insert: insertInstance,
+		remove: removeInstance,
+		methods: map[string]computeMethod{
+			"setLabels":                    setFingerprinted("labels", "labels", "labelFingerprint", "labelFingerprint"),
+			"setMetadata":                  setFingerprinted("metadata", "", "fingerprint", "metadata.fingerprint"),
+			"setTags":                      setFingerprinted("tags", "", "fingerprint", "tags.fingerprint"),
+			"setMachineType":               whenStopped(setMachineType),
+			"setMinCpuPlatform":            whenStopped(setField("minCpuPlatform", "minCpuPlatform", "fingerprint")),
+			"setServiceAccount":            whenStopped(setServiceAccount),
+			"setScheduling":                setField("scheduling", "", "fingerprint"),
+			"setDeletionProtection":        setDeletionProtection,
+			"updateShieldedInstanceConfig": setField("shieldedInstanceConfig", "", "fingerprint"),
+			"updateNetworkInterface":       updateNetworkInterface,
+			"start":                        setStatus("RUNNING"),
+			"stop":                         setStatus("TERMINATED"),
+		},
+	},
+	"disks": {
+		scope:  "zones",
+		kind:   "compute#disk",
+		insert: insertDisk,
+		remove: removeDisk,
+		methods: map[string]computeMethod{
+			"resize":    resizeDisk,
+			"setLabels": setFingerprinted("labels", "labels", "labelFingerprint", "labelFingerprint"),
+		},
+	},
+}
+
+// apiError is an error response of the fake.
+type apiError struct {
+	code    int
+	status  string
+	reason  string
+	message string
+}
+
+func (e *apiError) write(w http.ResponseWriter) {
+	writeError(w, e.code, e.status, e.reason, "%s", e.message)
+}
+
+func badRequest(reason, format string, a ...interface{}) *apiError {
+	return &apiError{http.StatusBadRequest, "INVALID_ARGUMENT", reason, fmt.Sprintf(format, a...)}
+}
+
+func notFound(path string) *apiError {
+	return &apiError{http.StatusNotFound, "NOT_FOUND", "notFound", fmt.Sprintf("The resource '%s' was not found", path)}
+}
+
+// serveCompute serves the compute API. Resources are identified by paths like
+// projects/{project}/zones/{zone}/instances/{name}, whose self links are URLs
+// of the fake.
+func (s *Server) serveCompute(w http.ResponseWriter, r *http.Request, segments []string) {
+	if len(segments) < 2 || segments[0] != "projects" {
+		writeError(w, http.StatusNotImplemented, "UNIMPLEMENTED", "notImplemented", "fakegcp doesn't implement %s", r.URL.Path)
+		return
+	}
+	project := segments[1]
+	if len(segments) == 2 {
+		s.serveComputeProject(w, r, project)
+		return
+	}
+
+	// Split the rest of the path into the scope of the resource, e.g.
+	// zones/us-central1-a, its collection and its name and method if any.
+	var scope, location string
+	rest := segments[2:]
+	switch {
+	case rest[0] == "global":
+		scope, rest = "global", rest[1:]
+	case (rest[0] == "regions" || rest[0] == "zones") && len(rest) > 1:
+		scope, location, rest = rest[0]+"/"+rest[1], rest[1], rest[2:]
+	default:
+		writeError(w, http.StatusNotImplemented, "UNIMPLEMENTED", "notImplemented", "fakegcp doesn't implement %s", r.URL.Path)
+		return
+	}
+	if len(rest) == 0 && location != "" && r.Method == http.MethodGet {
+		s.serveComputeLocation(w, project, scope, location)
+		return
+	}
+	if len(rest) == 0 || len(rest) > 3 {
+		writeError(w, http.StatusNotImplemented, "UNIMPLEMENTED", "notImplemented", "fakegcp doesn't implement %s", r.URL.Path)
+		return
+	}
+	collection := rest[0]
+	scopePath := "compute/v1/projects/" + project + "/" + scope
+	collectionPath := scopePath + "/" + collection
+
+	switch collection {
+	case "operations":
+		if len(rest) == 2 || (len(rest) == 3 && rest[2] == "wait") {
+			method := ""
+			if len(rest) == 3 {
+				method = rest[2]
+			}
+			s.serveComputeOperation(w, r, collectionPath+"/"+rest[1], method)
+			return
+		}
+	case "images":
+		if scope == "global" && r.Method == http.MethodGet && len(rest) > 1 {
+			s.serveComputeImage(w, project, rest[1:])
+			return
+		}
+	case "machineTypes", "diskTypes":
+		if strings.HasPrefix(scope, "zones/") && r.Method == http.MethodGet && len(rest) == 2 {
+			writeJSON(w, map[string]interface{}{
+				"kind":     "compute#" + strings.TrimSuffix(collection, "s"),
+				"id":       s.newId(),
+				"name":     rest[1],
+				"zone":     location,
+				"selfLink": s.URL + "/" + collectionPath + "/" + rest[1],
+			})
+			return
+		}
+	}
+
+	c, ok := computeCollections[collection]
+	if !ok || !strings.HasPrefix(scope, c.scope) {
+		writeError(w, http.StatusNotImplemented, "UNIMPLEMENTED", "notImplemented", "fakegcp doesn't implement %s", r.URL.Path)
+		return
+	}
+
+	if len(rest) == 1 {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, map[string]interface{}{
+				"kind":     c.kind + "List",
+				"id":       collectionPath,
+				"items":    s.list(collectionPath),
+				"selfLink": s.URL + "/" + collectionPath,
+			})
+		case http.MethodPost:
+			s.insertComputeResource(w, r, c, project, scope, location, collectionPath)
+		default:
+			writeMethodNotAllowed(w, r)
+		}
+		return
+	}
+
+	path := collectionPath + "/" + rest[1]
+	res, ok := s.resources[path]
+	if !ok {
+		notFound("projects/" + project + "/" + scope + "/" + collection + "/" + rest[1]).write(w)
+		return
+	}
+
+	if len(rest) == 3 {
+		s.serveComputeMethod(w, r, c, project, scope, path, res, rest[2])
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, res)
+	case http.MethodPatch, http.MethodPut:
+		body, ok := readBody(w, r)
+		if !ok {
+			return
+		}
+		if fingerprint, ok := body["fingerprint"]; ok && res["fingerprint"] != nil && fingerprint != res["fingerprint"] {
+			conditionNotMet("fingerprint").write(w)
+			return
+		}
+		for _, field := range []string{"kind", "id", "name", "selfLink", "creationTimestamp", "region", "zone", "status", "fingerprint"} {
+			delete(body, field)
+		}
+		merge(res, body, "")
+		if _, ok := res["fingerprint"]; ok {
+			res["fingerprint"] = s.fingerprint()
+		}
+		writeJSON(w, s.newComputeOperation(project, scope, strings.ToLower(r.Method), path))
+	case http.MethodDelete:
+		if c.remove != nil {
+			if err := c.remove(s, path, res); err != nil {
+				err.write(w)
+				return
+			}
+		}
+		op := s.newComputeOperation(project, scope, "delete", path)
+		delete(s.resources, path)
+		delete(s.policies, path)
+		writeJSON(w, op)
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) insertComputeResource(w http.ResponseWriter, r *http.Request, c computeCollection, project, scope, location, collectionPath string) {
+	res, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+	name, _ := res["name"].(string)
+	if !computeNameRegexp.MatchString(name) {
+		badRequest("invalid", "Invalid value for field 'resource.name': '%s'. Must be a match of regex '%s'", name, computeNameRegexp).write(w)
+		return
+	}
+	path := collectionPath + "/" + name
+	if _, ok := s.resources[path]; ok {
+		writeError(w, http.StatusConflict, "ALREADY_EXISTS", "alreadyExists", "The resource 'projects/%s/%s/%s' already exists", project, strings.TrimPrefix(collectionPath, "compute/v1/projects/"+project+"/"), name)
+		return
+	}
+
+	res["kind"] = c.kind
+	res["id"] = s.newId()
+	res["creationTimestamp"] = now()
+	res["selfLink"] = s.URL + "/" + path
+	switch {
+	case strings.HasPrefix(scope, "regions/"):
+		res["region"] = s.URL + "/compute/v1/projects/" + project + "/" + scope
+	case strings.HasPrefix(scope, "zones/"):
+		res["zone"] = s.URL + "/compute/v1/projects/" + project + "/" + scope
+	}
+	if c.insert != nil {
+		if err := c.insert(s, project, location, res); err != nil {
+			err.write(w)
+			return
+		}
+	}
+	s.resources[path] = res
+	writeJSON(w, s.newComputeOperation(project, scope, "insert", path))
+}
+
+func (s *Server) serveComputeMethod(w http.ResponseWriter, r *http.Request, c computeCollection, project, scope, path string, res map[string]interface{}, method string) {
+	switch method {
+	case "getIamPolicy", "setIamPolicy", "testIamPermissions":
+		s.serveIamMethod(w, r, path, method)
+		return
+	}
+	m, ok := c.methods[method]
+	if !ok || r.Method != http.MethodPost && !(r.Method == http.MethodPatch && method == "updateNetworkInterface") {
+		writeError(w, http.StatusNotImplemented, "UNIMPLEMENTED", "notImplemented", "fakegcp doesn't implement %s %s", r.Method, r.URL.Path)
+		return
+	}
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+	if err := m(s, res, body, r.URL.Query()); err != nil {
+		err.write(w)
+		return
+	}
+	writeJSON(w, s.newComputeOperation(project, scope, method, path))
+}
+
+func (s *Server) serveComputeProject(w http.ResponseWriter, r *http.Request, project string) {
+	if r.Method != http.MethodGet {
+		writeMethodNotAllowed(w, r)
+		return
+	}
+	// Projects are looked up by id or number.
+	projectId, number := project, ""
+	for id, n := range s.projectNumbers {
+		if n == project {
+			projectId, number = id, n
+		}
+	}
+	if number == "" {
+		number = s.projectNumber(projectId)
+	}
+	writeJSON(w, map[string]interface{}{
+		"kind":                  "compute#project",
+		"id":                    number,
+		"name":                  projectId,
+		"selfLink":              s.URL + "/compute/v1/projects/" + projectId,
+		"defaultServiceAccount": number + "-compute@developer.gserviceaccount.com",
+		"commonInstanceMetadata": map[string]interface{}{
+			"kind":        "compute#metadata",
+			"fingerprint": "42WmSpB8rSM=",
+		},
+	})
+}
+
+// serveComputeLocation serves the regions and zones. Every region and zone
+// exists, and the region of a zone is the zone without its last part, e.g.
+// us-central1 for us-central1-a.
+func (s *Server) serveComputeLocation(w http.ResponseWriter, project, scope, location string) {
+	projectLink := s.URL + "/compute/v1/projects/" + project
+	res := map[string]interface{}{
+		"id":       s.newId(),
+		"name":     location,
+		"status":   "UP",
+		"selfLink": projectLink + "/" + scope,
+	}
+	if strings.HasPrefix(scope, "zones/") {
+		res["kind"] = "compute#zone"
+		res["region"] = projectLink + "/regions/" + location[:strings.LastIndex(location, "-")]
+	} else {
+		res["kind"] = "compute#region"
+	}
+	writeJSON(w, res)
+}
+
+var (
+	computeNameRegexp = regexp.MustCompile(`^[a-z]([-a-z0-9]{0,61}[a-z0-9])?$`)
+	imageNameRegexp   = regexp.MustCompile(`^(.+)-v\d{8}$`)
+)
+
+// serveComputeImage serves the images of public image projects such as
+// debian-cloud. Every family of these projects exists and its latest image is
+// named {family}-v20240101, and every image named like {family}-v{date}
+// exists. Their self links are the ones of the real API, as the provider only
+// recognizes these when comparing the images of disks.
+func (s *Server) serveComputeImage(w http.ResponseWriter, project string, rest []string) {
+	var name string
+	switch {
+	case len(rest) == 1:
+		name = rest[0]
+	case len(rest) == 2 && rest[0] == "family":
+		name = rest[1] + "-v20240101"
+	default:
+		notFound("projects/" + project + "/global/images/" + strings.Join(rest, "/")).write(w)
+		return
+	}
+	m := imageNameRegexp.FindStringSubmatch(name)
+	if !strings.HasSuffix(project, "-cloud") || m == nil {
+		notFound("projects/" + project + "/global/images/" + strings.Join(rest, "/")).write(w)
+		return
+	}
+	writeJSON(w, map[string]interface{}{
+		"kind":              "compute#image",
+		"id":                s.newId(),
+		"name":              name,
+		"family":            m[1],
+		"status":            "READY",
+		"diskSizeGb":        "10",
+		"creationTimestamp": "2024-01-01T00:00:00.000-00:00",
+		"selfLink":          publicImageLink(project, name),
+	})
+}
+
+// computeLink returns the self link of a reference to a compute resource in
+// a request, e.g. zones/us-central1-a/machineTypes/e2-medium or a URL of the
+// real API, or ref if it isn't a reference.
+func (s *Server) computeLink(project, ref string) string {
+	for _, version := range []string{"/compute/v1/", "/compute/beta/"} {
+		if i := strings.Index(ref, version); i >= 0 && strings.Contains(ref, "://") {
+			return s.URL + "/compute/v1/" + ref[i+len(version):]
+		}
+	}
+	switch {
+	case strings.HasPrefix(ref, "projects/"):
+		return s.URL + "/compute/v1/" + ref
+	case strings.HasPrefix(ref, "global/"), strings.HasPrefix(ref, "regions/"), strings.HasPrefix(ref, "zones/"):
+		return s.URL + "/compute/v1/projects/" + project + "/" + ref
+	}
+	return ref
+}
+
+// linkPath returns the path of the resource at a self link of the fake.
+func (s *Server) linkPath(link string) string {
+	return strings.TrimPrefix(link, s.URL+"/")
+}
+
+func (s *Server) fingerprint() string {
+	return "fp" + s.newId()
+}
+
+func conditionNotMet(field string) *apiError {
+	return &apiError{http.StatusPreconditionFailed, "FAILED_PRECONDITION", "conditionNotMet", fmt.Sprintf("Supplied %s does not match current %s.", field, field)}
+}
+
+func resourceInUse(path, user string) *apiError {
+	return badRequest("resourceInUseByAnotherResource", "The %s resource '%s' is already being used by '%s'", strings.Split(path, "/")[len(strings.Split(path, "/"))-2], path, user)
+}
+
+// references returns the paths of the resources of collection whose field
+// refers to the self link.
+func (s *Server) references(collection, field, link string) []string {
+	var paths []string
+	for path, res := range s.resources {
+		parent, _, _ := cutLast(path)
+		if !strings.HasSuffix(parent, "/"+collection) || !strings.HasPrefix(path, "compute/") {
+			continue
+		}
+		if res[field] == link {
+			paths = append(paths, path)
+		}
+		if collection == "instances" {
+			nics, _ := res["networkInterfaces"].([]interface{})
+			for _, nic := range nics {
+				if nic, ok := nic.(map[string]interface{}); ok && nic[field] == link {
+					paths = append(paths, path)
+				}
+			}
+		}
+	}
+	return paths
+}
+
+func insertNetwork(s *Server, project, _ string, res map[string]interface{}) *apiError {
+	if _, ok := res["autoCreateSubnetworks"]; !ok {
+		res["autoCreateSubnetworks"] = true
+	}
+	if _, ok := res["routingConfig"]; !ok {
+		res["routingConfig"] = map[string]interface{}{"routingMode": "REGIONAL"}
+	}
+	if _, ok := res["networkFirewallPolicyEnforcementOrder"]; !ok {
+		res["networkFirewallPolicyEnforcementOrder"] = "AFTER_CLASSIC_FIREWALL"
+	}
+	return nil
+}
+
+func removeNetwork(s *Server, path string, res map[string]interface{}) *apiError {
+	link := s.URL + "/" + path
+	for _, collection := range []string{"subnetworks", "instances"} {
+		for _, user := range s.references(collection, "network", link) {
+			return resourceInUse(path, user)
+		}
+	}
+	return nil
+}
+
+func insertSubnetwork(s *Server, project, region string, res map[string]interface{}) *apiError {
+	network, _ := res["network"].(string)
+	network = s.computeLink(project, network)
+	if _, ok := s.resources[s.linkPath(network)]; !ok {
+		return notFound(network)
+	}
+	res["network"] = network
+	cidr, _ := res["ipCidrRange"].(string)
+	ip, _, ok := strings.Cut(cidr, "/")
+	if !ok {
+		return badRequest("invalid", "Invalid value for field 'resource.ipCidrRange': '%s'. Invalid IPv4 CIDR range.", cidr)
+	}
+	// The gateway is the first address of the range.
+	if i := strings.LastIndex(ip, "."); i >= 0 {
+		n, _ := strconv.Atoi(ip[i+1:])
+		res["gatewayAddress"] = fmt.Sprintf("%s.%d", ip[:i], n+1)
+	}
+	res["fingerprint"] = s.fingerprint()
+	if _, ok := res["privateIpGoogleAccess"]; !ok {
+		res["privateIpGoogleAccess"] = false
+	}
+	if _, ok := res["stackType"]; !ok {
+		res["stackType"] = "IPV4_ONLY"
+	}
+	if _, ok := res["purpose"]; !ok {
+		res["purpose"] = "PRIVATE"
+	}
+	return nil
+}
+
+func removeSubnetwork(s *Server, path string, res map[string]interface{}) *apiError {
+	for _, user := range s.references("instances", "subnetwork", s.URL+"/"+path) {
+		return resourceInUse(path, user)
+	}
+	return nil
+}
+
+func insertDisk(s *Server, project, zone string, res map[string]interface{}) *apiError {
+	if image, ok := res["sourceImage"].(string); ok {
+		link, err := s.resolveImage(project, image)
+		if err != nil {
+			return err
+		}
+		res["sourceImage"] = link
+	}
+	if _, ok := res["sizeGb"]; !ok {
+		res["sizeGb"] = "10"
+	}
+	res["sizeGb"] = fmt.Sprint(res["sizeGb"])
+	diskType, _ := res["type"].(string)
+	if diskType == "" {
+		diskType = "pd-standard"
+	}
+	if !strings.Contains(diskType, "/") {
+		diskType = "zones/" + zone + "/diskTypes/" + diskType
+	}
+	res["type"] = s.computeLink(project, diskType)
+	res["status"] = "READY"
+	res["labelFingerprint"] = s.fingerprint()
+	res["physicalBlockSizeBytes"] = "4096"
+	return nil
+}
+
+func removeDisk(s *Server, path string, res map[string]interface{}) *apiError {
+	if users, _ := res["users"].([]interface{}); len(users) > 0 {
+		return resourceInUse(path, fmt.Sprint(users[0]))
+	}
+	return nil
+}
+
+// resolveImage returns the self link of the image of a disk, the latest image
+// of the family if the image is a family.
+func (s *Server) resolveImage(project, image string) (string, *apiError) {
+	link := s.computeLink(project, image)
+	m := regexp.MustCompile(`/projects/([^/]+)/global/images/(family/)?([^/]+)$`).FindStringSubmatch(link)
+	if m == nil {
+		return "", notFound(image)
+	}
+	if _, ok := s.resources[s.linkPath(link)]; ok {
+		return link, nil
+	}
+	name := m[3]
+	if m[2] != "" {
+		name += "-v20240101"
+	}
+	if !strings.HasSuffix(m[1], "-cloud") || !imageNameRegexp.MatchString(name) {
+		return "", notFound(image)
+	}
+	return publicImageLink(m[1], name), nil
+}
+
+func publicImageLink(project, name string) string {
+	return "https://www.googleapis.com/compute/v1/projects/" + project + "/global/images/" + name
+}
+
+func insertInstance(s *Server, project, zone string, res map[string]interface{}) *apiError {
+	name := res["name"].(string)
+	link := res["selfLink"].(string)
+	zonePath := "compute/v1/projects/" + project + "/zones/" + zone
+
+	machineType, _ := res["machineType"].(string)
+	if machineType == "" {
+		return badRequest("required", "Invalid value for field 'resource.machineType': ''. Machine type must be specified.")
+	}
+	if !strings.Contains(machineType, "/") {
+		machineType = "zones/" + zone + "/machineTypes/" + machineType
+	}
+	res["machineType"] = s.computeLink(project, machineType)
+
+	// Validate the references of the instance before creating its disks.
+	nics, _ := res["networkInterfaces"].([]interface{})
+	for i, nic := range nics {
+		nic, _ := nic.(map[string]interface{})
+		if nic == nil {
+			return badRequest("invalid", "Invalid value for field 'resource.networkInterfaces[%d]'", i)
+		}
+		if subnetwork, _ := nic["subnetwork"].(string); subnetwork != "" {
+			subnetwork = s.computeLink(project, subnetwork)
+			subnet, ok := s.resources[s.linkPath(subnetwork)]
+			if !ok {
+				return notFound(subnetwork)
+			}
+			nic["subnetwork"] = subnetwork
+			nic["network"] = subnet["network"]
+		}
+		network, _ := nic["network"].(string)
+		if network == "" {
+			network = "global/networks/default"
+		} else if !strings.Contains(network, "/") {
+			network = "global/networks/" + network
+		}
+		network = s.computeLink(project, network)
+		if _, ok := s.resources[s.linkPath(network)]; !ok {
+			return notFound(network)
+		}
+		nic["network"] = network
+	}
+	disks, _ := res["disks"].([]interface{})
+	if len(disks) == 0 {
+		return badRequest("required", "Invalid value for field 'resource.disks': ''. At least one disk must be specified.")
+	}
+	for i, disk := range disks {
+		disk, _ := disk.(map[string]interface{})
+		if disk == nil {
+			return badRequest("invalid", "Invalid value for field 'resource.disks[%d]'", i)
+		}
+		if source, _ := disk["source"].(string); source != "" {
+			if _, ok := s.resources[s.linkPath(s.computeLink(project, source))]; !ok {
+				return notFound(source)
+			}
+		} else if _, ok := disk["initializeParams"].(map[string]interface{}); !ok {
+			return badRequest("required", "Invalid value for field 'resource.disks[%d]': Source or initialize params must be specified.", i)
+		}
+	}
+
+	for i, disk := range disks {
+		disk := disk.(map[string]interface{})
+		if params, ok := disk["initializeParams"].(map[string]interface{}); ok {
+			diskName, _ := params["diskName"].(string)
+			if diskName == "" {
+				diskName = name
+				if i > 0 {
+					diskName = fmt.Sprintf("%s-%d", name, i)
+				}
+			}
+			diskPath := zonePath + "/disks/" + diskName
+			if _, ok := s.resources[diskPath]; ok {
+				return &apiError{http.StatusConflict, "ALREADY_EXISTS", "alreadyExists", fmt.Sprintf("The resource '%s' already exists", diskPath)}
+			}
+			d := map[string]interface{}{
+				"kind":              "compute#disk",
+				"id":                s.newId(),
+				"name":              diskName,
+				"creationTimestamp": now(),
+				"zone":              s.URL + "/" + zonePath,
+				"selfLink":          s.URL + "/" + diskPath,
+			}
+			for k, v := range map[string]string{"sourceImage": "sourceImage", "diskSizeGb": "sizeGb", "diskType": "type", "labels": "labels"} {
+				if value, ok := params[k]; ok {
+					d[v] = value
+				}
+			}
+			if err := insertDisk(s, project, zone, d); err != nil {
+				return err
+			}
+			s.resources[diskPath] = d
+			disk["source"] = d["selfLink"]
+			disk["diskSizeGb"] = d["sizeGb"]
+			delete(disk, "initializeParams")
+		}
+		source := s.computeLink(project, disk["source"].(string))
+		disk["source"] = source
+		d := s.resources[s.linkPath(source)]
+		users, _ := d["users"].([]interface{})
+		d["users"] = append(users, link)
+		if _, ok := disk["diskSizeGb"]; !ok {
+			disk["diskSizeGb"] = d["sizeGb"]
+		}
+
+		disk["kind"] = "compute#attachedDisk"
+		disk["index"] = i
+		disk["boot"] = i == 0
+		disk["type"] = "PERSISTENT"
+		for k, v := range map[string]interface{}{
+			"deviceName": fmt.Sprintf("persistent-disk-%d", i),
+			"autoDelete": false,
+			"mode":       "READ_WRITE",
+			"interface":  "SCSI",
+		} {
+			if _, ok := disk[k]; !ok {
+				disk[k] = v
+			}
+		}
+	}
+
+	for i, nic := range nics {
+		nic := nic.(map[string]interface{})
+		nic["kind"] = "compute#networkInterface"
+		nic["name"] = fmt.Sprintf("nic%d", i)
+		nic["fingerprint"] = s.fingerprint()
+		if _, ok := nic["networkIP"]; !ok {
+			nic["networkIP"] = s.address("10.128")
+		}
+		if _, ok := nic["stackType"]; !ok {
+			nic["stackType"] = "IPV4_ONLY"
+		}
+		accessConfigs, _ := nic["accessConfigs"].([]interface{})
+		for _, ac := range accessConfigs {
+			ac, _ := ac.(map[string]interface{})
+			if ac == nil {
+				continue
+			}
+			ac["kind"] = "compute#accessConfig"
+			for k, v := range map[string]interface{}{
+				"name":        "External NAT",
+				"type":        "ONE_TO_ONE_NAT",
+				"networkTier": "PREMIUM",
+				"natIP":       s.address("34.118"),
+			} {
+				if value, ok := ac[k]; !ok || value == "" {
+					ac[k] = v
+				}
+			}
+		}
+	}
+
+	serviceAccounts, _ := res["serviceAccounts"].([]interface{})
+	for _, sa := range serviceAccounts {
+		if sa, ok := sa.(map[string]interface{}); ok && (sa["email"] == nil || sa["email"] == "default") {
+			sa["email"] = s.projectNumber(project) + "-compute@developer.gserviceaccount.com"
+		}
+	}
+
+	metadata, _ := res["metadata"].(map[string]interface{})
+	if metadata == nil {
+		metadata = make(map[string]interface{})
+	}
+	metadata["kind"] = "compute#metadata"
+	metadata["fingerprint"] = s.fingerprint()
+	res["metadata"] = metadata
+	tags, _ := res["tags"].(map[string]interface{})
+	if tags == nil {
+		tags = make(map[string]interface{})
+	}
+	tags["fingerprint"] = s.fingerprint()
+	res["tags"] = tags
+
+	scheduling, _ := res["scheduling"].(map[string]interface{})
+	if scheduling == nil {
+		scheduling = make(map[string]interface{})
+	}
+	for k, v := range map[string]interface{}{
+		"automaticRestart":  true,
+		"onHostMaintenance": "MIGRATE",
+		"preemptible":       false,
+		"provisioningModel": "STANDARD",
+	} {
+		if _, ok := scheduling[k]; !ok {
+			scheduling[k] = v
+		}
+	}
+	res["scheduling"] = scheduling
+
+	res["status"] = "RUNNING"
+	res["cpuPlatform"] = "Intel Broadwell"
+	res["fingerprint"] = s.fingerprint()
+	res["labelFingerprint"] = s.fingerprint()
+	for k, v := range map[string]interface{}{
+		"canIpForward":       false,
+		"deletionProtection": false,
+		"startRestricted":    false,
+	} {
+		if _, ok := res[k]; !ok {
+			res[k] = v
+		}
+	}
+	return nil
+}
+
+// removeInstance deletes the disks of an instance that are auto-deleted, and
+// detaches the others.
+func removeInstance(s *Server, path string, res map[string]interface{}) *apiError {
+	if res["deletionProtection"] == true {
+		return badRequest("resourceIsProtected", "The resource '%s' is protected from deletion", path)
+	}
+	link := s.URL + "/" + path
+	disks, _ := res["disks"].([]interface{})
+	for _, disk := range disks {
+		disk, _ := disk.(map[string]interface{})
+		source, _ := disk["source"].(string)
+		diskPath := s.linkPath(source)
+		d, ok := s.resources[diskPath]
+		if !ok {
+			continue
+		}
+		if disk["autoDelete"] == true {
+			delete(s.resources, diskPath)
+			delete(s.policies, diskPath)
+			continue
+		}
+		users, _ := d["users"].([]interface{})
+		var remaining []interface{}
+		for _, user := range users {
+			if user != link {
+				remaining = append(remaining, user)
+			}
+		}
+		d["users"] = remaining
+	}
+	return nil
+}
+
+// address returns a new IP address in the /16 range with the given prefix.
+func (s *Server) address(prefix string) string {
+	n, _ := strconv.ParseUint(s.newId(), 10, 64)
+	return fmt.Sprintf("%s.%d.%d", prefix, n/254%256, n%254+1)
+}
+
+// setField returns a method setting field of a resource to the value of
+// bodyField of the request, or the whole request if bodyField is empty, and
+// refreshing fingerprint if it isn't empty.
+func setField(field, bodyField, fingerprint string) computeMethod {
+	return func(s *Server, res, body map[string]interface{}, _ url.Values) *apiError {
+		var value interface{} = body
+		if bodyField != "" {
+			value = body[bodyField]
+		}
+		res[field] = value
+		if fingerprint != "" {
+			res[fingerprint] = s.fingerprint()
+		}
+		return nil
+	}
+}
+
+// setFingerprinted returns a method setting a field of a resource that has a
+// fingerprint, like labels or metadata, which must be given in the request.
+// fingerprintPath is the path to the fingerprint in the resource, e.g.
+// metadata.fingerprint.
+func setFingerprinted(field, bodyField, bodyFingerprint, fingerprintPath string) computeMethod {
+	return func(s *Server, res, body map[string]interface{}, _ url.Values) *apiError {
+		holder, key := res, fingerprintPath
+		if parent, k, ok := strings.Cut(fingerprintPath, "."); ok {
+			holder, _ = res[parent].(map[string]interface{})
+			key = k
+		}
+		var current interface{}
+		if holder != nil {
+			current = holder[key]
+		}
+		if body[bodyFingerprint] != current {
+			return conditionNotMet(bodyFingerprint)
+		}
+
+		var value interface{} = body
+		if bodyField != "" {
+			value = body[bodyField]
+		}
+		fingerprint := s.fingerprint()
+		if m, ok := value.(map[string]interface{}); ok && bodyField == "" {
+			m = deepCopy(m)
+			m[bodyFingerprint] = fingerprint
+			if field == "metadata" {
+				m["kind"] = "compute#metadata"
+			}
+			res[field] = m
+		} else {
+			res[field] = value
+			res[fingerprintPath] = fingerprint
+		}
+		return nil
+	}
+}
+
+func setMachineType(s *Server, res, body map[string]interface{}, _ url.Values) *apiError {
+	machineType, _ := body["machineType"].(string)
+	if machineType == "" {
+		return badRequest("required", "Required field 'machineType' not specified")
+	}
+	project := regexp.MustCompile(`/projects/([^/]+)/`).FindStringSubmatch(res["selfLink"].(string))[1]
+	res["machineType"] = s.computeLink(project, machineType)
+	res["fingerprint"] = s.fingerprint()
+	return nil
+}
+
+func resizeDisk(s *Server, res, body map[string]interface{}, _ url.Values) *apiError {
+	size, _ := strconv.ParseInt(fmt.Sprint(body["sizeGb"]), 10, 64)
+	current, _ := strconv.ParseInt(fmt.Sprint(res["sizeGb"]), 10, 64)
+	if size <= current {
+		return badRequest("invalid", "Invalid value for field 'sizeGb': '%v'. New disk size '%d' GB must be larger than existing size '%d' GB.", body["sizeGb"], size, current)
+	}
+	res["sizeGb"] = strconv.FormatInt(size, 10)
+	return nil
+}
+
+func whenStopped(m computeMethod) computeMethod {
+	return func(s *Server, res, body map[string]interface{}, query url.Values) *apiError {
+		if res["status"] != "TERMINATED" {
+			return badRequest("resourceNotReady", "The resource '%s' is not ready: the instance must be stopped", res["selfLink"])
+		}
+		return m(s, res, body, query)
+	}
+}
+
+func setStatus(status string) computeMethod {
+	return func(s *Server, res, _ map[string]interface{}, _ url.Values) *apiError {
+		res["status"] = status
+		return nil
+	}
+}
+
+func setServiceAccount(s *Server, res, body map[string]interface{}, _ url.Values) *apiError {
+	email, _ := body["email"].(string)
+	if email == "" {
+		delete(res, "serviceAccounts")
+		return nil
+	}
+	res["serviceAccounts"] = []interface{}{
+		map[string]interface{}{
+			"email":  email,
+			"scopes": body["scopes"],
+		},
+	}
+	return nil
+}
+
+func setDeletionProtection(s *Server, res, _ map[string]interface{}, query url.Values) *apiError {
+	res["deletionProtection"] = query.Get("deletionProtection") != "false"
+	return nil
+}
+
+func updateNetworkInterface(s *Server, res, body map[string]interface{}, query url.Values) *apiError {
+	nics, _ := res["networkInterfaces"].([]interface{})
+	for _, nic := range nics {
+		nic, _ := nic.(map[string]interface{})
+		if nic == nil || nic["name"] != query.Get("networkInterface") {
+			continue
+		}
+		if body["fingerprint"] != nic["fingerprint"] {
+			return conditionNotMet("fingerprint")
+		}
+		for k, v := range body {
+			if k != "name" && k != "fingerprint" && k != "kind" {
+				nic[k] = v
+			}
+		}
+		nic["fingerprint"] = s.fingerprint()
+		return nil
+	}
+	return badRequest("invalid", "Invalid value for field 'networkInterface': '%s'", query.Get("networkInterface"))
+}
@@ -0,0 +1,321 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fakegcp implements an in-process, stateful fake of the core REST APIs
+// of Google Cloud, so that acceptance tests of the resources that use them can
+// run offline with real create, read, update and delete semantics.
+//
+// The fake serves:
+//
+//   - Resource Manager v1 projects and their long-running operations, and the
+//     billing info of projects
+//   - Compute networks, subnetworks, instances and disks, with zonal, regional
+//     and global operations that complete when they are first polled
+//   - Storage buckets and objects, including multipart uploads and downloads
+//   - Pub/Sub topics and subscriptions
+//   - IAM policies of all of the above
+//
+// All services are served from one server, each under the path of its default
+// base path, e.g. /compute/v1/ for https://compute.googleapis.com/compute/v1/.
+// Endpoints returns the environment variables that point the provider's
+// *_custom_endpoint settings at the fake. Requests to other services fail
+// with 501 Not Implemented.
+package fakegcp
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Server is a fake of the Google Cloud APIs listening on a local address.
+type Server struct {
+	URL string
+
+	server *httptest.Server
+
+	mu sync.Mutex
+	// resources are the JSON representations of the resources, by their path
+	// relative to the server, e.g. compute/v1/projects/p/global/networks/n.
+	resources map[string]map[string]interface{}
+	// media is the content of storage objects, by the path of the object.
+	media map[string][]byte
+	// policies are the IAM policies of resources, by the path of the resource.
+	policies map[string]map[string]interface{}
+	// operations are the long-running operations, by their path.
+	operations map[string]*operation
+	// projectNumbers are the numbers of the projects that were referred to,
+	// by project id.
+	projectNumbers map[string]string
+	nextId         uint64
+}
+
+// New starts a fake server serving HTTPS with a self-signed certificate,
+// trusted by the client returned by Client. It must be closed with Close.
+func New() *Server {
+	s := &Server{
+		resources:      make(map[string]map[string]interface{}),
+		media:          make(map[string][]byte),
+		policies:       make(map[string]map[string]interface{}),
+		operations:     make(map[string]*operation),
+		projectNumbers: make(map[string]string),
+		nextId:         1000000000000,
+	}
+	s.server = httptest.NewTLSServer(s)
+	s.URL = s.server.URL
+	return s
+}
+
+// Client returns an HTTP client trusting the certificate of the server.
+func (s *Server) Client() *http.Client {
+	return s.server.Client()
+}
+
+// Close shuts the server down.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Endpoints returns the custom endpoint environment variables of the
+// provider for the services of the fake, with their values.
+func (s *Server) Endpoints() map[string]string {
+	return map[string]string{
+		"GOOGLE_CLOUD_BILLING_CUSTOM_ENDPOINT":    s.URL + "/cloudbilling/v1/",
+		"GOOGLE_COMPUTE_CUSTOM_ENDPOINT":          s.URL + "/compute/v1/",
+		"GOOGLE_PUBSUB_CUSTOM_ENDPOINT":           s.URL + "/pubsub/v1/",
+		"GOOGLE_RESOURCE_MANAGER_CUSTOM_ENDPOINT": s.URL + "/cloudresourcemanager/v1/",
+		"GOOGLE_STORAGE_CUSTOM_ENDPOINT":          s.URL + "/storage/v1/",
+	}
+}
+
+// AddProject adds an active project, as if it had been created beforehand.
+func (s *Server) AddProject(projectId string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.resources[projectPath(projectId)] = map[string]interface{}{
+		"projectId":      projectId,
+		"projectNumber":  s.projectNumber(projectId),
+		"name":           projectId,
+		"lifecycleState": "ACTIVE",
+		"createTime":     now(),
+	}
+}
+
+// Resource returns a copy of the JSON representation of a resource, given its
+// path relative to the server or its self link, e.g.
+// compute/v1/projects/p/global/networks/n.
+func (s *Server) Resource(name string) (map[string]interface{}, bool) {
+	name = strings.TrimPrefix(strings.TrimPrefix(name, s.URL), "/")
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res, ok := s.resources[name]
+	if !ok {
+		return nil, false
+	}
+	return deepCopy(res), true
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	log.Printf("[DEBUG] fakegcp: %s %s", r.Method, r.URL)
+	segments := pathSegments(r.URL)
+	if len(segments) < 2 {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "notFound", "The requested URL %s was not found.", r.URL.Path)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	service, rest := strings.Join(segments[:2], "/"), segments[2:]
+	switch service {
+	case "cloudresourcemanager/v1":
+		s.serveResourceManager(w, r, rest)
+	case "cloudbilling/v1":
+		s.serveBilling(w, r, rest)
+	case "compute/v1":
+		s.serveCompute(w, r, rest)
+	case "pubsub/v1":
+		s.servePubsub(w, r, rest)
+	case "storage/v1":
+		s.serveStorage(w, r, rest)
+	case "upload/storage":
+		s.serveStorageUpload(w, r, rest)
+	case "download/storage":
+		// Media links of objects are served like object downloads of the
+		// JSON API.
+		if len(rest) > 0 && rest[0] == "v1" {
+			s.serveStorage(w, r, rest[1:])
+			return
+		}
+		writeError(w, http.StatusNotImplemented, "UNIMPLEMENTED", "notImplemented", "fakegcp doesn't implement %s", r.URL.Path)
+	default:
+		writeError(w, http.StatusNotImplemented, "UNIMPLEMENTED", "notImplemented", "fakegcp doesn't implement %s", r.URL.Path)
+	}
+}
+
+// pathSegments returns the unescaped segments of the path of u, so that
+// escaped slashes in e.g. object names are kept in their segment.
+func pathSegments(u *url.URL) []string {
+	var segments []string
+	for _, segment := range strings.Split(strings.Trim(u.EscapedPath(), "/"), "/") {
+		if unescaped, err := url.PathUnescape(segment); err == nil {
+			segment = unescaped
+		}
+		segments = append(segments, segment)
+	}
+	return segments
+}
+
+// newId returns a new unique numeric id.
+func (s *Server) newId() string {
+	s.nextId++
+	return strconv.FormatUint(s.nextId, 10)
+}
+
+// projectNumber returns the number of a project, assigning one to it if it's
+// the first time the project is referred to. Projects don't need to be created
+// to be used by other services.
+func (s *Server) projectNumber(projectId string) string {
+	if n, ok := s.projectNumbers[projectId]; ok {
+		return n
+	}
+	n := s.newId()
+	s.projectNumbers[projectId] = n
+	return n
+}
+
+// list returns the resources directly under the collection at path, sorted by
+// path.
+func (s *Server) list(path string) []interface{} {
+	var names []string
+	for name := range s.resources {
+		if parent, _, ok := cutLast(name); ok && parent == path {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	items := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		items = append(items, s.resources[name])
+	}
+	return items
+}
+
+func cutLast(path string) (string, string, bool) {
+	i := strings.LastIndex(path, "/")
+	if i < 0 {
+		return "", path, false
+	}
+	return path[:i], path[i+1:], true
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339Nano)
+}
+
+// readBody decodes the JSON body of a request, if any.
+func readBody(w http.ResponseWriter, r *http.Request) (map[string]interface{}, bool) {
+	body := make(map[string]interface{})
+	if r.Body == nil || r.ContentLength == 0 {
+		return body, true
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "parseError", "Invalid JSON payload received: %s", err)
+		return nil, false
+	}
+	return body, true
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("[WARN] fakegcp: Error writing response: %s", err)
+	}
+}
+
+// writeError writes an error in the format of the Google APIs, which the
+// client libraries decode as a *googleapi.Error.
+func writeError(w http.ResponseWriter, code int, status, reason, format string, a ...interface{}) {
+	message := fmt.Sprintf(format, a...)
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(code)
+	err := json.NewEncoder(w).Encode(map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": message,
+			"status":  status,
+			"errors": []interface{}{
+				map[string]interface{}{
+					"message": message,
+					"domain":  "global",
+					"reason":  reason,
+				},
+			},
+		},
+	})
+	if err != nil {
+		log.Printf("[WARN] fakegcp: Error writing response: %s", err)
+	}
+}
+
+func writeNotFound(w http.ResponseWriter, kind, name string) {
+	writeError(w, http.StatusNotFound, "NOT_FOUND", "notFound", "The %s '%s' was not found", kind, name)
+}
+
+func writeAlreadyExists(w http.ResponseWriter, kind, name string) {
+	writeError(w, http.StatusConflict, "ALREADY_EXISTS", "alreadyExists", "The %s '%s' already exists", kind, name)
+}
+
+func writeMethodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	writeError(w, http.StatusMethodNotAllowed, "UNIMPLEMENTED", "httpMethodNotAllowed", "fakegcp doesn't implement %s %s", r.Method, r.URL.Path)
+}
+
+// merge sets the fields of patch on res, or only the fields in mask if it
+// isn't empty. Fields of the mask are the top-level JSON fields, in camel
+// or snake case.
+func merge(res, patch map[string]interface{}, mask string) {
+	if mask == "" {
+		for k, v := range patch {
+			res[k] = v
+		}
+		return
+	}
+	for _, field := range strings.Split(mask, ",") {
+		field = camelCase(strings.SplitN(strings.TrimSpace(field), ".", 2)[0])
+		if v, ok := patch[field]; ok {
+			res[field] = v
+		} else {
+			delete(res, field)
+		}
+	}
+}
+
+func camelCase(s string) string {
+	parts := strings.Split(s, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+func deepCopy(v map[string]interface{}) map[string]interface{} {
+	b, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	var c map[string]interface{}
+	if err := json.Unmarshal(b, &c); err != nil {
+		panic(err)
+	}
+	return c
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package fakegcp
+
+import (
+	"encoding/base64"
+	"net/http"
+	"strings"
+)
+
+// getIamPolicy returns the IAM policy of the resource at path, which is empty
+// if it was never set.
+func (s *Server) getIamPolicy(path string) map[string]interface{} {
+	if policy, ok := s.policies[path]; ok {
+		return deepCopy(policy)
+	}
+	return map[string]interface{}{
+		"version": 1,
+		"etag":    "ACAB",
+	}
+}
+
+// setIamPolicy replaces the IAM policy of the resource at path. Like the real
+// APIs, it fails with 409 Conflict if the policy has an etag that isn't the
+// etag of the current policy, as the policy was changed concurrently.
+func (s *Server) setIamPolicy(w http.ResponseWriter, path string, policy map[string]interface{}) (map[string]interface{}, bool) {
+	current := s.getIamPolicy(path)
+	if etag, ok := policy["etag"].(string); ok && etag != "" && etag != current["etag"] {
+		writeError(w, http.StatusConflict, "ABORTED", "conflict", "There were concurrent policy changes. Please retry the whole read-modify-write with exponential backoff.")
+		return nil, false
+	}
+
+	policy = deepCopy(policy)
+	if _, ok := policy["version"]; !ok {
+		policy["version"] = 1
+	}
+	policy["etag"] = base64.StdEncoding.EncodeToString([]byte(s.newId()))
+	s.policies[path] = policy
+	return deepCopy(policy), true
+}
+
+// serveIamMethod serves the getIamPolicy and setIamPolicy custom methods of
+// the resource at path, called as e.g. POST {path}:getIamPolicy. It returns
+// false if method isn't an IAM method.
+func (s *Server) serveIamMethod(w http.ResponseWriter, r *http.Request, path, method string) bool {
+	switch method {
+	case "getIamPolicy":
+		writeJSON(w, s.getIamPolicy(path))
+	case "setIamPolicy":
+		body, ok := readBody(w, r)
+		if !ok {
+			return true
+		}
+		policy, _ := body["policy"].(map[string]interface{})
+		if policy == nil {
+			writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "required", "Policy is required")
+			return true
+		}
+		if policy, ok := s.setIamPolicy(w, path, policy); ok {
+			writeJSON(w, policy)
+		}
+	case "testIamPermissions":
+		body, ok := readBody(w, r)
+		if !ok {
+			return true
+		}
+		writeJSON(w, map[string]interface{}{"permissions": body["permissions"]})
+	default:
+		return false
+	}
+	return true
+}
+
+// cutMethod splits the last segment of a path into its name and custom
+// method, e.g. my-topic:getIamPolicy into my-topic and getIamPolicy.
+func cutMethod(segments []string) ([]string, string) {
+	if len(segments) == 0 {
+		return segments, ""
+	}
+	last := segments[len(segments)-1]
+	name, method, ok := strings.Cut(last, ":")
+	if !ok {
+		return segments, ""
+	}
+	cut := append(append([]string(nil), segments[:len(segments)-1]...), name)
+	return cut, method
+}
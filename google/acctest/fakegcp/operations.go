@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package fakegcp
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Operations of the fake are pending when they are returned by the request
+// that started them, and done when they are first polled. Changes are applied
+// when the operation starts, so that the resource is in its final state once
+// the operation is done.
+
+// operation is a long-running operation, and the response it has once it's
+// done.
+type operation struct {
+	op       map[string]interface{}
+	response map[string]interface{}
+}
+
+// newCommonOperation returns a pending long-running operation in the format of
+// tpgresource.CommonOperation, e.g. operations/cp.123 of the Resource Manager
+// API served under service, whose response is response once it's done.
+func (s *Server) newCommonOperation(service, name string, response map[string]interface{}) map[string]interface{} {
+	op := map[string]interface{}{
+		"name": name,
+		"done": false,
+	}
+	s.operations[service+"/"+name] = &operation{op: op, response: response}
+	return deepCopy(op)
+}
+
+func (s *Server) serveCommonOperation(w http.ResponseWriter, r *http.Request, path string) {
+	o, ok := s.operations[path]
+	if !ok {
+		writeNotFound(w, "operation", path)
+		return
+	}
+	if r.Method != http.MethodGet {
+		writeMethodNotAllowed(w, r)
+		return
+	}
+	if o.op["done"] == false {
+		o.op["done"] = true
+		if o.response != nil {
+			o.op["response"] = o.response
+		}
+	}
+	writeJSON(w, o.op)
+}
+
+// newComputeOperation returns a pending compute operation on the resource at
+// target, in the zone, region or global scope of the resource.
+func (s *Server) newComputeOperation(project, scope, operationType, target string) map[string]interface{} {
+	id := s.newId()
+	name := "operation-" + id
+	path := "compute/v1/projects/" + project + "/" + scope + "/operations/" + name
+	op := map[string]interface{}{
+		"kind":          "compute#operation",
+		"id":            id,
+		"name":          name,
+		"operationType": operationType,
+		"status":        "RUNNING",
+		"progress":      0,
+		"insertTime":    now(),
+		"startTime":     now(),
+		"selfLink":      s.URL + "/" + path,
+		"targetLink":    s.URL + "/" + target,
+		"user":          "fakegcp@example.com",
+	}
+	if res, ok := s.resources[target]; ok {
+		op["targetId"] = res["id"]
+	}
+	if zone, ok := strings.CutPrefix(scope, "zones/"); ok {
+		op["zone"] = s.URL + "/compute/v1/projects/" + project + "/zones/" + zone
+	} else if region, ok := strings.CutPrefix(scope, "regions/"); ok {
+		op["region"] = s.URL + "/compute/v1/projects/" + project + "/regions/" + region
+	}
+	s.operations[path] = &operation{op: op}
+	return deepCopy(op)
+}
+
+// serveComputeOperation serves the get and wait methods of the compute
+// operation at path.
+func (s *Server) serveComputeOperation(w http.ResponseWriter, r *http.Request, path, method string) {
+	o, ok := s.operations[path]
+	if !ok {
+		writeNotFound(w, "resource", path)
+		return
+	}
+	op := o.op
+	switch {
+	case r.Method == http.MethodGet && method == "", r.Method == http.MethodPost && method == "wait":
+	case r.Method == http.MethodDelete && method == "":
+		delete(s.operations, path)
+		writeJSON(w, map[string]interface{}{})
+		return
+	default:
+		writeMethodNotAllowed(w, r)
+		return
+	}
+	if op["status"] != "DONE" {
+		op["status"] = "DONE"
+		op["progress"] = 100
+		op["endTime"] = now()
+	}
+	writeJSON(w, op)
+}
@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package fakegcp
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+var pubsubNameRegexp = regexp.MustCompile(`^[a-zA-Z][-a-zA-Z0-9_.~+%]{2,254}$`)
+
+// servePubsub serves the topics and subscriptions of the Pub/Sub API.
+func (s *Server) servePubsub(w http.ResponseWriter, r *http.Request, segments []string) {
+	segments, method := cutMethod(segments)
+	if len(segments) < 3 || segments[0] != "projects" || (segments[2] != "topics" && segments[2] != "subscriptions") {
+		writeError(w, http.StatusNotImplemented, "UNIMPLEMENTED", "notImplemented", "fakegcp doesn't implement %s", r.URL.Path)
+		return
+	}
+	project, collection := segments[1], segments[2]
+	collectionPath := "pubsub/v1/projects/" + project + "/" + collection
+
+	switch {
+	case len(segments) == 3 && method == "" && r.Method == http.MethodGet:
+		writeJSON(w, map[string]interface{}{collection: s.list(collectionPath)})
+	case len(segments) == 4 && method != "":
+		path := collectionPath + "/" + segments[3]
+		if _, ok := s.resources[path]; !ok {
+			writePubsubNotFound(w, segments[3])
+			return
+		}
+		if s.serveIamMethod(w, r, path, method) {
+			return
+		}
+		if collection == "topics" && method == "publish" && r.Method == http.MethodPost {
+			s.publish(w, r)
+			return
+		}
+		writeError(w, http.StatusNotImplemented, "UNIMPLEMENTED", "notImplemented", "fakegcp doesn't implement %s", r.URL.Path)
+	case len(segments) == 4:
+		s.servePubsubResource(w, r, project, collection, segments[3])
+	case len(segments) == 5 && collection == "topics" && segments[4] == "subscriptions" && r.Method == http.MethodGet:
+		topic := "projects/" + project + "/topics/" + segments[3]
+		if _, ok := s.resources["pubsub/v1/"+topic]; !ok {
+			writePubsubNotFound(w, segments[3])
+			return
+		}
+		subscriptions := []interface{}{}
+		for _, sub := range s.list("pubsub/v1/projects/" + project + "/subscriptions") {
+			if sub := sub.(map[string]interface{}); sub["topic"] == topic {
+				subscriptions = append(subscriptions, sub["name"])
+			}
+		}
+		writeJSON(w, map[string]interface{}{"subscriptions": subscriptions})
+	default:
+		writeError(w, http.StatusNotImplemented, "UNIMPLEMENTED", "notImplemented", "fakegcp doesn't implement %s %s", r.Method, r.URL.Path)
+	}
+}
+
+func writePubsubNotFound(w http.ResponseWriter, name string) {
+	writeError(w, http.StatusNotFound, "NOT_FOUND", "notFound", "Resource not found (resource=%s).", name)
+}
+
+func (s *Server) servePubsubResource(w http.ResponseWriter, r *http.Request, project, collection, id string) {
+	name := "projects/" + project + "/" + collection + "/" + id
+	path := "pubsub/v1/" + name
+	res, exists := s.resources[path]
+
+	switch r.Method {
+	case http.MethodPut:
+		if exists {
+			writeError(w, http.StatusConflict, "ALREADY_EXISTS", "alreadyExists", "Resource already exists in the project (resource=%s).", id)
+			return
+		}
+		if !pubsubNameRegexp.MatchString(id) || strings.HasPrefix(id, "goog") {
+			writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "badRequest", "Invalid resource name given (name=%s).", name)
+			return
+		}
+		body, ok := readBody(w, r)
+		if !ok {
+			return
+		}
+		body["name"] = name
+		if collection == "subscriptions" {
+			topic, _ := body["topic"].(string)
+			if _, ok := s.resources["pubsub/v1/"+topic]; !ok || topic == "" {
+				writePubsubNotFound(w, topic)
+				return
+			}
+			for k, v := range map[string]interface{}{
+				"ackDeadlineSeconds":       10,
+				"messageRetentionDuration": "604800s",
+				"expirationPolicy":         map[string]interface{}{"ttl": "2678400s"},
+				"pushConfig":               map[string]interface{}{},
+				"state":                    "ACTIVE",
+			} {
+				if _, ok := body[k]; !ok {
+					body[k] = v
+				}
+			}
+		}
+		s.resources[path] = body
+		writeJSON(w, body)
+	case http.MethodGet:
+		if !exists {
+			writePubsubNotFound(w, id)
+			return
+		}
+		writeJSON(w, res)
+	case http.MethodPatch:
+		if !exists {
+			writePubsubNotFound(w, id)
+			return
+		}
+		body, ok := readBody(w, r)
+		if !ok {
+			return
+		}
+		// The resource is in the field named after its kind, e.g. topic.
+		kind := strings.TrimSuffix(collection, "s")
+		patch, _ := body[kind].(map[string]interface{})
+		mask, _ := body["updateMask"].(string)
+		if mask == "" {
+			mask = r.URL.Query().Get("updateMask")
+		}
+		if patch == nil || mask == "" {
+			writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "badRequest", "The update_mask in the Update%s%sRequest must be set", strings.ToUpper(kind[:1]), kind[1:])
+			return
+		}
+		delete(patch, "name")
+		delete(patch, "topic")
+		merge(res, patch, mask)
+		writeJSON(w, res)
+	case http.MethodDelete:
+		if !exists {
+			writePubsubNotFound(w, id)
+			return
+		}
+		delete(s.resources, path)
+		delete(s.policies, path)
+		// The subscriptions of a deleted topic are kept, and refer to a
+		// deleted topic.
+		if collection == "topics" {
+			for _, sub := range s.list("pubsub/v1/projects/" + project + "/subscriptions") {
+				if sub := sub.(map[string]interface{}); sub["topic"] == name {
+					sub["topic"] = "_deleted-topic_"
+				}
+			}
+		}
+		writeJSON(w, map[string]interface{}{})
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+// publish accepts the messages of a publish request, and returns their ids.
+// The messages are dropped, as the fake doesn't implement pull.
+func (s *Server) publish(w http.ResponseWriter, r *http.Request) {
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+	messages, _ := body["messages"].([]interface{})
+	ids := make([]interface{}, 0, len(messages))
+	for range messages {
+		ids = append(ids, s.newId())
+	}
+	writeJSON(w, map[string]interface{}{"messageIds": ids})
+}
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package fakegcp
+
+import (
+	"net/http"
+	"strings"
+)
+
+func projectPath(projectId string) string {
+	return "cloudresourcemanager/v1/projects/" + projectId
+}
+
+// serveResourceManager serves the projects and operations of the Resource
+// Manager v1 API. Like the real API, projects that don't exist are reported
+// as forbidden rather than not found.
+func (s *Server) serveResourceManager(w http.ResponseWriter, r *http.Request, segments []string) {
+	segments, method := cutMethod(segments)
+	switch {
+	case len(segments) == 2 && segments[0] == "operations":
+		s.serveCommonOperation(w, r, "cloudresourcemanager/v1/operations/"+segments[1])
+	case len(segments) == 1 && segments[0] == "projects" && method == "":
+		switch r.Method {
+		case http.MethodPost:
+			s.createProject(w, r)
+		case http.MethodGet:
+			var projects []interface{}
+			for _, p := range s.list("cloudresourcemanager/v1/projects") {
+				if p.(map[string]interface{})["lifecycleState"] == "ACTIVE" {
+					projects = append(projects, p)
+				}
+			}
+			writeJSON(w, map[string]interface{}{"projects": projects})
+		default:
+			writeMethodNotAllowed(w, r)
+		}
+	case len(segments) == 2 && segments[0] == "projects":
+		s.serveProject(w, r, segments[1], method)
+	default:
+		writeError(w, http.StatusNotImplemented, "UNIMPLEMENTED", "notImplemented", "fakegcp doesn't implement %s", r.URL.Path)
+	}
+}
+
+func (s *Server) createProject(w http.ResponseWriter, r *http.Request) {
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+	projectId, _ := body["projectId"].(string)
+	if projectId == "" {
+		writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "badRequest", "Field 'projectId' is required")
+		return
+	}
+	if _, ok := s.resources[projectPath(projectId)]; ok {
+		writeError(w, http.StatusConflict, "ALREADY_EXISTS", "alreadyExists", "Requested entity already exists")
+		return
+	}
+
+	project := deepCopy(body)
+	project["projectNumber"] = s.projectNumber(projectId)
+	project["lifecycleState"] = "ACTIVE"
+	project["createTime"] = now()
+	if _, ok := project["name"]; !ok {
+		project["name"] = projectId
+	}
+	s.resources[projectPath(projectId)] = project
+
+	response := deepCopy(project)
+	response["@type"] = "type.googleapis.com/google.cloudresourcemanager.v1.Project"
+	writeJSON(w, s.newCommonOperation("cloudresourcemanager/v1", "operations/cp."+s.newId(), response))
+}
+
+func (s *Server) serveProject(w http.ResponseWriter, r *http.Request, projectId, method string) {
+	path := projectPath(projectId)
+	project, ok := s.resources[path]
+	if !ok {
+		writeError(w, http.StatusForbidden, "PERMISSION_DENIED", "forbidden", "The caller does not have permission")
+		return
+	}
+	active := project["lifecycleState"] == "ACTIVE"
+
+	switch {
+	case method == "undelete" && r.Method == http.MethodPost:
+		if active {
+			writeError(w, http.StatusBadRequest, "FAILED_PRECONDITION", "failedPrecondition", "Project %s is not deleted", projectId)
+			return
+		}
+		project["lifecycleState"] = "ACTIVE"
+		writeJSON(w, map[string]interface{}{})
+	case method != "" && active:
+		if !s.serveIamMethod(w, r, path, method) {
+			writeError(w, http.StatusNotImplemented, "UNIMPLEMENTED", "notImplemented", "fakegcp doesn't implement %s", r.URL.Path)
+		}
+	case method != "":
+		writeError(w, http.StatusForbidden, "PERMISSION_DENIED", "forbidden", "The caller does not have permission")
+	case r.Method == http.MethodGet:
+		writeJSON(w, project)
+	case r.Method == http.MethodPut && active:
+		body, ok := readBody(w, r)
+		if !ok {
+			return
+		}
+		for _, field := range []string{"name", "labels", "parent"} {
+			if v, ok := body[field]; ok {
+				project[field] = v
+			} else {
+				delete(project, field)
+			}
+		}
+		writeJSON(w, project)
+	case r.Method == http.MethodDelete && active:
+		project["lifecycleState"] = "DELETE_REQUESTED"
+		writeJSON(w, map[string]interface{}{})
+	case r.Method == http.MethodPut || r.Method == http.MethodDelete:
+		writeError(w, http.StatusBadRequest, "FAILED_PRECONDITION", "failedPrecondition", "Project %s is not active", projectId)
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+// serveBilling serves the billing info of projects. Projects have no billing
+// account until one is set.
+func (s *Server) serveBilling(w http.ResponseWriter, r *http.Request, segments []string) {
+	if len(segments) != 3 || segments[0] != "projects" || segments[2] != "billingInfo" {
+		writeError(w, http.StatusNotImplemented, "UNIMPLEMENTED", "notImplemented", "fakegcp doesn't implement %s", r.URL.Path)
+		return
+	}
+	projectId := segments[1]
+	if _, ok := s.resources[projectPath(projectId)]; !ok {
+		writeError(w, http.StatusForbidden, "PERMISSION_DENIED", "forbidden", "The caller does not have permission")
+		return
+	}
+
+	path := "cloudbilling/v1/projects/" + projectId + "/billingInfo"
+	info, ok := s.resources[path]
+	if !ok {
+		info = map[string]interface{}{
+			"name":           path[len("cloudbilling/v1/"):],
+			"projectId":      projectId,
+			"billingEnabled": false,
+		}
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, info)
+	case http.MethodPut:
+		body, ok := readBody(w, r)
+		if !ok {
+			return
+		}
+		account, _ := body["billingAccountName"].(string)
+		if account != "" && !strings.HasPrefix(account, "billingAccounts/") {
+			writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "badRequest", "Invalid billing account name %s", account)
+			return
+		}
+		info["billingAccountName"] = account
+		info["billingEnabled"] = account != ""
+		s.resources[path] = info
+		writeJSON(w, info)
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
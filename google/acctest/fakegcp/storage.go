@@ -0,0 +1,460 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package fakegcp
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"log"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var bucketNameRegexp = regexp.MustCompile(`^[a-z0-9][-a-z0-9_.]{1,61}[a-z0-9]$`)
+
+func bucketPath(bucket string) string {
+	return "storage/v1/b/" + bucket
+}
+
+func objectPath(bucket, object string) string {
+	return bucketPath(bucket) + "/o/" + object
+}
+
+// serveStorage serves the buckets and objects of the Cloud Storage JSON API.
+func (s *Server) serveStorage(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 1 && segments[0] == "b":
+		s.serveBuckets(w, r)
+	case len(segments) == 2 && segments[0] == "b":
+		s.serveBucket(w, r, segments[1])
+	case len(segments) >= 3 && segments[0] == "b" && segments[2] == "iam":
+		s.serveBucketIam(w, r, segments[1], segments[3:])
+	case len(segments) == 3 && segments[0] == "b" && segments[2] == "o":
+		s.serveObjects(w, r, segments[1])
+	case len(segments) == 4 && segments[0] == "b" && segments[2] == "o":
+		s.serveObject(w, r, segments[1], segments[3])
+	default:
+		writeError(w, http.StatusNotImplemented, "UNIMPLEMENTED", "notImplemented", "fakegcp doesn't implement %s", r.URL.Path)
+	}
+}
+
+func (s *Server) serveBuckets(w http.ResponseWriter, r *http.Request) {
+	project := r.URL.Query().Get("project")
+	switch r.Method {
+	case http.MethodGet:
+		items := []interface{}{}
+		number := s.projectNumber(project)
+		for _, bucket := range s.list("storage/v1/b") {
+			if bucket.(map[string]interface{})["projectNumber"] == number {
+				items = append(items, bucket)
+			}
+		}
+		writeJSON(w, map[string]interface{}{"kind": "storage#buckets", "items": items})
+	case http.MethodPost:
+		bucket, ok := readBody(w, r)
+		if !ok {
+			return
+		}
+		name, _ := bucket["name"].(string)
+		if !bucketNameRegexp.MatchString(name) || strings.HasPrefix(name, "goog") {
+			writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "invalid", "Invalid bucket name: '%s'", name)
+			return
+		}
+		if project == "" {
+			writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "required", "Required parameter: project")
+			return
+		}
+		if _, ok := s.resources[bucketPath(name)]; ok {
+			writeError(w, http.StatusConflict, "ALREADY_EXISTS", "conflict", "Your previous request to create the named bucket succeeded and you already own it.")
+			return
+		}
+
+		location, _ := bucket["location"].(string)
+		if location == "" {
+			location = "US"
+		}
+		location = strings.ToUpper(location)
+		locationType := "region"
+		if location == "US" || location == "EU" || location == "ASIA" {
+			locationType = "multi-region"
+		}
+		for k, v := range map[string]interface{}{
+			"kind":          "storage#bucket",
+			"id":            name,
+			"selfLink":      s.URL + "/" + bucketPath(name),
+			"projectNumber": s.projectNumber(project),
+			"location":      location,
+			"locationType":  locationType,
+			"timeCreated":   now(),
+		} {
+			bucket[k] = v
+		}
+		for k, v := range map[string]interface{}{
+			"storageClass": "STANDARD",
+			"iamConfiguration": map[string]interface{}{
+				"bucketPolicyOnly":         map[string]interface{}{"enabled": false},
+				"uniformBucketLevelAccess": map[string]interface{}{"enabled": false},
+				"publicAccessPrevention":   "inherited",
+			},
+			"softDeletePolicy": map[string]interface{}{
+				"retentionDurationSeconds": "604800",
+				"effectiveTime":            now(),
+			},
+		} {
+			if _, ok := bucket[k]; !ok {
+				bucket[k] = v
+			}
+		}
+		s.touch(bucket, 0)
+		s.resources[bucketPath(name)] = bucket
+		writeJSON(w, bucket)
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+func (s *Server) serveBucket(w http.ResponseWriter, r *http.Request, name string) {
+	path := bucketPath(name)
+	bucket, ok := s.resources[path]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "notFound", "The specified bucket does not exist.")
+		return
+	}
+	if !s.metagenerationMatches(w, r, bucket) {
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, bucket)
+	case http.MethodPatch, http.MethodPut:
+		body, ok := readBody(w, r)
+		if !ok {
+			return
+		}
+		if r.Method == http.MethodPut {
+			for k := range bucket {
+				if _, ok := body[k]; !ok && !storageOutputFields[k] {
+					delete(bucket, k)
+				}
+			}
+		}
+		for k, v := range body {
+			if storageOutputFields[k] || k == "name" || k == "location" {
+				continue
+			}
+			if v == nil {
+				delete(bucket, k)
+			} else {
+				bucket[k] = v
+			}
+		}
+		s.touch(bucket, 1)
+		writeJSON(w, bucket)
+	case http.MethodDelete:
+		if len(s.listObjects(name, "")) > 0 {
+			writeError(w, http.StatusConflict, "FAILED_PRECONDITION", "conflict", "The bucket you tried to delete is not empty.")
+			return
+		}
+		delete(s.resources, path)
+		delete(s.policies, path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+// storageOutputFields are the fields of buckets and objects that are set by
+// the server.
+var storageOutputFields = map[string]bool{
+	"kind":           true,
+	"id":             true,
+	"selfLink":       true,
+	"mediaLink":      true,
+	"projectNumber":  true,
+	"bucket":         true,
+	"generation":     true,
+	"metageneration": true,
+	"etag":           true,
+	"timeCreated":    true,
+	"updated":        true,
+	"size":           true,
+	"md5Hash":        true,
+	"crc32c":         true,
+	"locationType":   true,
+}
+
+// touch increments the metageneration of a bucket or object by increment, and
+// updates its etag and update time.
+func (s *Server) touch(res map[string]interface{}, increment int64) {
+	metageneration, _ := strconv.ParseInt(fmt.Sprint(res["metageneration"]), 10, 64)
+	if metageneration == 0 {
+		metageneration = 1
+	} else {
+		metageneration += increment
+	}
+	res["metageneration"] = strconv.FormatInt(metageneration, 10)
+	res["etag"] = base64.StdEncoding.EncodeToString([]byte(s.newId()))
+	res["updated"] = now()
+}
+
+// metagenerationMatches checks the ifMetagenerationMatch precondition of a
+// request on a bucket or object.
+func (s *Server) metagenerationMatches(w http.ResponseWriter, r *http.Request, res map[string]interface{}) bool {
+	if want := r.URL.Query().Get("ifMetagenerationMatch"); want != "" && want != res["metageneration"] {
+		writeError(w, http.StatusPreconditionFailed, "FAILED_PRECONDITION", "conditionNotMet", "Precondition Failed")
+		return false
+	}
+	return true
+}
+
+func (s *Server) serveBucketIam(w http.ResponseWriter, r *http.Request, bucket string, rest []string) {
+	path := bucketPath(bucket)
+	if _, ok := s.resources[path]; !ok {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "notFound", "The specified bucket does not exist.")
+		return
+	}
+
+	var policy map[string]interface{}
+	switch {
+	case len(rest) == 1 && rest[0] == "testPermissions" && r.Method == http.MethodGet:
+		writeJSON(w, map[string]interface{}{
+			"kind":        "storage#testIamPermissionsResponse",
+			"permissions": r.URL.Query()["permissions"],
+		})
+		return
+	case len(rest) > 0:
+		writeError(w, http.StatusNotImplemented, "UNIMPLEMENTED", "notImplemented", "fakegcp doesn't implement %s", r.URL.Path)
+		return
+	case r.Method == http.MethodGet:
+		policy = s.getIamPolicy(path)
+	case r.Method == http.MethodPut:
+		body, ok := readBody(w, r)
+		if !ok {
+			return
+		}
+		delete(body, "kind")
+		delete(body, "resourceId")
+		if policy, ok = s.setIamPolicy(w, path, body); !ok {
+			return
+		}
+	default:
+		writeMethodNotAllowed(w, r)
+		return
+	}
+	policy["kind"] = "storage#policy"
+	policy["resourceId"] = "projects/_/buckets/" + bucket
+	writeJSON(w, policy)
+}
+
+// listObjects returns the objects of a bucket whose names start with prefix,
+// sorted by name.
+func (s *Server) listObjects(bucket, prefix string) []interface{} {
+	objectsPath := objectPath(bucket, "")
+	var names []string
+	for path := range s.resources {
+		if name, ok := strings.CutPrefix(path, objectsPath); ok && strings.HasPrefix(name, prefix) {
+			names = append(names, path)
+		}
+	}
+	sort.Strings(names)
+	items := []interface{}{}
+	for _, name := range names {
+		items = append(items, s.resources[name])
+	}
+	return items
+}
+
+func (s *Server) serveObjects(w http.ResponseWriter, r *http.Request, bucket string) {
+	if _, ok := s.resources[bucketPath(bucket)]; !ok {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "notFound", "The specified bucket does not exist.")
+		return
+	}
+	if r.Method != http.MethodGet {
+		writeMethodNotAllowed(w, r)
+		return
+	}
+	writeJSON(w, map[string]interface{}{
+		"kind":  "storage#objects",
+		"items": s.listObjects(bucket, r.URL.Query().Get("prefix")),
+	})
+}
+
+func (s *Server) serveObject(w http.ResponseWriter, r *http.Request, bucket, name string) {
+	path := objectPath(bucket, name)
+	object, ok := s.resources[path]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "notFound", "No such object: %s/%s", bucket, name)
+		return
+	}
+	if !s.metagenerationMatches(w, r, object) {
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if r.URL.Query().Get("alt") == "media" {
+			w.Header().Set("Content-Type", fmt.Sprint(object["contentType"]))
+			w.Header().Set("X-Goog-Generation", fmt.Sprint(object["generation"]))
+			if _, err := w.Write(s.media[path]); err != nil {
+				log.Printf("[WARN] fakegcp: Error writing response: %s", err)
+			}
+			return
+		}
+		writeJSON(w, object)
+	case http.MethodPatch:
+		body, ok := readBody(w, r)
+		if !ok {
+			return
+		}
+		for k, v := range body {
+			if storageOutputFields[k] || k == "name" {
+				continue
+			}
+			if v == nil {
+				delete(object, k)
+			} else {
+				object[k] = v
+			}
+		}
+		s.touch(object, 1)
+		writeJSON(w, object)
+	case http.MethodDelete:
+		delete(s.resources, path)
+		delete(s.media, path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+// serveStorageUpload serves the media and multipart uploads of objects, at
+// /upload/storage/v1/b/{bucket}/o. Resumable uploads aren't implemented.
+func (s *Server) serveStorageUpload(w http.ResponseWriter, r *http.Request, segments []string) {
+	if len(segments) != 4 || segments[0] != "v1" || segments[1] != "b" || segments[3] != "o" || r.Method != http.MethodPost {
+		writeError(w, http.StatusNotImplemented, "UNIMPLEMENTED", "notImplemented", "fakegcp doesn't implement %s %s", r.Method, r.URL.Path)
+		return
+	}
+	bucket := segments[2]
+	if _, ok := s.resources[bucketPath(bucket)]; !ok {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "notFound", "The specified bucket does not exist.")
+		return
+	}
+
+	object := make(map[string]interface{})
+	var content []byte
+	switch uploadType := r.URL.Query().Get("uploadType"); uploadType {
+	case "media":
+		var err error
+		if content, err = io.ReadAll(r.Body); err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "invalid", "Error reading media: %s", err)
+			return
+		}
+		if contentType := r.Header.Get("Content-Type"); contentType != "" {
+			object["contentType"] = contentType
+		}
+	case "multipart":
+		var ok bool
+		if object, content, ok = readMultipartUpload(w, r); !ok {
+			return
+		}
+	default:
+		writeError(w, http.StatusNotImplemented, "UNIMPLEMENTED", "notImplemented", "fakegcp doesn't implement uploadType=%s", uploadType)
+		return
+	}
+	if name := r.URL.Query().Get("name"); name != "" {
+		object["name"] = name
+	}
+	name, _ := object["name"].(string)
+	if name == "" {
+		writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "required", "Required object name")
+		return
+	}
+
+	path := objectPath(bucket, name)
+	if r.URL.Query().Get("ifGenerationMatch") == "0" {
+		if _, ok := s.resources[path]; ok {
+			writeError(w, http.StatusPreconditionFailed, "FAILED_PRECONDITION", "conditionNotMet", "At least one of the pre-conditions you specified did not hold.")
+			return
+		}
+	}
+
+	md5Hash := md5.Sum(content)
+	crc := make([]byte, 4)
+	binary.BigEndian.PutUint32(crc, crc32.Checksum(content, crc32.MakeTable(crc32.Castagnoli)))
+	generation := strconv.FormatInt(time.Now().UnixMicro(), 10)
+	for k, v := range map[string]interface{}{
+		"kind":        "storage#object",
+		"id":          bucket + "/" + name + "/" + generation,
+		"selfLink":    s.URL + "/storage/v1/b/" + bucket + "/o/" + url.PathEscape(name),
+		"mediaLink":   s.URL + "/download/storage/v1/b/" + bucket + "/o/" + url.PathEscape(name) + "?generation=" + generation + "&alt=media",
+		"bucket":      bucket,
+		"generation":  generation,
+		"size":        strconv.Itoa(len(content)),
+		"md5Hash":     base64.StdEncoding.EncodeToString(md5Hash[:]),
+		"crc32c":      base64.StdEncoding.EncodeToString(crc),
+		"timeCreated": now(),
+	} {
+		object[k] = v
+	}
+	for k, v := range map[string]interface{}{
+		"contentType":  "application/octet-stream",
+		"storageClass": "STANDARD",
+	} {
+		if _, ok := object[k]; !ok {
+			object[k] = v
+		}
+	}
+	delete(object, "metageneration")
+	s.touch(object, 0)
+	s.resources[path] = object
+	s.media[path] = content
+	writeJSON(w, object)
+}
+
+// readMultipartUpload reads the metadata and content of a multipart upload,
+// which are its first and second parts.
+func readMultipartUpload(w http.ResponseWriter, r *http.Request) (map[string]interface{}, []byte, bool) {
+	_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || params["boundary"] == "" {
+		writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "invalid", "Invalid multipart request: %v", err)
+		return nil, nil, false
+	}
+	reader := multipart.NewReader(r.Body, params["boundary"])
+
+	object := make(map[string]interface{})
+	part, err := reader.NextPart()
+	if err == nil {
+		err = json.NewDecoder(part).Decode(&object)
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "invalid", "Invalid multipart request metadata: %s", err)
+		return nil, nil, false
+	}
+
+	var content []byte
+	part, err = reader.NextPart()
+	if err == nil {
+		if _, ok := object["contentType"]; !ok && part.Header.Get("Content-Type") != "" {
+			object["contentType"] = part.Header.Get("Content-Type")
+		}
+		content, err = io.ReadAll(part)
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "invalid", "Invalid multipart request media: %s", err)
+		return nil, nil, false
+	}
+	return object, content, true
+}
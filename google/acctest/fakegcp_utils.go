@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package acctest
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-google/google/acctest/fakegcp"
+	"github.com/hashicorp/terraform-provider-google/google/envvar"
+)
+
+const (
+	FakeGcpProject = "fakegcp-project"
+	FakeGcpRegion  = "us-central1"
+	FakeGcpZone    = "us-central1-a"
+)
+
+// fakeGcpTests are the names of the tests that called FakeGcp.
+var fakeGcpTests sync.Map
+
+// FakeGcp starts an in-process fake of the Google Cloud APIs for the duration
+// of the test, and points the provider at it through the custom endpoint
+// environment variables of its services, with a fake access token. The
+// project, region and zone of the test are set to FakeGcpProject,
+// FakeGcpRegion and FakeGcpZone, and the project exists in the fake.
+//
+// Tests calling FakeGcp run offline as long as their resources only use the
+// services of the fake (see package fakegcp), and can't be run in parallel as
+// the environment is changed. VCR is disabled for them. The fake serves
+// HTTPS, and the clients of the provider, which are built from
+// http.DefaultTransport, trust its certificate for the duration of the test.
+func FakeGcp(t *testing.T) *fakegcp.Server {
+	server := fakegcp.New()
+	t.Cleanup(server.Close)
+
+	defaultTransport := http.DefaultTransport
+	http.DefaultTransport = server.Client().Transport
+	t.Cleanup(func() {
+		http.DefaultTransport = defaultTransport
+	})
+	fakeGcpTests.Store(t.Name(), true)
+	t.Cleanup(func() {
+		fakeGcpTests.Delete(t.Name())
+	})
+
+	for k, v := range server.Endpoints() {
+		t.Setenv(k, v)
+	}
+	for _, k := range append(envvar.CredsEnvVars, "GOOGLE_CREDENTIALS_FILE", "VCR_MODE") {
+		t.Setenv(k, "")
+	}
+	t.Setenv("GOOGLE_OAUTH_ACCESS_TOKEN", "fakegcp-token")
+	for k, values := range map[string][]string{
+		FakeGcpProject: envvar.ProjectEnvVars,
+		FakeGcpRegion:  envvar.RegionEnvVars,
+		FakeGcpZone:    envvar.ZoneEnvVars,
+	} {
+		for _, v := range values {
+			t.Setenv(v, k)
+		}
+	}
+
+	server.AddProject(FakeGcpProject)
+	return server
+}
+
+// usesFakeGcp returns whether the test called FakeGcp.
+func usesFakeGcp(t *testing.T) bool {
+	_, ok := fakeGcpTests.Load(t.Name())
+	return ok
+}
@@ -0,0 +1,294 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package acctest_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+	"github.com/hashicorp/terraform-provider-google/google/acctest/fakegcp"
+)
+
+// fakeGcpPaths return the paths of resources in the fake from their
+// attributes, by resource type.
+var fakeGcpPaths = map[string]func(attributes map[string]string) string{
+	"google_compute_instance":    func(a map[string]string) string { return a["self_link"] },
+	"google_compute_network":     func(a map[string]string) string { return a["self_link"] },
+	"google_compute_subnetwork":  func(a map[string]string) string { return a["self_link"] },
+	"google_pubsub_subscription": func(a map[string]string) string { return "pubsub/v1/" + a["id"] },
+	"google_pubsub_topic":        func(a map[string]string) string { return "pubsub/v1/" + a["id"] },
+	"google_storage_bucket":      func(a map[string]string) string { return "storage/v1/b/" + a["name"] },
+	"google_project":             func(a map[string]string) string { return "cloudresourcemanager/v1/projects/" + a["project_id"] },
+}
+
+// testAccCheckFakeGcpDestroyProducer checks that the resources of the state
+// don't exist in the fake anymore. Projects are deleted by requesting their
+// deletion.
+func testAccCheckFakeGcpDestroyProducer(fake *fakegcp.Server) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for name, rs := range s.RootModule().Resources {
+			path, ok := fakeGcpPaths[rs.Type]
+			if !ok {
+				continue
+			}
+			res, ok := fake.Resource(path(rs.Primary.Attributes))
+			if ok && res["lifecycleState"] != "DELETE_REQUESTED" {
+				return fmt.Errorf("%s still exists: %v", name, res)
+			}
+		}
+		return nil
+	}
+}
+
+// testAccCheckFakeGcpResource checks the representation in the fake of the
+// resource with the given address.
+func testAccCheckFakeGcpResource(fake *fakegcp.Server, address string, check func(res map[string]interface{}) error) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[address]
+		if !ok {
+			return fmt.Errorf("%s not found in state", address)
+		}
+		path := fakeGcpPaths[rs.Type](rs.Primary.Attributes)
+		res, ok := fake.Resource(path)
+		if !ok {
+			return fmt.Errorf("%s doesn't exist in the fake at %s", address, path)
+		}
+		if err := check(res); err != nil {
+			return fmt.Errorf("%s: %s", address, err)
+		}
+		return nil
+	}
+}
+
+func TestAccFakeGcp_computeNetworkAndSubnetwork(t *testing.T) {
+	fake := acctest.FakeGcp(t)
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckFakeGcpDestroyProducer(fake),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFakeGcp_computeNetworkAndSubnetwork(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_subnetwork.default", "gateway_address", "10.2.0.1"),
+					resource.TestCheckResourceAttrPair("google_compute_subnetwork.default", "network", "google_compute_network.default", "self_link"),
+					testAccCheckFakeGcpResource(fake, "google_compute_subnetwork.default", func(res map[string]interface{}) error {
+						if res["privateIpGoogleAccess"] == true {
+							return fmt.Errorf("expected private Google access to be disabled, got %v", res)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				Config: testAccFakeGcp_computeNetworkAndSubnetwork(true),
+				Check: testAccCheckFakeGcpResource(fake, "google_compute_subnetwork.default", func(res map[string]interface{}) error {
+					if res["privateIpGoogleAccess"] != true {
+						return fmt.Errorf("expected private Google access to be enabled, got %v", res)
+					}
+					return nil
+				}),
+			},
+		},
+	})
+}
+
+func testAccFakeGcp_computeNetworkAndSubnetwork(privateIpGoogleAccess bool) string {
+	return fmt.Sprintf(`
+resource "google_compute_network" "default" {
+  name                    = "tf-test-network"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "default" {
+  name                     = "tf-test-subnetwork"
+  ip_cidr_range            = "10.2.0.0/16"
+  network                  = google_compute_network.default.id
+  private_ip_google_access = %t
+}
+`, privateIpGoogleAccess)
+}
+
+func TestAccFakeGcp_pubsub(t *testing.T) {
+	fake := acctest.FakeGcp(t)
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckFakeGcpDestroyProducer(fake),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFakeGcp_pubsub(""),
+			},
+			{
+				Config: testAccFakeGcp_pubsub(`
+  labels = {
+    env = "test"
+  }
+`),
+				Check: testAccCheckFakeGcpResource(fake, "google_pubsub_topic.default", func(res map[string]interface{}) error {
+					if labels, _ := res["labels"].(map[string]interface{}); labels["env"] != "test" {
+						return fmt.Errorf("expected the topic to be labeled, got %v", res)
+					}
+					return nil
+				}),
+			},
+		},
+	})
+}
+
+func testAccFakeGcp_pubsub(labels string) string {
+	return fmt.Sprintf(`
+resource "google_pubsub_topic" "default" {
+  name = "tf-test-topic"
+%s}
+
+resource "google_pubsub_subscription" "default" {
+  name                 = "tf-test-subscription"
+  topic                = google_pubsub_topic.default.id
+  ack_deadline_seconds = 20
+}
+
+resource "google_pubsub_topic_iam_member" "default" {
+  topic  = google_pubsub_topic.default.id
+  role   = "roles/pubsub.publisher"
+  member = "serviceAccount:publisher@%s.iam.gserviceaccount.com"
+}
+`, labels, acctest.FakeGcpProject)
+}
+
+func TestAccFakeGcp_storage(t *testing.T) {
+	fake := acctest.FakeGcp(t)
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckFakeGcpDestroyProducer(fake),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "google_storage_bucket" "default" {
+  name                        = "tf-test-bucket"
+  location                    = "US"
+  uniform_bucket_level_access = true
+}
+
+resource "google_storage_bucket_object" "default" {
+  bucket  = google_storage_bucket.default.name
+  name    = "path/to/object.txt"
+  content = "Hello, world!"
+}
+
+resource "google_storage_bucket_iam_member" "default" {
+  bucket = google_storage_bucket.default.name
+  role   = "roles/storage.objectViewer"
+  member = "allUsers"
+}
+`,
+				Check: resource.TestCheckResourceAttr("google_storage_bucket_object.default", "md5hash", "bNNVbesNpUvKBgtMOUeYOQ=="),
+			},
+		},
+	})
+}
+
+func TestAccFakeGcp_project(t *testing.T) {
+	fake := acctest.FakeGcp(t)
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckFakeGcpDestroyProducer(fake),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "google_project" "default" {
+  project_id      = "tf-test-project"
+  name            = "tf-test-project"
+  org_id          = "123456789"
+  deletion_policy = "DELETE"
+}
+
+resource "google_project_iam_member" "default" {
+  project = google_project.default.project_id
+  role    = "roles/viewer"
+  member  = "user:viewer@example.com"
+}
+`,
+			},
+		},
+	})
+}
+
+func TestAccFakeGcp_computeInstance(t *testing.T) {
+	fake := acctest.FakeGcp(t)
+	disk := fmt.Sprintf("compute/v1/projects/%s/zones/%s/disks/tf-test-instance", acctest.FakeGcpProject, acctest.FakeGcpZone)
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckFakeGcpDestroyProducer(fake),
+			func(s *terraform.State) error {
+				if _, ok := fake.Resource(disk); ok {
+					return fmt.Errorf("expected the boot disk to be deleted with its instance")
+				}
+				return nil
+			},
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFakeGcp_computeInstance(""),
+				Check: func(s *terraform.State) error {
+					res, ok := fake.Resource(disk)
+					if !ok || res["sourceImage"] != "https://www.googleapis.com/compute/v1/projects/debian-cloud/global/images/debian-12-v20240101" {
+						return fmt.Errorf("expected a boot disk from the latest debian-12 image, got %v", res)
+					}
+					return nil
+				},
+			},
+			{
+				Config: testAccFakeGcp_computeInstance(`
+  labels = {
+    env = "test"
+  }
+
+  metadata = {
+    foo = "bar"
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_instance.default", "labels.env", "test"),
+					resource.TestCheckResourceAttr("google_compute_instance.default", "metadata.foo", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFakeGcp_computeInstance(extra string) string {
+	return fmt.Sprintf(`
+resource "google_compute_network" "default" {
+  name = "tf-test-network"
+}
+
+resource "google_compute_instance" "default" {
+  name         = "tf-test-instance"
+  machine_type = "e2-medium"
+
+  boot_disk {
+    initialize_params {
+      image = "debian-cloud/debian-12"
+    }
+  }
+
+  network_interface {
+    network = google_compute_network.default.self_link
+  }
+%s}
+`, extra)
+}
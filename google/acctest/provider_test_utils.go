@@ -23,6 +23,7 @@ var testAccProvider *schema.Provider
 func init() {
 	configs = make(map[string]*transport_tpg.Config)
 	sources = make(map[string]VcrSource)
+	scrubbers = make(map[string][]VcrScrubber)
 	testAccProvider = provider.Provider()
 	TestAccProviders = map[string]*schema.Provider{
 		"google": testAccProvider,
@@ -55,8 +56,13 @@ func AccTestPreCheck(t *testing.T) {
 		os.Setenv("GOOGLE_CREDENTIALS", string(creds))
 	}
 
-	if v := transport_tpg.MultiEnvSearch(envvar.CredsEnvVars); v == "" {
-		t.Fatalf("One of %s must be set for acceptance tests", strings.Join(envvar.CredsEnvVars, ", "))
+	credsEnvVars := envvar.CredsEnvVars
+	if usesFakeGcp(t) {
+		// Tests against the fake APIs of FakeGcp authenticate with an access token.
+		credsEnvVars = append(append([]string{}, envvar.CredsEnvVars...), "GOOGLE_OAUTH_ACCESS_TOKEN")
+	}
+	if v := transport_tpg.MultiEnvSearch(credsEnvVars); v == "" {
+		t.Fatalf("One of %s must be set for acceptance tests", strings.Join(credsEnvVars, ", "))
 	}
 
 	if v := transport_tpg.MultiEnvSearch(envvar.ProjectEnvVars); v == "" {
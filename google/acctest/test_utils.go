@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-google/google/envvar"
+	"github.com/hashicorp/terraform-provider-google/google/provider"
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
 	"github.com/hashicorp/terraform-provider-google/google/verify"
 	googleoauth "golang.org/x/oauth2/google"
@@ -87,7 +88,7 @@ func MuxedProviders(testName string) (func() tfprotov5.ProviderServer, error) {
 	primary := GetSDKProvider(testName)
 
 	providers := []func() tfprotov5.ProviderServer{
-		primary.GRPCProvider, // sdk provider
+		provider.GRPCProviderServer(primary),                                     // sdk provider
 		providerserver.NewProtocol5(NewFrameworkTestProvider(testName, primary)), // framework provider
 	}
 
@@ -0,0 +1,567 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package acctest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/dnaeon/go-vcr/cassette"
+	"github.com/dnaeon/go-vcr/recorder"
+)
+
+// VcrScrubber replaces sensitive values in the interactions of a VCR cassette
+// once it is recorded. Every occurrence of a scrubbed value in the cassette is
+// replaced, e.g. in the URLs and bodies of later requests, so that the
+// cassette stays consistent when it is replayed.
+type VcrScrubber struct {
+	// Headers are the names of the request and response headers whose
+	// values are scrubbed.
+	Headers []string
+	// JsonPaths are the paths of the string fields of the JSON request and
+	// response bodies whose values are scrubbed. The keys of a path are
+	// separated by dots, and * matches every key of an object or element of
+	// an array, e.g. bindings.*.members.*
+	JsonPaths []string
+	// Replacement is the value the scrubbed values are replaced with. A %d
+	// in Replacement is replaced with a number distinguishing the different
+	// values, e.g. "REDACTED-%d".
+	Replacement string
+}
+
+// VcrScrubbers are the scrubbers applied to the cassette of every test.
+var VcrScrubbers = []VcrScrubber{
+	{
+		Headers:     []string{"Authorization", "X-Goog-Api-Key"},
+		Replacement: "REDACTED",
+	},
+	{
+		JsonPaths:   []string{"access_token", "accessToken", "id_token", "idToken", "refresh_token"},
+		Replacement: "REDACTED-%d",
+	},
+}
+
+// VcrEmailScrubber scrubs the email of the account the provider authenticates
+// as, from its userinfo. Tests whose configuration contains this email can't
+// be replayed with it.
+var VcrEmailScrubber = VcrScrubber{
+	JsonPaths:   []string{"email"},
+	Replacement: "user-%d@example.com",
+}
+
+// VcrProjectNumberScrubber scrubs the project numbers returned by the APIs.
+// Tests whose configuration contains a project number, e.g. from
+// envvar.GetTestProjectNumberFromEnv, can't be replayed with it.
+var VcrProjectNumberScrubber = VcrScrubber{
+	JsonPaths:   []string{"projectNumber", "*.projectNumber"},
+	Replacement: "10000000000%d",
+}
+
+var scrubbersLock = sync.RWMutex{}
+
+var scrubbers map[string][]VcrScrubber
+
+// AddVcrScrubbers adds scrubbers to apply to the cassette of the test, in
+// addition to VcrScrubbers. It must be called before VcrTest.
+func AddVcrScrubbers(t *testing.T, s ...VcrScrubber) {
+	scrubbersLock.Lock()
+	scrubbers[t.Name()] = append(scrubbers[t.Name()], s...)
+	scrubbersLock.Unlock()
+}
+
+func testVcrScrubbers(testName string) []VcrScrubber {
+	scrubbersLock.RLock()
+	defer scrubbersLock.RUnlock()
+	return append(append([]VcrScrubber{}, VcrScrubbers...), scrubbers[testName]...)
+}
+
+// ScrubVcrInteractions applies the scrubbers to the interactions. The values
+// already replaced in a previous scrub, e.g. in the interactions kept by a
+// partial recording, are left as is.
+func ScrubVcrInteractions(interactions []*cassette.Interaction, s ...VcrScrubber) {
+	replacements := map[string]string{}
+	for _, scrubber := range s {
+		template := regexp.MustCompile("^" + strings.ReplaceAll(regexp.QuoteMeta(scrubber.Replacement), "%d", `(\d+)`) + "$")
+		used := map[string]bool{}
+		var values []string
+		collect := func(v string) {
+			if v == "" {
+				return
+			}
+			if m := template.FindStringSubmatch(v); m != nil {
+				if len(m) > 1 {
+					used[m[1]] = true
+				}
+				return
+			}
+			if _, ok := replacements[v]; !ok {
+				replacements[v] = ""
+				values = append(values, v)
+			}
+		}
+		for _, i := range interactions {
+			for _, h := range scrubber.Headers {
+				for _, v := range i.Request.Headers.Values(h) {
+					collect(v)
+				}
+				for _, v := range i.Response.Headers.Values(h) {
+					collect(v)
+				}
+			}
+			for _, p := range scrubber.JsonPaths {
+				for _, body := range []string{i.Request.Body, i.Response.Body} {
+					var v interface{}
+					if json.Unmarshal([]byte(body), &v) != nil {
+						continue
+					}
+					for _, s := range jsonPathStrings(v, strings.Split(p, ".")) {
+						collect(s)
+					}
+				}
+			}
+		}
+		n := 1
+		for _, v := range values {
+			if !strings.Contains(scrubber.Replacement, "%d") {
+				replacements[v] = scrubber.Replacement
+				continue
+			}
+			for used[strconv.Itoa(n)] {
+				n++
+			}
+			replacements[v] = strings.ReplaceAll(scrubber.Replacement, "%d", strconv.Itoa(n))
+			n++
+		}
+	}
+	if len(replacements) == 0 {
+		return
+	}
+
+	// Replace the longest values first, in case a value contains another.
+	var values []string
+	for v := range replacements {
+		values = append(values, v)
+	}
+	sort.Slice(values, func(i, j int) bool {
+		if len(values[i]) != len(values[j]) {
+			return len(values[i]) > len(values[j])
+		}
+		return values[i] < values[j]
+	})
+	var oldnew []string
+	for _, v := range values {
+		oldnew = append(oldnew, v, replacements[v])
+	}
+	replacer := strings.NewReplacer(oldnew...)
+
+	for _, i := range interactions {
+		i.Request.URL = replacer.Replace(i.Request.URL)
+		i.Request.Body = replacer.Replace(i.Request.Body)
+		i.Request.Headers = scrubHeaders(replacer, i.Request.Headers)
+		for k, vs := range i.Request.Form {
+			for j, v := range vs {
+				vs[j] = replacer.Replace(v)
+			}
+			i.Request.Form[k] = vs
+		}
+		i.Response.Body = replacer.Replace(i.Response.Body)
+		i.Response.Headers = scrubHeaders(replacer, i.Response.Headers)
+	}
+}
+
+// scrubHeaders returns a copy of the headers, as the headers of a recorded
+// request are the headers of the request sent.
+func scrubHeaders(replacer *strings.Replacer, headers http.Header) http.Header {
+	if headers == nil {
+		return nil
+	}
+	scrubbed := make(http.Header, len(headers))
+	for k, vs := range headers {
+		for _, v := range vs {
+			scrubbed[k] = append(scrubbed[k], replacer.Replace(v))
+		}
+	}
+	return scrubbed
+}
+
+func jsonPathStrings(v interface{}, path []string) []string {
+	if len(path) == 0 {
+		if s, ok := v.(string); ok {
+			return []string{s}
+		}
+		return nil
+	}
+	var children []interface{}
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if path[0] == "*" {
+			// Sort the keys, for the scrubbed values to be numbered in the
+			// same order every time.
+			var keys []string
+			for k := range v {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				children = append(children, v[k])
+			}
+		} else if child, ok := v[path[0]]; ok {
+			children = append(children, child)
+		}
+	case []interface{}:
+		if path[0] == "*" {
+			children = v
+		} else if i, err := strconv.Atoi(path[0]); err == nil && i >= 0 && i < len(v) {
+			children = append(children, v[i])
+		}
+	}
+	var values []string
+	for _, child := range children {
+		values = append(values, jsonPathStrings(child, path[1:])...)
+	}
+	return values
+}
+
+// vcrRecorder records the HTTP interactions of a test in its cassette, or
+// replays them from it, depending on the VCR mode:
+//   - RECORDING sends every request to the APIs, and records a new cassette.
+//   - REPLAYING replays every request from the cassette. A request that
+//     doesn't match any interaction of the cassette fails, with a report of
+//     its differences with the closest interaction recorded.
+//   - PARTIAL_RECORDING replays the requests that match an interaction of
+//     the cassette, and sends the others to the APIs. The cassette is
+//     recorded again with the interactions replayed and the new ones, so
+//     that only the interactions that no longer match change.
+//
+// The scrubbers of the test are applied to the cassette when it is recorded.
+type vcrRecorder struct {
+	mode     string
+	path     string
+	testName string
+
+	// recorder records the requests in RECORDING mode.
+	recorder *recorder.Recorder
+
+	// cassette is the cassette replayed in the other modes, and transport
+	// sends the requests recorded in PARTIAL_RECORDING mode.
+	cassette  *cassette.Cassette
+	transport http.RoundTripper
+
+	// interactions are the interactions replayed or recorded in
+	// PARTIAL_RECORDING mode, in order.
+	interactionsLock sync.Mutex
+	interactions     []*cassette.Interaction
+}
+
+func newVcrRecorder(ctx context.Context, path, mode, testName string, transport http.RoundTripper) (*vcrRecorder, error) {
+	r := &vcrRecorder{
+		mode:      mode,
+		path:      path,
+		testName:  testName,
+		transport: transport,
+	}
+	// As with go-vcr, a cassette that doesn't exist is recorded.
+	if _, err := os.Stat(path + ".yaml"); mode == "RECORDING" || os.IsNotExist(err) {
+		rec, err := recorder.NewAsMode(path, recorder.ModeRecording, transport)
+		if err != nil {
+			return nil, err
+		}
+		r.mode = "RECORDING"
+		r.recorder = rec
+		return r, nil
+	}
+	c, err := cassette.Load(path)
+	if err != nil {
+		return nil, err
+	}
+	// Defines how VCR will match requests to responses.
+	c.Matcher = NewVcrMatcherFunc(ctx)
+	r.cassette = c
+	return r, nil
+}
+
+func (r *vcrRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if r.recorder != nil {
+		return r.recorder.RoundTrip(req)
+	}
+	if err := req.Context().Err(); err != nil {
+		return nil, err
+	}
+	i, err := r.cassette.GetInteraction(req)
+	if errors.Is(err, cassette.ErrInteractionNotFound) {
+		if r.mode != "PARTIAL_RECORDING" {
+			return nil, fmt.Errorf("%w: %s", err, VcrMismatchReport(req, r.cassette.Interactions))
+		}
+		i, err = recordVcrInteraction(req, r.transport)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if r.mode == "PARTIAL_RECORDING" {
+		r.interactionsLock.Lock()
+		r.interactions = append(r.interactions, i)
+		r.interactionsLock.Unlock()
+	}
+	return vcrInteractionResponse(req, i), nil
+}
+
+// Stop saves the cassette if it was recorded.
+func (r *vcrRecorder) Stop() error {
+	switch {
+	case r.recorder != nil:
+		if err := r.recorder.Stop(); err != nil {
+			return err
+		}
+		c, err := cassette.Load(r.path)
+		if os.IsNotExist(err) {
+			// Cassettes without interactions aren't saved.
+			return nil
+		} else if err != nil {
+			return err
+		}
+		r.cassette = c
+	case r.mode == "PARTIAL_RECORDING":
+		r.interactionsLock.Lock()
+		r.cassette.Interactions = r.interactions
+		r.interactionsLock.Unlock()
+	default:
+		return nil
+	}
+	ScrubVcrInteractions(r.cassette.Interactions, testVcrScrubbers(r.testName)...)
+	if err := r.cassette.Save(); err != nil {
+		return fmt.Errorf("error saving cassette %s: %w", r.cassette.File, err)
+	}
+	return nil
+}
+
+// recordVcrInteraction sends the request, and returns its interaction.
+func recordVcrInteraction(req *http.Request, transport http.RoundTripper) (*cassette.Interaction, error) {
+	var reqBody []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		if reqBody, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+	form := url.Values{}
+	if strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		form, _ = url.ParseQuery(string(reqBody))
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cassette.Interaction{
+		Request: cassette.Request{
+			Body:    string(reqBody),
+			Form:    form,
+			Headers: req.Header,
+			URL:     req.URL.String(),
+			Method:  req.Method,
+		},
+		Response: cassette.Response{
+			Body:    string(respBody),
+			Headers: resp.Header,
+			Status:  resp.Status,
+			Code:    resp.StatusCode,
+		},
+	}, nil
+}
+
+// vcrInteractionResponse returns the response of the interaction, as go-vcr
+// replays it.
+func vcrInteractionResponse(req *http.Request, i *cassette.Interaction) *http.Response {
+	contentLength := int64(len(i.Response.Body))
+	// For HTTP HEAD requests, the ContentLength should be set to the size
+	// of the body that would have been sent for a GET.
+	if req.Method == http.MethodHead {
+		if cl, err := strconv.ParseInt(i.Response.Headers.Get("Content-Length"), 10, 64); err == nil {
+			contentLength = cl
+		}
+	}
+	return &http.Response{
+		Status:        i.Response.Status,
+		StatusCode:    i.Response.Code,
+		Proto:         "HTTP/1.0",
+		ProtoMajor:    1,
+		ProtoMinor:    0,
+		Request:       req,
+		Header:        i.Response.Headers,
+		Close:         true,
+		ContentLength: contentLength,
+		Body:          io.NopCloser(strings.NewReader(i.Response.Body)),
+	}
+}
+
+// VcrMismatchReport describes the differences between a request that doesn't
+// match any interaction of a cassette and the closest interaction recorded,
+// the one with the fewest differences.
+func VcrMismatchReport(r *http.Request, interactions []*cassette.Interaction) string {
+	var body string
+	if r.Body != nil && r.Body != http.NoBody {
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			return fmt.Sprintf("failed to read the request body: %v", err)
+		}
+		r.Body = io.NopCloser(bytes.NewReader(b))
+		body = string(b)
+	}
+	// If body contains media, don't try to compare
+	if strings.Contains(r.Header.Get("Content-Type"), "multipart/related") {
+		body = ""
+	}
+
+	closest := -1
+	var differences []string
+	for n, i := range interactions {
+		d := diffVcrRequest(r.Method, r.URL, body, i.Request)
+		if closest == -1 || len(d) < len(differences) {
+			closest = n
+			differences = d
+		}
+	}
+	switch {
+	case closest == -1:
+		return fmt.Sprintf("the cassette has no interactions for %s %s", r.Method, r.URL)
+	case len(differences) == 0:
+		return fmt.Sprintf("%s %s matches interaction #%d of the cassette, which was already replayed: the test sends this request more times than it was recorded", r.Method, r.URL, closest)
+	}
+	return fmt.Sprintf("%s %s doesn't match any interaction of the cassette, the closest is interaction #%d:\n  %s", r.Method, r.URL, closest, strings.Join(differences, "\n  "))
+}
+
+func diffVcrRequest(method string, u *url.URL, body string, i cassette.Request) []string {
+	var differences []string
+	if method != i.Method {
+		differences = append(differences, fmt.Sprintf("method: recorded %s, requested %s", i.Method, method))
+	}
+	recordedUrl, err := url.Parse(i.URL)
+	if err != nil {
+		recordedUrl = &url.URL{Path: i.URL}
+	}
+	if u.Scheme+"://"+u.Host+u.Path != recordedUrl.Scheme+"://"+recordedUrl.Host+recordedUrl.Path {
+		differences = append(differences, fmt.Sprintf("url: recorded %s, requested %s", recordedUrl.Scheme+"://"+recordedUrl.Host+recordedUrl.Path, u.Scheme+"://"+u.Host+u.Path))
+	}
+	differences = append(differences, diffJson("query", queryJson(recordedUrl.Query()), queryJson(u.Query()))...)
+
+	if body == "" || body == i.Body {
+		return differences
+	}
+	var requested, recorded interface{}
+	if json.Unmarshal([]byte(body), &requested) == nil && json.Unmarshal([]byte(i.Body), &recorded) == nil {
+		return append(differences, diffJson("body", recorded, requested)...)
+	}
+	return append(differences, fmt.Sprintf("body: recorded %s, requested %s", truncateVcrBody(i.Body), truncateVcrBody(body)))
+}
+
+func queryJson(query url.Values) interface{} {
+	v := map[string]interface{}{}
+	for k, vs := range query {
+		if len(vs) == 1 {
+			v[k] = vs[0]
+		} else {
+			v[k] = vs
+		}
+	}
+	return v
+}
+
+func truncateVcrBody(body string) string {
+	if len(body) > 200 {
+		body = body[:200] + "..."
+	}
+	return strconv.Quote(body)
+}
+
+// diffJson returns the differences between the recorded and requested
+// JSON values, one for each field that differs.
+func diffJson(path string, recorded, requested interface{}) []string {
+	switch recorded := recorded.(type) {
+	case map[string]interface{}:
+		requested, ok := requested.(map[string]interface{})
+		if !ok {
+			break
+		}
+		keys := map[string]bool{}
+		for k := range recorded {
+			keys[k] = true
+		}
+		for k := range requested {
+			keys[k] = true
+		}
+		var sorted []string
+		for k := range keys {
+			sorted = append(sorted, k)
+		}
+		sort.Strings(sorted)
+		var differences []string
+		for _, k := range sorted {
+			differences = append(differences, diffJsonField(path+"."+k, recorded, requested, k)...)
+		}
+		return differences
+	case []interface{}:
+		requested, ok := requested.([]interface{})
+		if !ok {
+			break
+		}
+		var differences []string
+		for n := 0; n < len(recorded) || n < len(requested); n++ {
+			p := fmt.Sprintf("%s[%d]", path, n)
+			switch {
+			case n >= len(requested):
+				differences = append(differences, fmt.Sprintf("%s: recorded %s, not requested", p, jsonString(recorded[n])))
+			case n >= len(recorded):
+				differences = append(differences, fmt.Sprintf("%s: not recorded, requested %s", p, jsonString(requested[n])))
+			default:
+				differences = append(differences, diffJson(p, recorded[n], requested[n])...)
+			}
+		}
+		return differences
+	default:
+		if recorded == requested {
+			return nil
+		}
+	}
+	return []string{fmt.Sprintf("%s: recorded %s, requested %s", path, jsonString(recorded), jsonString(requested))}
+}
+
+func diffJsonField(path string, recorded, requested map[string]interface{}, k string) []string {
+	recordedValue, recordedOk := recorded[k]
+	requestedValue, requestedOk := requested[k]
+	switch {
+	case !requestedOk:
+		return []string{fmt.Sprintf("%s: recorded %s, not requested", path, jsonString(recordedValue))}
+	case !recordedOk:
+		return []string{fmt.Sprintf("%s: not recorded, requested %s", path, jsonString(requestedValue))}
+	}
+	return diffJson(path, recordedValue, requestedValue)
+}
+
+func jsonString(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
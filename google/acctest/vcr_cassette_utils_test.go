@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package acctest_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dnaeon/go-vcr/cassette"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestScrubVcrInteractions(t *testing.T) {
+	interactions := []*cassette.Interaction{
+		{
+			Request: cassette.Request{
+				URL:     "https://oauth2.googleapis.com/token",
+				Headers: http.Header{"Authorization": {"Bearer secret"}},
+			},
+			Response: cassette.Response{
+				Body: `{"access_token": "ya29.first", "email": "me@corp.com"}`,
+			},
+		},
+		{
+			Request: cassette.Request{
+				URL:  "https://iam.googleapis.com/v1/projects/p/serviceAccounts/me@corp.com",
+				Body: `{"members": ["user:me@corp.com"]}`,
+			},
+			Response: cassette.Response{
+				Body: `{"access_token": "ya29.second"}`,
+			},
+		},
+	}
+
+	acctest.ScrubVcrInteractions(interactions, append(acctest.VcrScrubbers, acctest.VcrEmailScrubber)...)
+
+	if got := interactions[0].Request.Headers.Get("Authorization"); got != "REDACTED" {
+		t.Errorf("expected the Authorization header to be scrubbed, got %q", got)
+	}
+	if got, want := interactions[0].Response.Body, `{"access_token": "REDACTED-1", "email": "user-1@example.com"}`; got != want {
+		t.Errorf("expected the first response body to be %s, got %s", want, got)
+	}
+	if got, want := interactions[1].Request.URL, "https://iam.googleapis.com/v1/projects/p/serviceAccounts/user-1@example.com"; got != want {
+		t.Errorf("expected the email to be scrubbed everywhere, got url %s", got)
+	}
+	if got, want := interactions[1].Request.Body, `{"members": ["user:user-1@example.com"]}`; got != want {
+		t.Errorf("expected the email to be scrubbed everywhere, got body %s", got)
+	}
+	if got, want := interactions[1].Response.Body, `{"access_token": "REDACTED-2"}`; got != want {
+		t.Errorf("expected the second response body to be %s, got %s", want, got)
+	}
+
+	// Scrubbing again only scrubs the new values, without reusing the
+	// replacements of the values already scrubbed.
+	interactions = append(interactions, &cassette.Interaction{
+		Response: cassette.Response{
+			Body: `{"access_token": "ya29.third"}`,
+		},
+	})
+	acctest.ScrubVcrInteractions(interactions, acctest.VcrScrubbers...)
+	if got, want := interactions[0].Response.Body, `{"access_token": "REDACTED-1", "email": "user-1@example.com"}`; got != want {
+		t.Errorf("expected the first response body to stay %s, got %s", want, got)
+	}
+	if got, want := interactions[2].Response.Body, `{"access_token": "REDACTED-3"}`; got != want {
+		t.Errorf("expected the third response body to be %s, got %s", want, got)
+	}
+}
+
+func TestVcrMismatchReport(t *testing.T) {
+	interactions := []*cassette.Interaction{
+		{
+			Request: cassette.Request{
+				Method: "POST",
+				URL:    "https://compute.googleapis.com/compute/v1/projects/p/global/networks?alt=json",
+				Body:   `{"name": "network", "autoCreateSubnetworks": false}`,
+			},
+		},
+		{
+			Request: cassette.Request{
+				Method: "PATCH",
+				URL:    "https://compute.googleapis.com/compute/v1/projects/p/global/networks/network?alt=json",
+				Body:   `{"mtu": 1460, "routingConfig": {"routingMode": "REGIONAL"}}`,
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		method   string
+		url      string
+		body     string
+		expected []string
+	}{
+		"body field differs": {
+			method: "PATCH",
+			url:    "https://compute.googleapis.com/compute/v1/projects/p/global/networks/network?alt=json",
+			body:   `{"mtu": 1500, "routingConfig": {"routingMode": "GLOBAL"}}`,
+			expected: []string{
+				"the closest is interaction #1",
+				"body.mtu: recorded 1460, requested 1500",
+				`body.routingConfig.routingMode: recorded "REGIONAL", requested "GLOBAL"`,
+			},
+		},
+		"body field missing": {
+			method: "POST",
+			url:    "https://compute.googleapis.com/compute/v1/projects/p/global/networks?alt=json",
+			body:   `{"name": "network", "description": "test"}`,
+			expected: []string{
+				"the closest is interaction #0",
+				"body.autoCreateSubnetworks: recorded false, not requested",
+				`body.description: not recorded, requested "test"`,
+			},
+		},
+		"query differs": {
+			method: "POST",
+			url:    "https://compute.googleapis.com/compute/v1/projects/p/global/networks?alt=media",
+			body:   `{"name": "network", "autoCreateSubnetworks": false}`,
+			expected: []string{
+				"the closest is interaction #0",
+				`query.alt: recorded "json", requested "media"`,
+			},
+		},
+		"matches": {
+			method: "POST",
+			url:    "https://compute.googleapis.com/compute/v1/projects/p/global/networks?alt=json",
+			body:   `{"autoCreateSubnetworks": false, "name": "network"}`,
+			expected: []string{
+				"matches interaction #0 of the cassette, which was already replayed",
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, tc.url, strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			report := acctest.VcrMismatchReport(req, interactions)
+
+			for _, e := range tc.expected {
+				if !strings.Contains(report, e) {
+					t.Errorf("expected the report to contain %q, got:\n%s", e, report)
+				}
+			}
+			// The request body can be read again after the report.
+			if b, _ := io.ReadAll(req.Body); string(b) != tc.body {
+				t.Errorf("expected the request body to be kept, got %q", b)
+			}
+		})
+	}
+}
+
+func TestHandleVCRConfiguration_partialRecording(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"access_token": "new-secret", "path": "` + r.URL.Path + `"}`))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	c := cassette.New(filepath.Join(dir, t.Name()))
+	c.Interactions = []*cassette.Interaction{
+		{
+			Request:  cassette.Request{Method: "GET", URL: server.URL + "/kept"},
+			Response: cassette.Response{Body: `{"path": "/kept", "access_token": "REDACTED-1"}`, Code: 200, Status: "200 OK"},
+		},
+		{
+			Request:  cassette.Request{Method: "GET", URL: server.URL + "/unused"},
+			Response: cassette.Response{Body: `{"path": "/unused"}`, Code: 200, Status: "200 OK"},
+		},
+	}
+	if err := c.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("VCR_PATH", dir)
+	t.Setenv("VCR_MODE", "PARTIAL_RECORDING")
+	_, transport, diags := acctest.HandleVCRConfiguration(context.Background(), t.Name(), http.DefaultTransport, time.Second)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	client := &http.Client{Transport: transport}
+	for _, path := range []string{"/kept", "/new"} {
+		resp, err := client.Get(server.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if !strings.Contains(string(body), `"path": "`+path+`"`) {
+			t.Errorf("unexpected response to %s: %s", path, body)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("expected only the new request to be sent, got %d requests", requests)
+	}
+
+	if err := transport.(interface{ Stop() error }).Stop(); err != nil {
+		t.Fatal(err)
+	}
+	c, err := cassette.Load(filepath.Join(dir, t.Name()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c.Interactions) != 2 {
+		t.Fatalf("expected the cassette to have the kept and the new interactions, got %d interactions", len(c.Interactions))
+	}
+	if got := c.Interactions[0].Request.URL; got != server.URL+"/kept" {
+		t.Errorf("expected the first interaction to be kept, got %s", got)
+	}
+	if got, want := c.Interactions[1].Response.Body, `{"access_token": "REDACTED-2", "path": "/new"}`; got != want {
+		t.Errorf("expected the new interaction to be recorded and scrubbed as %s, got %s", want, got)
+	}
+}
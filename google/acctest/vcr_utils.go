@@ -29,7 +29,6 @@ import (
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
 
 	"github.com/dnaeon/go-vcr/cassette"
-	"github.com/dnaeon/go-vcr/recorder"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	fwDiags "github.com/hashicorp/terraform-plugin-framework/diag"
@@ -80,7 +79,7 @@ func vcrSource(t *testing.T, path, mode string) (*VcrSource, error) {
 		sources[t.Name()] = vcrSource
 		sourcesLock.Unlock()
 		return &vcrSource, nil
-	case "REPLAYING":
+	case "REPLAYING", "PARTIAL_RECORDING":
 		seed, err := readSeedFromFile(vcrSeedFile(path, t.Name()))
 		if err != nil {
 			return nil, fmt.Errorf("no cassette found on disk for %s, please replay this testcase in recording mode - %w", t.Name(), err)
@@ -92,7 +91,7 @@ func vcrSource(t *testing.T, path, mode string) (*VcrSource, error) {
 		sourcesLock.Unlock()
 		return &vcrSource, nil
 	default:
-		log.Printf("[DEBUG] No valid environment var set for VCR_MODE, expected RECORDING or REPLAYING, skipping VCR. VCR_MODE: %s", mode)
+		log.Printf("[DEBUG] No valid environment var set for VCR_MODE, expected RECORDING, REPLAYING or PARTIAL_RECORDING, skipping VCR. VCR_MODE: %s", mode)
 		return nil, errors.New("No valid VCR_MODE set")
 	}
 }
@@ -175,7 +174,7 @@ func closeRecorder(t *testing.T) {
 		// We did not cache the config if it does not use VCR
 		if !t.Failed() && IsVcrEnabled() {
 			// If a test succeeds, write new seed/yaml to files
-			err := config.Client.Transport.(*recorder.Recorder).Stop()
+			err := config.Client.Transport.(*vcrRecorder).Stop()
 			if err != nil {
 				t.Error(err)
 			}
@@ -199,6 +198,10 @@ func closeRecorder(t *testing.T) {
 		sourcesLock.Lock()
 		delete(sources, t.Name())
 		sourcesLock.Unlock()
+
+		scrubbersLock.Lock()
+		delete(scrubbers, t.Name())
+		scrubbersLock.Unlock()
 	}
 }
 
@@ -283,23 +286,21 @@ func reformConfigWithProvider(config, provider string) string {
 	return string(resourceHeader.ReplaceAll(configBytes, providerReplacementBytes))
 }
 
-// HandleVCRConfiguration configures the recorder (github.com/dnaeon/go-vcr/recorder) used in the VCR test
+// HandleVCRConfiguration configures the recorder (see vcrRecorder) used in the VCR test
 // This includes:
 //   - Setting the recording/replaying mode
 //   - Determining the path to the file API interactions will be recorded to/read from
-//   - Determining the logic used to match requests against recorded HTTP interactions (see rec.SetMatcher)
+//   - Determining the logic used to match requests against recorded HTTP interactions (see NewVcrMatcherFunc)
 func HandleVCRConfiguration(ctx context.Context, testName string, rndTripper http.RoundTripper, pollInterval time.Duration) (time.Duration, http.RoundTripper, fwDiags.Diagnostics) {
 	var diags fwDiags.Diagnostics
-	var vcrMode recorder.Mode
-	switch vcrEnv := os.Getenv("VCR_MODE"); vcrEnv {
-	case "RECORDING":
-		vcrMode = recorder.ModeRecording
+	vcrMode := os.Getenv("VCR_MODE")
+	switch vcrMode {
+	case "RECORDING", "PARTIAL_RECORDING":
 	case "REPLAYING":
-		vcrMode = recorder.ModeReplaying
 		// When replaying, set the poll interval low to speed up tests
 		pollInterval = 10 * time.Millisecond
 	default:
-		tflog.Debug(ctx, fmt.Sprintf("No valid environment var set for VCR_MODE, expected RECORDING or REPLAYING, skipping VCR. VCR_MODE: %s", vcrEnv))
+		tflog.Debug(ctx, fmt.Sprintf("No valid environment var set for VCR_MODE, expected RECORDING, REPLAYING or PARTIAL_RECORDING, skipping VCR. VCR_MODE: %s", vcrMode))
 		return pollInterval, rndTripper, diags
 	}
 
@@ -310,13 +311,11 @@ func HandleVCRConfiguration(ctx context.Context, testName string, rndTripper htt
 	}
 	path := filepath.Join(envPath, vcrFileName(testName))
 
-	rec, err := recorder.NewAsMode(path, vcrMode, rndTripper)
+	rec, err := newVcrRecorder(ctx, path, vcrMode, testName, rndTripper)
 	if err != nil {
 		diags.AddError("error creating record as new mode", err.Error())
 		return pollInterval, rndTripper, diags
 	}
-	// Defines how VCR will match requests to responses.
-	rec.SetMatcher(NewVcrMatcherFunc(ctx))
 
 	return pollInterval, rec, diags
 }
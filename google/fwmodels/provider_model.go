@@ -13,6 +13,8 @@ import (
 type ProviderModel struct {
 	Credentials                               types.String `tfsdk:"credentials"`
 	AccessToken                               types.String `tfsdk:"access_token"`
+	CredentialsCommand                        types.List   `tfsdk:"credentials_command"`
+	AccessTokenRefreshCommand                 types.List   `tfsdk:"access_token_refresh_command"`
 	ImpersonateServiceAccount                 types.String `tfsdk:"impersonate_service_account"`
 	ImpersonateServiceAccountDelegates        types.List   `tfsdk:"impersonate_service_account_delegates"`
 	Project                                   types.String `tfsdk:"project"`
@@ -21,11 +23,19 @@ type ProviderModel struct {
 	Zone                                      types.String `tfsdk:"zone"`
 	Scopes                                    types.List   `tfsdk:"scopes"`
 	Batching                                  types.List   `tfsdk:"batching"`
+	Retry                                     types.List   `tfsdk:"retry"`
+	AuditLog                                  types.List   `tfsdk:"audit_log"`
+	Tracing                                   types.List   `tfsdk:"tracing"`
+	RateLimits                                types.List   `tfsdk:"rate_limits"`
 	UserProjectOverride                       types.Bool   `tfsdk:"user_project_override"`
 	RequestTimeout                            types.String `tfsdk:"request_timeout"`
 	RequestReason                             types.String `tfsdk:"request_reason"`
+	ReadOnly                                  types.Bool   `tfsdk:"read_only"`
 	UniverseDomain                            types.String `tfsdk:"universe_domain"`
 	DefaultLabels                             types.Map    `tfsdk:"default_labels"`
+	DefaultLabel                              types.List   `tfsdk:"default_label"`
+	DefaultAnnotations                        types.Map    `tfsdk:"default_annotations"`
+	DefaultResourceTags                       types.Map    `tfsdk:"default_resource_tags"`
 	AddTerraformAttributionLabel              types.Bool   `tfsdk:"add_terraform_attribution_label"`
 	TerraformAttributionLabelAdditionStrategy types.String `tfsdk:"terraform_attribution_label_addition_strategy"`
 
@@ -189,14 +199,116 @@ type ProviderModel struct {
 	GkehubFeatureCustomEndpoint types.String `tfsdk:"gkehub_feature_custom_endpoint"`
 }
 
+type ProviderCredentialsCommand struct {
+	Command types.String `tfsdk:"command"`
+	Args    types.List   `tfsdk:"args"`
+}
+
+var ProviderCredentialsCommandAttributes = map[string]attr.Type{
+	"command": types.StringType,
+	"args":    types.ListType{ElemType: types.StringType},
+}
+
+type ProviderDefaultLabel struct {
+	Key           types.String `tfsdk:"key"`
+	Value         types.String `tfsdk:"value"`
+	ResourceTypes types.List   `tfsdk:"resource_types"`
+	Services      types.List   `tfsdk:"services"`
+	Enforced      types.Bool   `tfsdk:"enforced"`
+}
+
+var ProviderDefaultLabelAttributes = map[string]attr.Type{
+	"key":            types.StringType,
+	"value":          types.StringType,
+	"resource_types": types.ListType{ElemType: types.StringType},
+	"services":       types.ListType{ElemType: types.StringType},
+	"enforced":       types.BoolType,
+}
+
 type ProviderBatching struct {
 	SendAfter      types.String `tfsdk:"send_after"`
 	EnableBatching types.Bool   `tfsdk:"enable_batching"`
+	Iam            types.List   `tfsdk:"iam"`
+}
+
+type ProviderBatchingIam struct {
+	ParentType     types.String `tfsdk:"parent_type"`
+	EnableBatching types.Bool   `tfsdk:"enable_batching"`
+}
+
+var ProviderBatchingIamAttributes = map[string]attr.Type{
+	"parent_type":     types.StringType,
+	"enable_batching": types.BoolType,
 }
 
 var ProviderBatchingAttributes = map[string]attr.Type{
 	"send_after":      types.StringType,
 	"enable_batching": types.BoolType,
+	"iam": types.ListType{
+		ElemType: types.ObjectType{AttrTypes: ProviderBatchingIamAttributes},
+	},
+}
+
+type ProviderRetry struct {
+	MaxAttempts    types.Int64  `tfsdk:"max_attempts"`
+	MaxElapsedTime types.String `tfsdk:"max_elapsed_time"`
+	RetryableError types.List   `tfsdk:"retryable_error"`
+}
+
+type ProviderRetryableError struct {
+	Code         types.Int64  `tfsdk:"code"`
+	Reason       types.String `tfsdk:"reason"`
+	MessageRegex types.String `tfsdk:"message_regex"`
+	Service      types.String `tfsdk:"service"`
+}
+
+var ProviderRetryableErrorAttributes = map[string]attr.Type{
+	"code":          types.Int64Type,
+	"reason":        types.StringType,
+	"message_regex": types.StringType,
+	"service":       types.StringType,
+}
+
+var ProviderRetryAttributes = map[string]attr.Type{
+	"max_attempts":     types.Int64Type,
+	"max_elapsed_time": types.StringType,
+	"retryable_error": types.ListType{
+		ElemType: types.ObjectType{AttrTypes: ProviderRetryableErrorAttributes},
+	},
+}
+
+type ProviderAuditLog struct {
+	Path          types.String `tfsdk:"path"`
+	IncludeBodies types.Bool   `tfsdk:"include_bodies"`
+}
+
+var ProviderAuditLogAttributes = map[string]attr.Type{
+	"path":           types.StringType,
+	"include_bodies": types.BoolType,
+}
+
+type ProviderTracing struct {
+	OTLPEndpoint types.String `tfsdk:"otlp_endpoint"`
+	FilePath     types.String `tfsdk:"file_path"`
+}
+
+var ProviderTracingAttributes = map[string]attr.Type{
+	"otlp_endpoint": types.StringType,
+	"file_path":     types.StringType,
+}
+
+type ProviderRateLimit struct {
+	Service               types.String  `tfsdk:"service"`
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	Burst                 types.Int64   `tfsdk:"burst"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+}
+
+var ProviderRateLimitAttributes = map[string]attr.Type{
+	"service":                 types.StringType,
+	"requests_per_second":     types.Float64Type,
+	"burst":                   types.Int64Type,
+	"max_concurrent_requests": types.Int64Type,
 }
 
 // ProviderMetaModel describes the provider meta model
@@ -7,6 +7,7 @@ import (
 
 	sdk_schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
@@ -22,7 +23,11 @@ import (
 	"github.com/hashicorp/terraform-provider-google/google/functions"
 	"github.com/hashicorp/terraform-provider-google/google/fwmodels"
 	"github.com/hashicorp/terraform-provider-google/google/fwvalidators"
+	"github.com/hashicorp/terraform-provider-google/google/services/kms"
 	"github.com/hashicorp/terraform-provider-google/google/services/resourcemanager"
+	"github.com/hashicorp/terraform-provider-google/google/services/secretmanager"
+	"github.com/hashicorp/terraform-provider-google/google/services/secretmanagerregional"
+	"github.com/hashicorp/terraform-provider-google/google/services/sql"
 	"github.com/hashicorp/terraform-provider-google/version"
 
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
@@ -81,6 +86,7 @@ func (p *FrameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Validators: []validator.String{
 					stringvalidator.ConflictsWith(path.Expressions{
 						path.MatchRoot("access_token"),
+						path.MatchRoot("credentials_command"),
 					}...),
 					fwvalidators.CredentialsValidator(),
 					fwvalidators.NonEmptyStringValidator(),
@@ -91,6 +97,7 @@ func (p *FrameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Validators: []validator.String{
 					stringvalidator.ConflictsWith(path.Expressions{
 						path.MatchRoot("credentials"),
+						path.MatchRoot("credentials_command"),
 					}...),
 					fwvalidators.NonEmptyStringValidator(),
 				},
@@ -146,6 +153,9 @@ func (p *FrameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 			"request_reason": schema.StringAttribute{
 				Optional: true,
 			},
+			"read_only": schema.BoolAttribute{
+				Optional: true,
+			},
 			"universe_domain": schema.StringAttribute{
 				Optional: true,
 			},
@@ -153,6 +163,14 @@ func (p *FrameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Optional:    true,
 				ElementType: types.StringType,
 			},
+			"default_annotations": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"default_resource_tags": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
 			"add_terraform_attribution_label": schema.BoolAttribute{
 				Optional: true,
 			},
@@ -1018,6 +1036,68 @@ func (p *FrameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"credentials_command": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot("credentials"),
+						path.MatchRoot("access_token"),
+					}...),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"command": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								fwvalidators.NonEmptyStringValidator(),
+							},
+						},
+						"args": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+			"access_token_refresh_command": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"command": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								fwvalidators.NonEmptyStringValidator(),
+							},
+						},
+						"args": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+			"default_label": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Required: true,
+						},
+						"value": schema.StringAttribute{
+							Required: true,
+						},
+						"resource_types": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+						"services": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+						"enforced": schema.BoolAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
 			"batching": schema.ListNestedBlock{
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
@@ -1031,6 +1111,109 @@ func (p *FrameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 							Optional: true,
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"iam": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"parent_type": schema.StringAttribute{
+										Required: true,
+									},
+									"enable_batching": schema.BoolAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"retry": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_attempts": schema.Int64Attribute{
+							Optional: true,
+						},
+						"max_elapsed_time": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								fwvalidators.NonNegativeDurationValidator(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"retryable_error": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"code": schema.Int64Attribute{
+										Optional: true,
+									},
+									"reason": schema.StringAttribute{
+										Optional: true,
+									},
+									"message_regex": schema.StringAttribute{
+										Optional: true,
+									},
+									"service": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"audit_log": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								fwvalidators.NonEmptyStringValidator(),
+							},
+						},
+						"include_bodies": schema.BoolAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"tracing": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"otlp_endpoint": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								fwvalidators.NonEmptyStringValidator(),
+							},
+						},
+						"file_path": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								fwvalidators.NonEmptyStringValidator(),
+							},
+						},
+					},
+				},
+			},
+			"rate_limits": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"service": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								fwvalidators.NonEmptyStringValidator(),
+							},
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Optional: true,
+						},
+						"burst": schema.Int64Attribute{
+							Optional: true,
+						},
+						"max_concurrent_requests": schema.Int64Attribute{
+							Optional: true,
+						},
+					},
 				},
 			},
 		},
@@ -1100,5 +1283,10 @@ func (p *FrameworkProvider) EphemeralResources(_ context.Context) []func() ephem
 		resourcemanager.GoogleEphemeralServiceAccountIdToken,
 		resourcemanager.GoogleEphemeralServiceAccountJwt,
 		resourcemanager.GoogleEphemeralServiceAccountKey,
+		resourcemanager.GoogleEphemeralClientConfig,
+		secretmanager.GoogleEphemeralSecretManagerSecretVersionAccess,
+		secretmanagerregional.GoogleEphemeralSecretManagerRegionalSecretVersionAccess,
+		kms.GoogleEphemeralKmsSecret,
+		sql.GoogleEphemeralSqlIamAuthToken,
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -39,14 +40,55 @@ func Provider() *schema.Provider {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ValidateFunc:  ValidateCredentials,
-				ConflictsWith: []string{"access_token"},
+				ConflictsWith: []string{"access_token", "credentials_command"},
 			},
 
 			"access_token": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ValidateFunc:  ValidateEmptyStrings,
-				ConflictsWith: []string{"credentials"},
+				ConflictsWith: []string{"credentials", "credentials_command"},
+			},
+
+			"credentials_command": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"credentials", "access_token"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"command": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: ValidateEmptyStrings,
+						},
+						"args": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"access_token_refresh_command": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"command": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: ValidateEmptyStrings,
+						},
+						"args": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
 			},
 
 			"impersonate_service_account": {
@@ -111,6 +153,132 @@ func Provider() *schema.Provider {
 							Type:     schema.TypeBool,
 							Optional: true,
 						},
+						"iam": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"parent_type": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"enable_batching": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"retry": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_attempts": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"max_elapsed_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidateNonNegativeDuration(),
+						},
+						"retryable_error": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"code": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"reason": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"message_regex": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidateRegexCompiles(),
+									},
+									"service": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"audit_log": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: ValidateEmptyStrings,
+						},
+						"include_bodies": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"tracing": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"otlp_endpoint": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: ValidateEmptyStrings,
+						},
+						"file_path": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: ValidateEmptyStrings,
+						},
+					},
+				},
+			},
+
+			"rate_limits": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: ValidateEmptyStrings,
+						},
+						"requests_per_second": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+						},
+						"burst": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"max_concurrent_requests": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
 					},
 				},
 			},
@@ -130,12 +298,60 @@ func Provider() *schema.Provider {
 				Optional: true,
 			},
 
+			"read_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
 			"default_labels": {
 				Type:     schema.TypeMap,
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"default_annotations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"default_resource_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"default_label": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"resource_types": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"services": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"enforced": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+
 			"add_terraform_attribution_label": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -837,8 +1053,8 @@ func Provider() *schema.Provider {
 			},
 		},
 
-		DataSourcesMap: DatasourceMap(),
-		ResourcesMap:   ResourceMap(),
+		DataSourcesMap: tracedResources(DatasourceMap(), "data."),
+		ResourcesMap:   tracedResources(resumableResources(resourceTypeResources(identityResources(ResourceMap()))), ""),
 	}
 
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
@@ -910,6 +1126,8 @@ func ProviderConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 		config.RequestReason = v.(string)
 	}
 
+	config.ReadOnly = d.Get("read_only").(bool)
+
 	// Check for primary credentials in config. Note that if neither is set, ADCs
 	// will be used if available.
 	if v, ok := d.GetOk("access_token"); ok {
@@ -920,9 +1138,21 @@ func ProviderConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 		config.Credentials = v.(string)
 	}
 
-	// only check environment variables if neither value was set in config- this
+	credentialsCommand, err := transport_tpg.ExpandProviderCredentialsCommand(d.Get("credentials_command"))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	config.CredentialsCommand = credentialsCommand
+
+	accessTokenRefreshCommand, err := transport_tpg.ExpandProviderCredentialsCommand(d.Get("access_token_refresh_command"))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	config.AccessTokenRefreshCommand = accessTokenRefreshCommand
+
+	// only check environment variables if no value was set in config- this
 	// means config beats env var in all cases.
-	if config.AccessToken == "" && config.Credentials == "" {
+	if config.AccessToken == "" && config.Credentials == "" && config.CredentialsCommand == nil {
 		config.Credentials = transport_tpg.MultiEnvSearch([]string{
 			"GOOGLE_CREDENTIALS",
 			"GOOGLE_CLOUD_KEYFILE_JSON",
@@ -984,6 +1214,22 @@ func ProviderConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 		config.DefaultLabels[k] = v.(string)
 	}
 
+	scopedDefaultLabels, err := transport_tpg.ExpandProviderDefaultLabelConfig(d.Get("default_label"))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	config.ScopedDefaultLabels = scopedDefaultLabels
+
+	config.DefaultAnnotations = make(map[string]string)
+	for k, v := range d.Get("default_annotations").(map[string]interface{}) {
+		config.DefaultAnnotations[k] = v.(string)
+	}
+
+	config.DefaultResourceTags = make(map[string]string)
+	for k, v := range d.Get("default_resource_tags").(map[string]interface{}) {
+		config.DefaultResourceTags[k] = v.(string)
+	}
+
 	config.AddTerraformAttributionLabel = d.Get("add_terraform_attribution_label").(bool)
 	if config.AddTerraformAttributionLabel {
 		config.TerraformAttributionLabelAdditionStrategy = transport_tpg.CreateOnlyAttributionStrategy
@@ -1003,6 +1249,41 @@ func ProviderConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 	}
 	config.BatchingConfig = batchCfg
 
+	retryCfg, err := transport_tpg.ExpandProviderRetryConfig(d.Get("retry"))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	for _, retryableError := range retryCfg.RetryableErrors {
+		if retryableError.Service != "" {
+			retryableError.Endpoint = customEndpointForService(d, retryableError.Service)
+		}
+	}
+	config.RetryConfig = retryCfg
+
+	auditLogCfg, err := transport_tpg.ExpandProviderAuditLogConfig(d.Get("audit_log"))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	if auditLogCfg != nil && auditLogCfg.IncludeBodies {
+		auditLogCfg.SensitiveFields = sensitiveFieldNames(p)
+	}
+	config.AuditLogConfig = auditLogCfg
+
+	tracingCfg, err := transport_tpg.ExpandProviderTracingConfig(d.Get("tracing"))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	config.TracingConfig = tracingCfg
+
+	rateLimits, err := transport_tpg.ExpandProviderRateLimits(d.Get("rate_limits"))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	for _, rateLimit := range rateLimits {
+		rateLimit.Endpoint = customEndpointForService(d, rateLimit.Service)
+	}
+	config.RateLimits = rateLimits
+
 	// Generated products
 	config.AccessApprovalBasePath = d.Get("access_approval_custom_endpoint").(string)
 	config.AccessContextManagerBasePath = d.Get("access_context_manager_custom_endpoint").(string)
@@ -1176,6 +1457,46 @@ func ProviderConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 	return &config, nil
 }
 
+// customEndpointForService resolves a service referenced by the name of its
+// custom endpoint field (e.g. "compute") to the configured endpoint. It returns
+// an empty string for services given as an API host or base URL.
+func customEndpointForService(d *schema.ResourceData, service string) string {
+	if endpoint, ok := d.Get(service + "_custom_endpoint").(string); ok {
+		return endpoint
+	}
+	return ""
+}
+
+// sensitiveFieldNames returns the names of all fields marked as Sensitive in
+// the schemas of the provider's resources and data sources.
+func sensitiveFieldNames(p *schema.Provider) []string {
+	names := make(map[string]bool)
+	var walk func(map[string]*schema.Schema)
+	walk = func(s map[string]*schema.Schema) {
+		for k, v := range s {
+			if v.Sensitive {
+				names[k] = true
+			}
+			if r, ok := v.Elem.(*schema.Resource); ok {
+				walk(r.Schema)
+			}
+		}
+	}
+	for _, r := range p.ResourcesMap {
+		walk(r.Schema)
+	}
+	for _, r := range p.DataSourcesMap {
+		walk(r.Schema)
+	}
+
+	result := make([]string, 0, len(names))
+	for k := range names {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+	return result
+}
+
 func mergeResourceMaps(ms ...map[string]*schema.Resource) (map[string]*schema.Resource, error) {
 	merged := make(map[string]*schema.Resource)
 	duplicates := []string{}
@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+// defaultedIdentityFields are the identity fields that can be left out when
+// importing, as they default to the provider's settings like in import ids.
+var defaultedIdentityFields = map[string]bool{
+	"project": true,
+	"region":  true,
+	"zone":    true,
+}
+
+// ImportFormats returns the import id formats of a resource type, as given to
+// tpgresource.ParseImportId by its importer, or nil if they aren't known.
+func ImportFormats(resourceType string) []string {
+	return importFormats[resourceType]
+}
+
+// identityResources returns copies of the given resources with an identity
+// schema derived from their import id formats (see importFormats). The fields
+// of the identity are the fields captured by the first format, e.g. project,
+// location and name.
+//
+// Resources can then be imported with an identity object rather than an id,
+// which is built from the identity with the first format and given to the
+// resource's importer. The identity of a resource is set when it is created or
+// read, and isn't changed afterwards.
+func identityResources(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	withIdentity := make(map[string]*schema.Resource, len(resources))
+	for name, r := range resources {
+		formats := importFormats[name]
+		read := readContextFunc(r)
+		if len(formats) == 0 || r.Identity != nil || r.Importer == nil || read == nil {
+			withIdentity[name] = r
+			continue
+		}
+		fields, err := tpgresource.ImportIdFields(formats[0])
+		if err != nil || len(fields) == 0 {
+			withIdentity[name] = r
+			continue
+		}
+
+		rr := *r
+		rr.Identity = &schema.ResourceIdentity{
+			SchemaFunc: identitySchemaFunc(fields),
+		}
+		rr.Importer = &schema.ResourceImporter{
+			StateContext: identityImportFunc(formats[0], fields, importStateContextFunc(r.Importer)),
+		}
+
+		rr.Read, rr.ReadContext, rr.ReadWithoutTimeout = nil, nil, nil
+		if r.ReadWithoutTimeout != nil {
+			rr.ReadWithoutTimeout = identityReadFunc(formats, fields, read)
+		} else {
+			rr.ReadContext = identityReadFunc(formats, fields, read)
+		}
+		if create := createContextFunc(r); create != nil {
+			rr.Create, rr.CreateContext, rr.CreateWithoutTimeout = nil, nil, nil
+			if r.CreateWithoutTimeout != nil {
+				rr.CreateWithoutTimeout = identityCreateFunc(formats, fields, create)
+			} else {
+				rr.CreateContext = identityCreateFunc(formats, fields, create)
+			}
+		}
+
+		withIdentity[name] = &rr
+	}
+	return withIdentity
+}
+
+func identitySchemaFunc(fields []string) func() map[string]*schema.Schema {
+	return func() map[string]*schema.Schema {
+		s := make(map[string]*schema.Schema, len(fields))
+		for _, field := range fields {
+			s[field] = &schema.Schema{
+				Type:              schema.TypeString,
+				RequiredForImport: !defaultedIdentityFields[field],
+				OptionalForImport: defaultedIdentityFields[field],
+			}
+		}
+		return s
+	}
+}
+
+func importStateContextFunc(importer *schema.ResourceImporter) schema.StateContextFunc {
+	if importer.StateContext != nil {
+		return importer.StateContext
+	}
+	return func(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		return importer.State(d, meta)
+	}
+}
+
+// identityImportFunc builds the import id from the identity when a resource is
+// imported by identity, before calling the resource's importer.
+func identityImportFunc(idFormat string, fields []string, importState schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		if d.Id() != "" {
+			return importState(ctx, d, meta)
+		}
+
+		identity, err := d.Identity()
+		if err != nil {
+			return nil, fmt.Errorf("Error getting identity: %s", err)
+		}
+		config, _ := meta.(*transport_tpg.Config)
+		values := make(map[string]string, len(fields))
+		for _, field := range fields {
+			if v, ok := identity.GetOk(field); ok {
+				values[field] = v.(string)
+			} else if config != nil && defaultedIdentityFields[field] {
+				values[field] = defaultIdentityValue(field, d, config)
+			}
+		}
+
+		id, err := tpgresource.ImportIdFromFields(idFormat, values)
+		if err != nil {
+			return nil, fmt.Errorf("Error building import id from identity: %s", err)
+		}
+		log.Printf("[DEBUG] importing identity %v as id %s", values, id)
+		d.SetId(id)
+		return importState(ctx, d, meta)
+	}
+}
+
+func identityCreateFunc(formats, fields []string, create schema.CreateContextFunc) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := create(ctx, d, meta)
+		if d.Id() == "" || diags.HasError() {
+			return diags
+		}
+		return append(diags, diag.FromErr(setIdentity(formats, fields, d, meta))...)
+	}
+}
+
+func identityReadFunc(formats, fields []string, read schema.ReadContextFunc) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := read(ctx, d, meta)
+		if d.Id() == "" || diags.HasError() {
+			return diags
+		}
+		return append(diags, diag.FromErr(setIdentity(formats, fields, d, meta))...)
+	}
+}
+
+// setIdentity sets the identity of a resource that doesn't have one yet, e.g.
+// as it was just created, imported or created before resources had identities.
+// The values of the identity fields are parsed from the resource's id with its
+// import formats, falling back to the resource's fields and the provider's
+// defaults. The identity is left unset if some of them can't be found.
+func setIdentity(formats, fields []string, d *schema.ResourceData, meta interface{}) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return fmt.Errorf("Error getting identity: %s", err)
+	}
+	for _, field := range fields {
+		if _, ok := identity.GetOk(field); ok {
+			return nil
+		}
+	}
+
+	values := make(map[string]string, len(fields))
+	for _, format := range formats {
+		re, err := regexp.Compile(format)
+		if err != nil {
+			continue
+		}
+		if matches := re.FindStringSubmatch(d.Id()); matches != nil {
+			for i, name := range re.SubexpNames() {
+				if name != "" {
+					values[name] = matches[i]
+				}
+			}
+			break
+		}
+	}
+
+	config, _ := meta.(*transport_tpg.Config)
+	for _, field := range fields {
+		if values[field] != "" {
+			continue
+		}
+		if v, ok := d.GetOk(field); ok {
+			values[field] = fmt.Sprint(v)
+		} else if config != nil && defaultedIdentityFields[field] {
+			values[field] = defaultIdentityValue(field, d, config)
+		}
+		if values[field] == "" {
+			log.Printf("[DEBUG] Not setting identity of %s: no value was found for %s", d.Id(), field)
+			return nil
+		}
+	}
+
+	for _, field := range fields {
+		if err := identity.Set(field, values[field]); err != nil {
+			return fmt.Errorf("Error setting identity %s: %s", field, err)
+		}
+	}
+	return nil
+}
+
+func defaultIdentityValue(field string, d tpgresource.TerraformResourceData, config *transport_tpg.Config) string {
+	var v string
+	switch field {
+	case "project":
+		v, _ = tpgresource.GetProject(d, config)
+	case "region":
+		v, _ = tpgresource.GetRegion(d, config)
+	case "zone":
+		v, _ = tpgresource.GetZone(d, config)
+	}
+	return v
+}
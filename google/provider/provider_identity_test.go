@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+// TestProvider_importFormatsAreCurrent checks that the generated import formats
+// are usable. Whether they match the resources' importers is checked in CI by
+// make importformatscheck, which regenerates them.
+func TestProvider_importFormatsAreCurrent(t *testing.T) {
+	resources := ResourceMap()
+	for name, formats := range importFormats {
+		if _, ok := resources[name]; !ok {
+			t.Errorf("%s has import formats but isn't a resource, run make importformats", name)
+			continue
+		}
+		fields, err := tpgresource.ImportIdFields(formats[0])
+		if err != nil {
+			t.Errorf("%s: invalid import format %q: %s", name, formats[0], err)
+			continue
+		}
+		values := make(map[string]string, len(fields))
+		for _, field := range fields {
+			values[field] = "my-" + field
+		}
+		// Fields with a structure of their own, like a parent's name, don't
+		// match placeholder values, but their format can still be built.
+		if _, err := tpgresource.ImportIdFromFields(formats[0], values); err != nil && !strings.Contains(err.Error(), "doesn't match the format") {
+			t.Errorf("%s: can't build an import id from its identity: %s", name, err)
+		}
+	}
+}
+
+func TestProvider_identityImport(t *testing.T) {
+	r := Provider().ResourcesMap["google_compute_network"]
+	if r.Identity == nil {
+		t.Fatal("google_compute_network has no identity")
+	}
+
+	identitySchema := r.Identity.SchemaMap()
+	if !identitySchema["name"].RequiredForImport || !identitySchema["project"].OptionalForImport {
+		t.Errorf("expected name to be required and project to be optional for import, got %#v", identitySchema)
+	}
+
+	config := &transport_tpg.Config{Project: "default-project"}
+	cases := map[string]struct {
+		Identity   map[string]string
+		ExpectedId string
+	}{
+		"full identity": {
+			Identity:   map[string]string{"project": "my-project", "name": "my-network"},
+			ExpectedId: "projects/my-project/global/networks/my-network",
+		},
+		"default project": {
+			Identity:   map[string]string{"name": "my-network"},
+			ExpectedId: "projects/default-project/global/networks/my-network",
+		},
+	}
+	for tn, tc := range cases {
+		d := r.Data(&terraform.InstanceState{Identity: tc.Identity})
+		imported, err := r.Importer.StateContext(context.Background(), d, config)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+			continue
+		}
+		if len(imported) != 1 || imported[0].Id() != tc.ExpectedId {
+			t.Errorf("%s: expected id %q, got %v", tn, tc.ExpectedId, imported)
+		}
+	}
+}
+
+func TestProvider_setIdentity(t *testing.T) {
+	formats := []string{
+		"projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/instances/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<name>[^/]+)",
+		"(?P<name>[^/]+)",
+	}
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":    {Type: schema.TypeString, Required: true},
+			"project": {Type: schema.TypeString, Optional: true},
+			"zone":    {Type: schema.TypeString, Optional: true},
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: identitySchemaFunc([]string{"project", "zone", "name"}),
+		},
+	}
+	config := &transport_tpg.Config{Project: "default-project", Zone: "us-central1-a"}
+
+	cases := map[string]struct {
+		Id       string
+		State    map[string]string
+		Identity map[string]string
+		Expected map[string]string
+	}{
+		"from id": {
+			Id:       "projects/my-project/zones/us-east1-b/instances/my-instance",
+			Expected: map[string]string{"project": "my-project", "zone": "us-east1-b", "name": "my-instance"},
+		},
+		"from fields": {
+			Id:       "my-instance",
+			State:    map[string]string{"zone": "us-east1-b"},
+			Expected: map[string]string{"project": "default-project", "zone": "us-east1-b", "name": "my-instance"},
+		},
+		"unchanged": {
+			Id:       "projects/my-project/zones/us-east1-b/instances/my-instance",
+			Identity: map[string]string{"project": "other-project", "zone": "us-east1-b", "name": "my-instance"},
+			Expected: map[string]string{"project": "other-project", "zone": "us-east1-b", "name": "my-instance"},
+		},
+	}
+	for tn, tc := range cases {
+		d := r.Data(&terraform.InstanceState{ID: tc.Id, Attributes: tc.State, Identity: tc.Identity})
+		if err := setIdentity(formats, []string{"project", "zone", "name"}, d, config); err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+			continue
+		}
+		identity, err := d.Identity()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for k, v := range tc.Expected {
+			if got := identity.Get(k); got != v {
+				t.Errorf("%s: expected identity %s = %q, got %q", tn, k, v, got)
+			}
+		}
+	}
+}
@@ -0,0 +1,2589 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by scripts/importformats (make importformats). DO NOT EDIT.
+
+package provider
+
+// importFormats are the import id formats of resources, as given to
+// tpgresource.ParseImportId by their importers. The first format is the one
+// their identity schema is derived from.
+var importFormats = map[string][]string{
+	"google_access_context_manager_access_level": {
+		"(?P<name>.+)",
+	},
+	"google_access_context_manager_access_policy": {
+		"^(?P<name>[^/]+)$",
+	},
+	"google_access_context_manager_authorized_orgs_desc": {
+		"(?P<name>.+)",
+	},
+	"google_access_context_manager_gcp_user_access_binding": {
+		"(?P<name>.+)",
+	},
+	"google_access_context_manager_service_perimeter": {
+		"(?P<name>.+)",
+	},
+	"google_active_directory_domain": {
+		"(?P<project>[^ ]+) (?P<name>[^ ]+)",
+		"(?P<name>[^ ]+)",
+	},
+	"google_active_directory_domain_trust": {
+		"^projects/(?P<project>[^/]+)/locations/global/domains/(?P<domain>[^/]+)/(?P<target_domain_name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<domain>[^/]+)/(?P<target_domain_name>[^/]+)$",
+		"^(?P<domain>[^/]+)/(?P<target_domain_name>[^/]+)$",
+	},
+	"google_alloydb_backup": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/backups/(?P<backup_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<backup_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<backup_id>[^/]+)$",
+	},
+	"google_alloydb_cluster": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/clusters/(?P<cluster_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<cluster_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<cluster_id>[^/]+)$",
+		"^(?P<cluster_id>[^/]+)$",
+	},
+	"google_alloydb_instance": {
+		"(?P<cluster>.+)/instances/(?P<instance_id>[^/]+)",
+	},
+	"google_alloydb_user": {
+		"(?P<cluster>.+)/users/(?P<user_id>[^/]+)",
+	},
+	"google_apigee_addons_config": {
+		"(?P<org>.+)",
+	},
+	"google_apigee_api": {
+		"organizations/(?P<org_id>[^/]+)/apis/(?P<name>[^/]+)",
+		"(?P<org_id>[^/]+)/(?P<name>[^/]+)",
+	},
+	"google_apigee_app_group": {
+		"(?P<name>.+)",
+	},
+	"google_apigee_developer": {
+		"(?P<email>.+)",
+	},
+	"google_apigee_endpoint_attachment": {
+		"(?P<name>.+)",
+	},
+	"google_apigee_env_keystore": {
+		"(?P<env_id>.+)/keystores/(?P<name>.+)",
+		"(?P<env_id>.+)/(?P<name>.+)",
+	},
+	"google_apigee_env_references": {
+		"(?P<env_id>.+)/references/(?P<name>.+)",
+		"(?P<env_id>.+)/(?P<name>.+)",
+	},
+	"google_apigee_envgroup": {
+		"(?P<name>.+)",
+	},
+	"google_apigee_envgroup_attachment": {
+		"(?P<envgroup_id>.+)/attachments/(?P<name>.+)",
+		"(?P<envgroup_id>.+)/(?P<name>.+)",
+	},
+	"google_apigee_environment": {
+		"(?P<name>.+)",
+	},
+	"google_apigee_environment_addons_config": {
+		"(?P<env_id>.+)",
+	},
+	"google_apigee_environment_keyvaluemaps": {
+		"(?P<env_id>.+)/keyvaluemaps/(?P<name>.+)",
+		"(?P<env_id>.+)/(?P<name>.+)",
+	},
+	"google_apigee_environment_keyvaluemaps_entries": {
+		"(?P<env_keyvaluemap_id>.+)/entries/(?P<name>.+)",
+		"(?P<env_keyvaluemap_id>.+)/(?P<name>.+)",
+	},
+	"google_apigee_flowhook": {
+		"organizations/(?P<org_id>[^/]+)/environments/(?P<environment>[^/]+)/flowhooks/(?P<flow_hook_point>[^/]+)",
+		"(?P<org_id>[^/]+)/(?P<environment>[^/]+)/(?P<flow_hook_point>[^/]+)",
+	},
+	"google_apigee_instance": {
+		"(?P<name>.+)",
+	},
+	"google_apigee_instance_attachment": {
+		"(?P<instance_id>.+)/attachments/(?P<name>.+)",
+		"(?P<instance_id>.+)/(?P<name>.+)",
+	},
+	"google_apigee_keystores_aliases_key_cert_file": {
+		"organizations/(?P<org_id>[^/]+)/environments/(?P<environment>[^/]+)/keystores/(?P<keystore>[^/]+)/aliases/(?P<alias>[^/]+)",
+		"(?P<org_id>[^/]+)/(?P<environment>[^/]+)/(?P<keystore>[^/]+)/(?P<alias>[^/]+)",
+	},
+	"google_apigee_keystores_aliases_pkcs12": {
+		"organizations/(?P<org_id>[^/]+)/environments/(?P<environment>[^/]+)/keystores/(?P<keystore>[^/]+)/aliases/(?P<alias>[^/]+)",
+		"(?P<org_id>[^/]+)/(?P<environment>[^/]+)/(?P<keystore>[^/]+)/(?P<alias>[^/]+)",
+	},
+	"google_apigee_keystores_aliases_self_signed_cert": {
+		"organizations/(?P<org_id>[^/]+)/environments/(?P<environment>[^/]+)/keystores/(?P<keystore>[^/]+)/aliases/(?P<alias>[^/]+)",
+		"(?P<org_id>[^/]+)/(?P<environment>[^/]+)/(?P<keystore>[^/]+)/(?P<alias>[^/]+)",
+	},
+	"google_apigee_nat_address": {
+		"(?P<instance_id>.+)/natAddresses/(?P<name>.+)",
+		"(?P<instance_id>.+)/(?P<name>.+)",
+	},
+	"google_apigee_organization": {
+		"(?P<name>.+)",
+	},
+	"google_apigee_sharedflow": {
+		"organizations/(?P<org_id>[^/]+)/sharedflows/(?P<name>[^/]+)",
+		"(?P<org_id>[^/]+)/(?P<name>[^/]+)",
+	},
+	"google_apigee_sharedflow_deployment": {
+		"organizations/(?P<org_id>[^/]+)/environments/(?P<environment>[^/]+)/sharedflows/(?P<sharedflow_id>[^/]+)/revisions/(?P<revision>[^/]+)",
+		"(?P<org_id>[^/]+)/(?P<environment>[^/]+)/(?P<sharedflow_id>[^/]+)/(?P<revision>[^/]+)",
+	},
+	"google_apigee_sync_authorization": {
+		"^organizations/(?P<name>[^/]+)/syncAuthorization$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_apigee_target_server": {
+		"(?P<env_id>.+)/targetservers/(?P<name>.+)",
+		"(?P<env_id>.+)/(?P<name>.+)",
+	},
+	"google_apihub_api_hub_instance": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/apiHubInstances/(?P<api_hub_instance_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<api_hub_instance_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<api_hub_instance_id>[^/]+)$",
+	},
+	"google_apikeys_key": {
+		"projects/(?P<project>[^/]+)/locations/global/keys/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<name>[^/]+)",
+		"(?P<name>[^/]+)",
+	},
+	"google_app_engine_application_url_dispatch_rules": {
+		"^(?P<project>[^/]+)$",
+	},
+	"google_app_engine_domain_mapping": {
+		"^apps/(?P<project>[^/]+)/domainMappings/(?P<domain_name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<domain_name>[^/]+)$",
+		"^(?P<domain_name>[^/]+)$",
+	},
+	"google_app_engine_firewall_rule": {
+		"^apps/(?P<project>[^/]+)/firewall/ingressRules/(?P<priority>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<priority>[^/]+)$",
+		"^(?P<priority>[^/]+)$",
+	},
+	"google_app_engine_flexible_app_version": {
+		"^apps/(?P<project>[^/]+)/services/(?P<service>[^/]+)/versions/(?P<version_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<service>[^/]+)/(?P<version_id>[^/]+)$",
+		"^(?P<service>[^/]+)/(?P<version_id>[^/]+)$",
+	},
+	"google_app_engine_service_network_settings": {
+		"^apps/(?P<project>[^/]+)/services/(?P<service>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<service>[^/]+)$",
+		"^(?P<service>[^/]+)$",
+	},
+	"google_app_engine_service_split_traffic": {
+		"^apps/(?P<project>[^/]+)/services/(?P<service>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<service>[^/]+)$",
+		"^(?P<service>[^/]+)$",
+	},
+	"google_app_engine_standard_app_version": {
+		"^apps/(?P<project>[^/]+)/services/(?P<service>[^/]+)/versions/(?P<version_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<service>[^/]+)/(?P<version_id>[^/]+)$",
+		"^(?P<service>[^/]+)/(?P<version_id>[^/]+)$",
+	},
+	"google_apphub_application": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/applications/(?P<application_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<application_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<application_id>[^/]+)$",
+	},
+	"google_apphub_service": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/applications/(?P<application_id>[^/]+)/services/(?P<service_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<application_id>[^/]+)/(?P<service_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<application_id>[^/]+)/(?P<service_id>[^/]+)$",
+	},
+	"google_apphub_service_project_attachment": {
+		"^projects/(?P<project>[^/]+)/locations/global/serviceProjectAttachments/(?P<service_project_attachment_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<service_project_attachment_id>[^/]+)$",
+		"^(?P<service_project_attachment_id>[^/]+)$",
+	},
+	"google_apphub_workload": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/applications/(?P<application_id>[^/]+)/workloads/(?P<workload_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<application_id>[^/]+)/(?P<workload_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<application_id>[^/]+)/(?P<workload_id>[^/]+)$",
+	},
+	"google_artifact_registry_repository": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/repositories/(?P<repository_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<repository_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<repository_id>[^/]+)$",
+	},
+	"google_assured_workloads_workload": {
+		"organizations/(?P<organization>[^/]+)/locations/(?P<location>[^/]+)/workloads/(?P<name>[^/]+)",
+		"(?P<organization>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)",
+	},
+	"google_backup_dr_backup_vault": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/backupVaults/(?P<backup_vault_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<backup_vault_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<backup_vault_id>[^/]+)$",
+	},
+	"google_beyondcorp_app_connection": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<region>[^/]+)/appConnections/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_beyondcorp_app_connector": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<region>[^/]+)/appConnectors/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_beyondcorp_app_gateway": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<region>[^/]+)/appGateways/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_beyondcorp_application": {
+		"^projects/(?P<project>[^/]+)/locations/global/securityGateways/(?P<security_gateways_id>[^/]+)/applications/(?P<application_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<security_gateways_id>[^/]+)/(?P<application_id>[^/]+)$",
+		"^(?P<security_gateways_id>[^/]+)/(?P<application_id>[^/]+)$",
+	},
+	"google_beyondcorp_security_gateway": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/securityGateways/(?P<security_gateway_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<security_gateway_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<security_gateway_id>[^/]+)$",
+	},
+	"google_biglake_catalog": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/catalogs/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_biglake_database": {
+		"^(?P<catalog>.+)/databases/(?P<name>[^/]+)$",
+	},
+	"google_biglake_table": {
+		"^(?P<database>.+)/tables/(?P<name>[^/]+)$",
+	},
+	"google_bigquery_analytics_hub_data_exchange": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/dataExchanges/(?P<data_exchange_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<data_exchange_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<data_exchange_id>[^/]+)$",
+		"^(?P<data_exchange_id>[^/]+)$",
+	},
+	"google_bigquery_analytics_hub_listing": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/dataExchanges/(?P<data_exchange_id>[^/]+)/listings/(?P<listing_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<data_exchange_id>[^/]+)/(?P<listing_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<data_exchange_id>[^/]+)/(?P<listing_id>[^/]+)$",
+	},
+	"google_bigquery_analytics_hub_listing_subscription": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/subscriptions/(?P<subscription_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<subscription_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<subscription_id>[^/]+)$",
+	},
+	"google_bigquery_bi_reservation": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/biReservation$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)$",
+		"^(?P<location>[^/]+)$",
+	},
+	"google_bigquery_capacity_commitment": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/capacityCommitments/(?P<capacity_commitment_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<capacity_commitment_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<capacity_commitment_id>[^/]+)$",
+	},
+	"google_bigquery_connection": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/connections/(?P<connection_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<connection_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<connection_id>[^/]+)$",
+	},
+	"google_bigquery_data_transfer_config": {
+		"(?P<project>[^ ]+) (?P<name>[^ ]+)",
+		"(?P<name>[^ ]+)",
+	},
+	"google_bigquery_datapolicy_data_policy": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/dataPolicies/(?P<data_policy_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<data_policy_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<data_policy_id>[^/]+)$",
+	},
+	"google_bigquery_dataset": {
+		"^projects/(?P<project>[^/]+)/datasets/(?P<dataset_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<dataset_id>[^/]+)$",
+		"^(?P<dataset_id>[^/]+)$",
+	},
+	"google_bigquery_job": {
+		"^projects/(?P<project>[^/]+)/jobs/(?P<job_id>[^/]+)/location/(?P<location>[^/]+)$",
+		"^projects/(?P<project>[^/]+)/jobs/(?P<job_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<job_id>[^/]+)/(?P<location>[^/]+)$",
+		"^(?P<job_id>[^/]+)/(?P<location>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<job_id>[^/]+)$",
+		"^(?P<job_id>[^/]+)$",
+	},
+	"google_bigquery_reservation": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/reservations/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_bigquery_reservation_assignment": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/reservations/(?P<reservation>[^/]+)/assignments/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<reservation>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<reservation>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_bigquery_routine": {
+		"^projects/(?P<project>[^/]+)/datasets/(?P<dataset_id>[^/]+)/routines/(?P<routine_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<dataset_id>[^/]+)/(?P<routine_id>[^/]+)$",
+		"^(?P<dataset_id>[^/]+)/(?P<routine_id>[^/]+)$",
+	},
+	"google_bigquery_table": {
+		"projects/(?P<project>[^/]+)/datasets/(?P<dataset_id>[^/]+)/tables/(?P<table_id>[^/]+)",
+		"(?P<project>[^/]+)/(?P<dataset_id>[^/]+)/(?P<table_id>[^/]+)",
+		"(?P<dataset_id>[^/]+)/(?P<table_id>[^/]+)",
+	},
+	"google_bigtable_app_profile": {
+		"^projects/(?P<project>[^/]+)/instances/(?P<instance>[^/]+)/appProfiles/(?P<app_profile_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<instance>[^/]+)/(?P<app_profile_id>[^/]+)$",
+		"^(?P<instance>[^/]+)/(?P<app_profile_id>[^/]+)$",
+	},
+	"google_bigtable_authorized_view": {
+		"projects/(?P<project>[^/]+)/instances/(?P<instance_name>[^/]+)/tables/(?P<table_name>[^/]+)/authorizedViews/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<instance_name>[^/]+)/(?P<table_name>[^/]+)/(?P<name>[^/]+)",
+		"(?P<instance_name>[^/]+)/(?P<table_name>[^/]+)/(?P<name>[^/]+)",
+	},
+	"google_bigtable_instance": {
+		"projects/(?P<project>[^/]+)/instances/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<name>[^/]+)",
+		"(?P<name>[^/]+)",
+	},
+	"google_bigtable_table": {
+		"projects/(?P<project>[^/]+)/instances/(?P<instance_name>[^/]+)/tables/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<instance_name>[^/]+)/(?P<name>[^/]+)",
+		"(?P<instance_name>[^/]+)/(?P<name>[^/]+)",
+	},
+	"google_billing_budget": {
+		"^billingAccounts/(?P<billing_account>[^/]+)/budgets/(?P<name>[^/]+)$",
+		"^(?P<billing_account>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_billing_project_info": {
+		"^projects/(?P<project>.+)$",
+		"^(?P<project>.+)$",
+	},
+	"google_binary_authorization_attestor": {
+		"^projects/(?P<project>[^/]+)/attestors/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_binary_authorization_policy": {
+		"^projects/(?P<project>[^/]+)$",
+		"^(?P<project>[^/]+)$",
+	},
+	"google_blockchain_node_engine_blockchain_nodes": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/blockchainNodes/(?P<blockchain_node_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<blockchain_node_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<blockchain_node_id>[^/]+)$",
+	},
+	"google_certificate_manager_certificate": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/certificates/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_certificate_manager_certificate_issuance_config": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/certificateIssuanceConfigs/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_certificate_manager_certificate_map": {
+		"^projects/(?P<project>[^/]+)/locations/global/certificateMaps/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_certificate_manager_certificate_map_entry": {
+		"^projects/(?P<project>[^/]+)/locations/global/certificateMaps/(?P<map>[^/]+)/certificateMapEntries/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<map>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<map>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_certificate_manager_dns_authorization": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/dnsAuthorizations/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_certificate_manager_trust_config": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/trustConfigs/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_cloud_identity_group": {
+		"(?P<name>.+)",
+	},
+	"google_cloud_identity_group_membership": {
+		"^(?P<name>.+)$",
+	},
+	"google_cloud_ids_endpoint": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/endpoints/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_cloud_quotas_quota_preference": {
+		"^(?P<parent>.+)/locations/global/quotaPreferences/(?P<name>[^/]+)$",
+	},
+	"google_cloud_run_domain_mapping": {
+		"^locations/(?P<location>[^/]+)/namespaces/(?P<project>[^/]+)/domainmappings/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_cloud_run_service": {
+		"^locations/(?P<location>[^/]+)/namespaces/(?P<project>[^/]+)/services/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_cloud_run_v2_job": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/jobs/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_cloud_run_v2_service": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/services/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_cloud_scheduler_job": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<region>[^/]+)/jobs/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_cloud_tasks_queue": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/queues/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_cloudbuild_bitbucket_server_config": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/bitbucketServerConfigs/(?P<config_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<config_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<config_id>[^/]+)$",
+	},
+	"google_cloudbuild_trigger": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/triggers/(?P<trigger_id>[^/]+)$",
+		"^projects/(?P<project>[^/]+)/triggers/(?P<trigger_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<trigger_id>[^/]+)$",
+		"^(?P<trigger_id>[^/]+)$",
+	},
+	"google_cloudbuild_worker_pool": {
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/workerPools/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<name>[^/]+)",
+	},
+	"google_cloudbuildv2_connection": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/connections/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_cloudbuildv2_repository": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/connections/(?P<parent_connection>[^/]+)/repositories/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<parent_connection>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<parent_connection>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_clouddeploy_automation": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/deliveryPipelines/(?P<delivery_pipeline>[^/]+)/automations/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<delivery_pipeline>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<delivery_pipeline>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_clouddeploy_custom_target_type": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/customTargetTypes/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_clouddeploy_delivery_pipeline": {
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/deliveryPipelines/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<name>[^/]+)",
+	},
+	"google_clouddeploy_target": {
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/targets/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<name>[^/]+)",
+	},
+	"google_clouddomains_registration": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/registrations/(?P<domain_name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<domain_name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<domain_name>[^/]+)$",
+	},
+	"google_cloudfunctions2_function": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/functions/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_colab_notebook_execution": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/notebookExecutionJobs/(?P<notebook_execution_job_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<notebook_execution_job_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<notebook_execution_job_id>[^/]+)$",
+	},
+	"google_colab_runtime": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/notebookRuntimes/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_colab_runtime_template": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/notebookRuntimeTemplates/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_colab_schedule": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/schedules/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_composer_environment": {
+		"projects/(?P<project>[^/]+)/locations/(?P<region>[^/]+)/environments/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)",
+		"(?P<name>[^/]+)",
+	},
+	"google_composer_user_workloads_config_map": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<region>[^/]+)/environments/(?P<environment>[^/]+)/userWorkloadsConfigMaps/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<environment>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<environment>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<environment>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_composer_user_workloads_secret": {
+		"projects/(?P<project>[^/]+)/locations/(?P<region>[^/]+)/environments/(?P<environment>[^/]+)/userWorkloadsSecrets/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<environment>[^/]+)/(?P<name>[^/]+)",
+		"(?P<environment>[^/]+)/(?P<name>[^/]+)",
+	},
+	"google_compute_address": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/addresses/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_attached_disk": {
+		"projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/instances/(?P<instance>[^/]+)/(?P<disk>[^/]+)",
+		"(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<instance>[^/]+)/(?P<disk>[^/]+)",
+	},
+	"google_compute_autoscaler": {
+		"^projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/autoscalers/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<zone>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_backend_bucket": {
+		"^projects/(?P<project>[^/]+)/global/backendBuckets/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_backend_service": {
+		"^projects/(?P<project>[^/]+)/global/backendServices/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_disk": {
+		"^projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/disks/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<zone>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_disk_resource_policy_attachment": {
+		"^projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/disks/(?P<disk>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<disk>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<zone>[^/]+)/(?P<disk>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<disk>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_compute_external_vpn_gateway": {
+		"^projects/(?P<project>[^/]+)/global/externalVpnGateways/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_firewall": {
+		"^projects/(?P<project>[^/]+)/global/firewalls/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_firewall_policy": {
+		"locations/global/firewallPolicies/(?P<name>[^/]+)",
+		"(?P<name>[^/]+)",
+	},
+	"google_compute_firewall_policy_association": {
+		"^locations/global/firewallPolicies/(?P<firewall_policy>[^/]+)/associations/(?P<name>[^/]+)$",
+		"^(?P<firewall_policy>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_compute_firewall_policy_rule": {
+		"^locations/global/firewallPolicies/(?P<firewall_policy>[^/]+)/rules/(?P<priority>[^/]+)$",
+		"^(?P<firewall_policy>[^/]+)/(?P<priority>[^/]+)$",
+	},
+	"google_compute_forwarding_rule": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/forwardingRules/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_global_address": {
+		"^projects/(?P<project>[^/]+)/global/addresses/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_global_forwarding_rule": {
+		"^projects/(?P<project>[^/]+)/global/forwardingRules/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_global_network_endpoint": {
+		"projects/(?P<project>[^/]+)/global/networkEndpointGroups/(?P<global_network_endpoint_group>[^/]+)/(?P<ip_address>[^/]*)/(?P<fqdn>[^/]*)/(?P<port>[^/]+)",
+		"(?P<project>[^/]+)/(?P<global_network_endpoint_group>[^/]+)/(?P<ip_address>[^/]*)/(?P<fqdn>[^/]*)/(?P<port>[^/]*)",
+		"(?P<global_network_endpoint_group>[^/]+)/(?P<ip_address>[^/]*)/(?P<fqdn>[^/]*)/(?P<port>[^/]*)",
+	},
+	"google_compute_global_network_endpoint_group": {
+		"^projects/(?P<project>[^/]+)/global/networkEndpointGroups/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_ha_vpn_gateway": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/vpnGateways/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_health_check": {
+		"^projects/(?P<project>[^/]+)/global/healthChecks/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_http_health_check": {
+		"^projects/(?P<project>[^/]+)/global/httpHealthChecks/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_https_health_check": {
+		"^projects/(?P<project>[^/]+)/global/httpsHealthChecks/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_image": {
+		"^projects/(?P<project>[^/]+)/global/images/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_instance": {
+		"projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/instances/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<name>[^/]+)",
+		"(?P<name>[^/]+)",
+	},
+	"google_compute_instance_group": {
+		"projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/instanceGroups/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<name>[^/]+)",
+		"(?P<zone>[^/]+)/(?P<name>[^/]+)",
+	},
+	"google_compute_instance_group_manager": {
+		"projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/instanceGroupManagers/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<name>[^/]+)",
+		"(?P<name>[^/]+)",
+	},
+	"google_compute_instance_group_membership": {
+		"projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/instanceGroups/(?P<instance_group>[^/]+)/(?P<instance>.+)",
+		"(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<instance_group>[^/]+)/(?P<instance>.+)",
+		"(?P<zone>[^/]+)/(?P<instance_group>[^/]+)/(?P<instance>.+)",
+		"(?P<instance_group>[^/]+)/(?P<instance>.+)",
+	},
+	"google_compute_instance_group_named_port": {
+		"^projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/instanceGroups/(?P<group>[^/]+)/(?P<port>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<group>[^/]+)/(?P<port>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<zone>[^/]+)/(?P<group>[^/]+)/(?P<port>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<group>[^/]+)/(?P<port>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_compute_instance_settings": {
+		"^projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/instanceSettings$",
+		"^(?P<project>[^/]+)/(?P<zone>[^/]+)$",
+		"^(?P<zone>[^/]+)$",
+	},
+	"google_compute_instance_template": {
+		"projects/(?P<project>[^/]+)/global/instanceTemplates/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<name>[^/]+)",
+		"(?P<name>[^/]+)",
+	},
+	"google_compute_interconnect": {
+		"^projects/(?P<project>[^/]+)/global/interconnects/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_interconnect_attachment": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/interconnectAttachments/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_managed_ssl_certificate": {
+		"^projects/(?P<project>[^/]+)/global/sslCertificates/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_network": {
+		"^projects/(?P<project>[^/]+)/global/networks/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_network_attachment": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/networkAttachments/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_network_endpoint": {
+		"projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/networkEndpointGroups/(?P<network_endpoint_group>[^/]+)/(?P<instance>[^/]*)/(?P<ip_address>[^/]+)/(?P<port>[^/]+)",
+		"(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<network_endpoint_group>[^/]+)/(?P<instance>[^/]*)/(?P<ip_address>[^/]+)/(?P<port>[^/]+)",
+		"(?P<zone>[^/]+)/(?P<network_endpoint_group>[^/]+)/(?P<instance>[^/]*)/(?P<ip_address>[^/]+)/(?P<port>[^/]+)",
+		"(?P<network_endpoint_group>[^/]+)/(?P<instance>[^/]*)/(?P<ip_address>[^/]+)/(?P<port>[^/]+)",
+	},
+	"google_compute_network_endpoint_group": {
+		"^projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/networkEndpointGroups/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<zone>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_network_endpoints": {
+		"^projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/networkEndpointGroups/(?P<network_endpoint_group>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<network_endpoint_group>[^/]+)$",
+		"^(?P<zone>[^/]+)/(?P<network_endpoint_group>[^/]+)$",
+		"^(?P<network_endpoint_group>[^/]+)$",
+	},
+	"google_compute_network_firewall_policy": {
+		"^projects/(?P<project>[^/]+)/global/firewallPolicies/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_network_firewall_policy_association": {
+		"^projects/(?P<project>[^/]+)/global/firewallPolicies/(?P<firewall_policy>[^/]+)/associations/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<firewall_policy>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<firewall_policy>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_compute_network_firewall_policy_rule": {
+		"^projects/(?P<project>[^/]+)/global/firewallPolicies/(?P<firewall_policy>[^/]+)/rules/(?P<priority>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<firewall_policy>[^/]+)/(?P<priority>[^/]+)$",
+		"^(?P<firewall_policy>[^/]+)/(?P<priority>[^/]+)$",
+	},
+	"google_compute_network_peering_routes_config": {
+		"^projects/(?P<project>[^/]+)/global/networks/(?P<network>[^/]+)/networkPeerings/(?P<peering>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<network>[^/]+)/(?P<peering>[^/]+)$",
+		"^(?P<network>[^/]+)/(?P<peering>[^/]+)$",
+	},
+	"google_compute_node_group": {
+		"^projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/nodeGroups/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<zone>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_node_template": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/nodeTemplates/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_packet_mirroring": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/packetMirrorings/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_per_instance_config": {
+		"^projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/instanceGroupManagers/(?P<instance_group_manager>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<instance_group_manager>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<zone>[^/]+)/(?P<instance_group_manager>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<instance_group_manager>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_compute_project_cloud_armor_tier": {
+		"^projects/(?P<project>[^/]+)$",
+		"^(?P<project>[^/]+)$",
+	},
+	"google_compute_project_metadata_item": {
+		"projects/(?P<project>[^/]+)/meta-data/(?P<key>[^/]+)",
+		"(?P<key>[^/]+)",
+	},
+	"google_compute_public_advertised_prefix": {
+		"^projects/(?P<project>[^/]+)/global/publicAdvertisedPrefixes/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_public_delegated_prefix": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/publicDelegatedPrefixes/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_region_autoscaler": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/autoscalers/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_region_backend_service": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/backendServices/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_region_commitment": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/commitments/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_region_disk": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/disks/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_region_disk_resource_policy_attachment": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/disks/(?P<disk>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<disk>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<disk>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<disk>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_compute_region_health_check": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/healthChecks/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_region_instance_group_manager": {
+		"projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/instanceGroupManagers/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)",
+		"(?P<region>[^/]+)/(?P<name>[^/]+)",
+		"(?P<name>[^/]+)",
+	},
+	"google_compute_region_instance_template": {
+		"projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/instanceTemplates/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)",
+		"(?P<region>[^/]+)/(?P<name>[^/]+)",
+		"(?P<name>[^/]+)",
+	},
+	"google_compute_region_network_endpoint": {
+		"projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/networkEndpointGroups/(?P<region_network_endpoint_group>[^/]+)/(?P<ip_address>[^/]*)/(?P<fqdn>[^/]*)/(?P<port>[^/]+)",
+		"(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<region_network_endpoint_group>[^/]+)/(?P<ip_address>[^/]*)/(?P<fqdn>[^/]*)/(?P<port>[^/]+)",
+		"(?P<region>[^/]+)/(?P<region_network_endpoint_group>[^/]+)/(?P<ip_address>[^/]*)/(?P<fqdn>[^/]*)/(?P<port>[^/]+)",
+		"(?P<region_network_endpoint_group>[^/]+)/(?P<ip_address>[^/]*)/(?P<fqdn>[^/]*)/(?P<port>[^/]+)",
+	},
+	"google_compute_region_network_endpoint_group": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/networkEndpointGroups/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_region_network_firewall_policy": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/firewallPolicies/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_region_network_firewall_policy_association": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/firewallPolicies/(?P<firewall_policy>[^/]+)/associations/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<firewall_policy>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<firewall_policy>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<firewall_policy>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<firewall_policy>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_compute_region_network_firewall_policy_rule": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/firewallPolicies/(?P<firewall_policy>[^/]+)/(?P<priority>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<firewall_policy>[^/]+)/(?P<priority>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<firewall_policy>[^/]+)/(?P<priority>[^/]+)$",
+		"^(?P<firewall_policy>[^/]+)/(?P<priority>[^/]+)$",
+	},
+	"google_compute_region_per_instance_config": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/instanceGroupManagers/(?P<region_instance_group_manager>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<region_instance_group_manager>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<region_instance_group_manager>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region_instance_group_manager>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_compute_region_ssl_certificate": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/sslCertificates/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_region_ssl_policy": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/sslPolicies/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_region_target_http_proxy": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/targetHttpProxies/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_region_target_https_proxy": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/targetHttpsProxies/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_region_target_tcp_proxy": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/targetTcpProxies/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_region_url_map": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/urlMaps/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_reservation": {
+		"^projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/reservations/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<zone>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_resize_request": {
+		"^projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/instanceGroupManagers/(?P<instance_group_manager>[^/]+)/resizeRequests/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<instance_group_manager>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<zone>[^/]+)/(?P<instance_group_manager>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<instance_group_manager>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_compute_resource_policy": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/resourcePolicies/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_route": {
+		"^projects/(?P<project>[^/]+)/global/routes/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_router": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/routers/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_router_nat": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/routers/(?P<router>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<router>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<router>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<router>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_compute_router_nat_address": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/routers/(?P<router>[^/]+)/(?P<router_nat>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<router>[^/]+)/(?P<router_nat>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<router>[^/]+)/(?P<router_nat>[^/]+)$",
+		"^(?P<router>[^/]+)/(?P<router_nat>[^/]+)$",
+	},
+	"google_compute_router_peer": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/routers/(?P<router>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<router>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<router>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<router>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_compute_security_policy": {
+		"projects/(?P<project>[^/]+)/global/securityPolicies/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<name>[^/]+)",
+		"(?P<name>[^/]+)",
+	},
+	"google_compute_security_policy_rule": {
+		"^projects/(?P<project>[^/]+)/global/securityPolicies/(?P<security_policy>[^/]+)/priority/(?P<priority>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<security_policy>[^/]+)/(?P<priority>[^/]+)$",
+		"^(?P<security_policy>[^/]+)/(?P<priority>[^/]+)$",
+	},
+	"google_compute_service_attachment": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/serviceAttachments/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_snapshot": {
+		"^projects/(?P<project>[^/]+)/global/snapshots/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_ssl_certificate": {
+		"^projects/(?P<project>[^/]+)/global/sslCertificates/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_ssl_policy": {
+		"^projects/(?P<project>[^/]+)/global/sslPolicies/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_subnetwork": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/subnetworks/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_target_grpc_proxy": {
+		"^projects/(?P<project>[^/]+)/global/targetGrpcProxies/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_target_http_proxy": {
+		"^projects/(?P<project>[^/]+)/global/targetHttpProxies/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_target_https_proxy": {
+		"^projects/(?P<project>[^/]+)/global/targetHttpsProxies/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_target_instance": {
+		"^projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/targetInstances/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<zone>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_target_pool": {
+		"projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/targetPools/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)",
+		"(?P<region>[^/]+)/(?P<name>[^/]+)",
+		"(?P<name>[^/]+)",
+	},
+	"google_compute_target_ssl_proxy": {
+		"^projects/(?P<project>[^/]+)/global/targetSslProxies/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_target_tcp_proxy": {
+		"^projects/(?P<project>[^/]+)/global/targetTcpProxies/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_url_map": {
+		"^projects/(?P<project>[^/]+)/global/urlMaps/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_vpn_gateway": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/targetVpnGateways/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_compute_vpn_tunnel": {
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/vpnTunnels/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_container_analysis_note": {
+		"^projects/(?P<project>[^/]+)/notes/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_container_analysis_occurrence": {
+		"^projects/(?P<project>[^/]+)/occurrences/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_container_attached_cluster": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/attachedClusters/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_container_aws_cluster": {
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/awsClusters/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<name>[^/]+)",
+	},
+	"google_container_aws_node_pool": {
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/awsClusters/(?P<cluster>[^/]+)/awsNodePools/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<cluster>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<cluster>[^/]+)/(?P<name>[^/]+)",
+	},
+	"google_container_azure_client": {
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/azureClients/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<name>[^/]+)",
+	},
+	"google_container_azure_cluster": {
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/azureClusters/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<name>[^/]+)",
+	},
+	"google_container_azure_node_pool": {
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/azureClusters/(?P<cluster>[^/]+)/azureNodePools/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<cluster>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<cluster>[^/]+)/(?P<name>[^/]+)",
+	},
+	"google_container_cluster": {
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/clusters/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<name>[^/]+)",
+	},
+	"google_container_node_pool": {
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/clusters/(?P<cluster>[^/]+)/nodePools/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<cluster>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<cluster>[^/]+)/(?P<name>[^/]+)",
+	},
+	"google_data_catalog_entry": {
+		"(?P<name>.+)",
+	},
+	"google_data_catalog_entry_group": {
+		"(?P<name>.+)",
+	},
+	"google_data_catalog_policy_tag": {
+		"(?P<taxonomy>projects/[^/]+/locations/[^/]+/taxonomies/[^/]+)/policyTags/(?P<name>.+)",
+	},
+	"google_data_catalog_tag": {
+		"(?P<name>.+)",
+	},
+	"google_data_catalog_tag_template": {
+		"(?P<name>.+)",
+	},
+	"google_data_catalog_taxonomy": {
+		"(?P<name>.+)",
+	},
+	"google_data_fusion_instance": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<region>[^/]+)/instances/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_data_loss_prevention_deidentify_template": {
+		"(?P<name>.+)",
+	},
+	"google_data_loss_prevention_discovery_config": {
+		"(?P<name>.+)",
+	},
+	"google_data_loss_prevention_inspect_template": {
+		"(?P<name>.+)",
+	},
+	"google_data_loss_prevention_job_trigger": {
+		"(?P<name>.+)",
+	},
+	"google_data_loss_prevention_stored_info_type": {
+		"(?P<name>.+)",
+	},
+	"google_data_pipeline_pipeline": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<region>[^/]+)/pipelines/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_database_migration_service_connection_profile": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/connectionProfiles/(?P<connection_profile_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<connection_profile_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<connection_profile_id>[^/]+)$",
+	},
+	"google_database_migration_service_migration_job": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/migrationJobs/(?P<migration_job_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<migration_job_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<migration_job_id>[^/]+)$",
+	},
+	"google_database_migration_service_private_connection": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/privateConnections/(?P<private_connection_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<private_connection_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<private_connection_id>[^/]+)$",
+	},
+	"google_dataplex_aspect_type": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/aspectTypes/(?P<aspect_type_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<aspect_type_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<aspect_type_id>[^/]+)$",
+	},
+	"google_dataplex_asset": {
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/lakes/(?P<lake>[^/]+)/zones/(?P<dataplex_zone>[^/]+)/assets/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<lake>[^/]+)/(?P<dataplex_zone>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<lake>[^/]+)/(?P<dataplex_zone>[^/]+)/(?P<name>[^/]+)",
+	},
+	"google_dataplex_datascan": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/dataScans/(?P<data_scan_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<data_scan_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<data_scan_id>[^/]+)$",
+		"^(?P<data_scan_id>[^/]+)$",
+	},
+	"google_dataplex_entry_group": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/entryGroups/(?P<entry_group_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<entry_group_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<entry_group_id>[^/]+)$",
+	},
+	"google_dataplex_entry_type": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/entryTypes/(?P<entry_type_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<entry_type_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<entry_type_id>[^/]+)$",
+	},
+	"google_dataplex_lake": {
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/lakes/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<name>[^/]+)",
+	},
+	"google_dataplex_task": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/lakes/(?P<lake>[^/]+)/tasks/(?P<task_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<lake>[^/]+)/(?P<task_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<lake>[^/]+)/(?P<task_id>[^/]+)$",
+	},
+	"google_dataplex_zone": {
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/lakes/(?P<lake>[^/]+)/zones/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<lake>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<lake>[^/]+)/(?P<name>[^/]+)",
+	},
+	"google_dataproc_autoscaling_policy": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/autoscalingPolicies/(?P<policy_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<policy_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<policy_id>[^/]+)$",
+	},
+	"google_dataproc_batch": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/batches/(?P<batch_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<batch_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<batch_id>[^/]+)$",
+	},
+	"google_dataproc_gdc_application_environment": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/serviceInstances/(?P<serviceinstance>[^/]+)/applicationEnvironments/(?P<application_environment_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<serviceinstance>[^/]+)/(?P<application_environment_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<serviceinstance>[^/]+)/(?P<application_environment_id>[^/]+)$",
+	},
+	"google_dataproc_gdc_service_instance": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/serviceInstances/(?P<service_instance_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<service_instance_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<service_instance_id>[^/]+)$",
+	},
+	"google_dataproc_gdc_spark_application": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/serviceInstances/(?P<serviceinstance>[^/]+)/sparkApplications/(?P<spark_application_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<serviceinstance>[^/]+)/(?P<spark_application_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<serviceinstance>[^/]+)/(?P<spark_application_id>[^/]+)$",
+	},
+	"google_dataproc_metastore_federation": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/federations/(?P<federation_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<federation_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<federation_id>[^/]+)$",
+	},
+	"google_dataproc_metastore_service": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/services/(?P<service_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<service_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<service_id>[^/]+)$",
+	},
+	"google_dataproc_workflow_template": {
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/workflowTemplates/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<name>[^/]+)",
+	},
+	"google_datastream_connection_profile": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/connectionProfiles/(?P<connection_profile_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<connection_profile_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<connection_profile_id>[^/]+)$",
+	},
+	"google_datastream_private_connection": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/privateConnections/(?P<private_connection_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<private_connection_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<private_connection_id>[^/]+)$",
+	},
+	"google_datastream_stream": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/streams/(?P<stream_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<stream_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<stream_id>[^/]+)$",
+	},
+	"google_deployment_manager_deployment": {
+		"^projects/(?P<project>[^/]+)/deployments/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_developer_connect_connection": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/connections/(?P<connection_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<connection_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<connection_id>[^/]+)$",
+	},
+	"google_developer_connect_git_repository_link": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/connections/(?P<parent_connection>[^/]+)/gitRepositoryLinks/(?P<git_repository_link_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<parent_connection>[^/]+)/(?P<git_repository_link_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<parent_connection>[^/]+)/(?P<git_repository_link_id>[^/]+)$",
+	},
+	"google_dialogflow_agent": {
+		"^(?P<project>[^/]+)$",
+	},
+	"google_dialogflow_cx_agent": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/agents/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_dialogflow_cx_entity_type": {
+		"(?P<parent>.+)/entityTypes/(?P<name>[^/]+)",
+		"(?P<parent>.+)/(?P<name>[^/]+)",
+	},
+	"google_dialogflow_cx_environment": {
+		"(?P<parent>.+)/environments/(?P<name>[^/]+)",
+		"(?P<parent>.+)/(?P<name>[^/]+)",
+	},
+	"google_dialogflow_cx_flow": {
+		"(?P<parent>.+)/flows/(?P<name>[^/]+)",
+		"(?P<parent>.+)/(?P<name>[^/]+)",
+	},
+	"google_dialogflow_cx_intent": {
+		"(?P<parent>.+)/intents/(?P<name>[^/]+)",
+		"(?P<parent>.+)/(?P<name>[^/]+)",
+	},
+	"google_dialogflow_cx_page": {
+		"(?P<parent>.+)/pages/(?P<name>[^/]+)",
+		"(?P<parent>.+)/(?P<name>[^/]+)",
+	},
+	"google_dialogflow_cx_security_settings": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/securitySettings/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_dialogflow_cx_test_case": {
+		"^(?P<parent>.+)/testCases/(?P<name>[^/]+)$",
+	},
+	"google_dialogflow_cx_version": {
+		"(?P<parent>.+)/versions/(?P<name>[^/]+)",
+		"(?P<parent>.+)/(?P<name>[^/]+)",
+	},
+	"google_dialogflow_cx_webhook": {
+		"(?P<parent>.+)/webhooks/(?P<name>[^/]+)",
+		"(?P<parent>.+)/(?P<name>[^/]+)",
+	},
+	"google_dialogflow_entity_type": {
+		"(?P<name>.+)",
+	},
+	"google_dialogflow_fulfillment": {
+		"(?P<name>.+)",
+	},
+	"google_dialogflow_intent": {
+		"(?P<name>.+)",
+	},
+	"google_discovery_engine_chat_engine": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/collections/(?P<collection_id>[^/]+)/engines/(?P<engine_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<collection_id>[^/]+)/(?P<engine_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<collection_id>[^/]+)/(?P<engine_id>[^/]+)$",
+	},
+	"google_discovery_engine_data_store": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/collections/default_collection/dataStores/(?P<data_store_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<data_store_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<data_store_id>[^/]+)$",
+	},
+	"google_discovery_engine_schema": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/collections/default_collection/dataStores/(?P<data_store_id>[^/]+)/schemas/(?P<schema_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<data_store_id>[^/]+)/(?P<schema_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<data_store_id>[^/]+)/(?P<schema_id>[^/]+)$",
+	},
+	"google_discovery_engine_search_engine": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/collections/(?P<collection_id>[^/]+)/engines/(?P<engine_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<collection_id>[^/]+)/(?P<engine_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<collection_id>[^/]+)/(?P<engine_id>[^/]+)$",
+	},
+	"google_discovery_engine_target_site": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/collections/default_collection/dataStores/(?P<data_store_id>[^/]+)/siteSearchEngine/targetSites/(?P<target_site_id>[^/]+)$",
+	},
+	"google_dns_managed_zone": {
+		"^projects/(?P<project>[^/]+)/managedZones/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_dns_policy": {
+		"^projects/(?P<project>[^/]+)/policies/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_dns_record_set": {
+		"projects/(?P<project>[^/]+)/managedZones/(?P<managed_zone>[^/]+)/rrsets/(?P<name>[^/]+)/(?P<type>[^/]+)",
+		"(?P<project>[^/]+)/(?P<managed_zone>[^/]+)/(?P<name>[^/]+)/(?P<type>[^/]+)",
+		"(?P<managed_zone>[^/]+)/(?P<name>[^/]+)/(?P<type>[^/]+)",
+	},
+	"google_dns_response_policy": {
+		"^projects/(?P<project>[^/]+)/responsePolicies/(?P<response_policy_name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<response_policy_name>[^/]+)$",
+		"^(?P<response_policy_name>[^/]+)$",
+	},
+	"google_dns_response_policy_rule": {
+		"^projects/(?P<project>[^/]+)/responsePolicies/(?P<response_policy>[^/]+)/rules/(?P<rule_name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<response_policy>[^/]+)/(?P<rule_name>[^/]+)$",
+		"^(?P<response_policy>[^/]+)/(?P<rule_name>[^/]+)$",
+	},
+	"google_document_ai_processor": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/processors/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_document_ai_processor_default_version": {
+		"^(?P<processor>.+)$",
+	},
+	"google_document_ai_warehouse_document_schema": {
+		"projects/(?P<project_number>[^/]+)/locations/(?P<location>[^/]+)/documentSchemas/(?P<name>[^/]+)",
+	},
+	"google_edgecontainer_cluster": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/clusters/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_edgecontainer_node_pool": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/clusters/(?P<cluster>[^/]+)/nodePools/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<cluster>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<cluster>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_edgecontainer_vpn_connection": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/vpnConnections/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_edgenetwork_interconnect_attachment": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/zones/(?P<zone>[^/]+)/interconnectAttachment/(?P<interconnect_attachment_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<zone>[^/]+)/(?P<interconnect_attachment_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<zone>[^/]+)/(?P<interconnect_attachment_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<interconnect_attachment_id>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_edgenetwork_network": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/zones/(?P<zone>[^/]+)/networks/(?P<network_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<zone>[^/]+)/(?P<network_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<zone>[^/]+)/(?P<network_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<network_id>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_edgenetwork_subnet": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/zones/(?P<zone>[^/]+)/subnets/(?P<subnet_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<zone>[^/]+)/(?P<subnet_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<zone>[^/]+)/(?P<subnet_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<subnet_id>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_essential_contacts_contact": {
+		"^(?P<name>.+)$",
+	},
+	"google_eventarc_channel": {
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/channels/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<name>[^/]+)",
+	},
+	"google_eventarc_google_channel_config": {
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/googleChannelConfig",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)",
+		"(?P<location>[^/]+)",
+	},
+	"google_eventarc_trigger": {
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/triggers/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<name>[^/]+)",
+	},
+	"google_filestore_backup": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/backups/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_filestore_instance": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/instances/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_filestore_snapshot": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/instances/(?P<instance>[^/]+)/snapshots/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<instance>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<instance>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_firebase_app_check_app_attest_config": {
+		"^projects/(?P<project>[^/]+)/apps/(?P<app_id>[^/]+)/appAttestConfig$",
+		"^(?P<project>[^/]+)/(?P<app_id>[^/]+)$",
+		"^(?P<app_id>[^/]+)$",
+	},
+	"google_firebase_app_check_debug_token": {
+		"^projects/(?P<project>[^/]+)/apps/(?P<app_id>[^/]+)/debugTokens/(?P<debug_token_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<app_id>[^/]+)/(?P<debug_token_id>[^/]+)$",
+		"^(?P<app_id>[^/]+)/(?P<debug_token_id>[^/]+)$",
+	},
+	"google_firebase_app_check_device_check_config": {
+		"^projects/(?P<project>[^/]+)/apps/(?P<app_id>[^/]+)/deviceCheckConfig$",
+		"^(?P<project>[^/]+)/(?P<app_id>[^/]+)$",
+		"^(?P<app_id>[^/]+)$",
+	},
+	"google_firebase_app_check_play_integrity_config": {
+		"^projects/(?P<project>[^/]+)/apps/(?P<app_id>[^/]+)/playIntegrityConfig$",
+		"^(?P<project>[^/]+)/(?P<app_id>[^/]+)$",
+		"^(?P<app_id>[^/]+)$",
+	},
+	"google_firebase_app_check_recaptcha_enterprise_config": {
+		"^projects/(?P<project>[^/]+)/apps/(?P<app_id>[^/]+)/recaptchaEnterpriseConfig$",
+		"^(?P<project>[^/]+)/(?P<app_id>[^/]+)$",
+		"^(?P<app_id>[^/]+)$",
+	},
+	"google_firebase_app_check_recaptcha_v3_config": {
+		"^projects/(?P<project>[^/]+)/apps/(?P<app_id>[^/]+)/recaptchaV3Config$",
+		"^(?P<project>[^/]+)/(?P<app_id>[^/]+)$",
+		"^(?P<app_id>[^/]+)$",
+	},
+	"google_firebase_app_check_service_config": {
+		"^projects/(?P<project>[^/]+)/services/(?P<service_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<service_id>[^/]+)$",
+		"^(?P<service_id>[^/]+)$",
+	},
+	"google_firebaserules_release": {
+		"projects/(?P<project>.+)/releases/(?P<name>.+)",
+	},
+	"google_firebaserules_ruleset": {
+		"projects/(?P<project>[^/]+)/rulesets/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<name>[^/]+)",
+		"(?P<name>[^/]+)",
+	},
+	"google_firestore_backup_schedule": {
+		"^projects/(?P<project>[^/]+)/databases/(?P<database>[^/]+)/backupSchedules/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<database>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<database>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_firestore_database": {
+		"^projects/(?P<project>[^/]+)/databases/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_firestore_document": {
+		"(?P<name>.+)",
+	},
+	"google_firestore_field": {
+		"(?P<name>.+)",
+	},
+	"google_firestore_index": {
+		"(?P<name>.+)",
+	},
+	"google_folder_access_approval_settings": {
+		"^folders/(?P<folder_id>[^/]+)/accessApprovalSettings$",
+		"^(?P<folder_id>[^/]+)$",
+	},
+	"google_folder_organization_policy": {
+		"folders/(?P<folder>[^/]+)/constraints/(?P<constraint>[^/]+)",
+		"folders/(?P<folder>[^/]+)/(?P<constraint>[^/]+)",
+		"(?P<folder>[^/]+)/(?P<constraint>[^/]+)",
+	},
+	"google_gemini_code_repository_index": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/codeRepositoryIndexes/(?P<code_repository_index_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<code_repository_index_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<code_repository_index_id>[^/]+)$",
+	},
+	"google_gemini_logging_setting": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/loggingSettings/(?P<logging_setting_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<logging_setting_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<logging_setting_id>[^/]+)$",
+	},
+	"google_gemini_repository_group": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/codeRepositoryIndexes/(?P<code_repository_index>[^/]+)/repositoryGroups/(?P<repository_group_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<code_repository_index>[^/]+)/(?P<repository_group_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<code_repository_index>[^/]+)/(?P<repository_group_id>[^/]+)$",
+	},
+	"google_gke_backup_backup_plan": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/backupPlans/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_gke_backup_restore_plan": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/restorePlans/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_gke_hub_feature": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/features/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_gke_hub_feature_membership": {
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/features/(?P<feature>[^/]+)/membershipId/(?P<membership>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<feature>[^/]+)/(?P<membership>[^/]+)",
+		"(?P<location>[^/]+)/(?P<feature>[^/]+)/(?P<membership>[^/]+)",
+	},
+	"google_gke_hub_fleet": {
+		"^projects/(?P<project>[^/]+)/locations/global/fleets/default$",
+		"^(?P<project>[^/]+)$",
+	},
+	"google_gke_hub_membership": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/memberships/(?P<membership_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<membership_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<membership_id>[^/]+)$",
+	},
+	"google_gke_hub_membership_binding": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/memberships/(?P<membership_id>[^/]+)/bindings/(?P<membership_binding_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<membership_id>[^/]+)/(?P<membership_binding_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<membership_id>[^/]+)/(?P<membership_binding_id>[^/]+)$",
+	},
+	"google_gke_hub_namespace": {
+		"^projects/(?P<project>[^/]+)/locations/global/scopes/(?P<scope_id>[^/]+)/namespaces/(?P<scope_namespace_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<scope_id>[^/]+)/(?P<scope_namespace_id>[^/]+)$",
+		"^(?P<scope_id>[^/]+)/(?P<scope_namespace_id>[^/]+)$",
+	},
+	"google_gke_hub_scope": {
+		"^projects/(?P<project>[^/]+)/locations/global/scopes/(?P<scope_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<scope_id>[^/]+)$",
+		"^(?P<scope_id>[^/]+)$",
+	},
+	"google_gke_hub_scope_rbac_role_binding": {
+		"^projects/(?P<project>[^/]+)/locations/global/scopes/(?P<scope_id>[^/]+)/rbacrolebindings/(?P<scope_rbac_role_binding_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<scope_id>[^/]+)/(?P<scope_rbac_role_binding_id>[^/]+)$",
+		"^(?P<scope_id>[^/]+)/(?P<scope_rbac_role_binding_id>[^/]+)$",
+	},
+	"google_gkeonprem_bare_metal_admin_cluster": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/bareMetalAdminClusters/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_gkeonprem_bare_metal_cluster": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/bareMetalClusters/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_gkeonprem_bare_metal_node_pool": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/bareMetalClusters/(?P<bare_metal_cluster>[^/]+)/bareMetalNodePools/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<bare_metal_cluster>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<bare_metal_cluster>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_gkeonprem_vmware_cluster": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/vmwareClusters/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_gkeonprem_vmware_node_pool": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/vmwareClusters/(?P<vmware_cluster>[^/]+)/vmwareNodePools/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<vmware_cluster>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<vmware_cluster>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_healthcare_consent_store": {
+		"^(?P<dataset>.+)/consentStores/(?P<name>[^/]+)$",
+	},
+	"google_healthcare_dataset": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/datasets/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_healthcare_pipeline_job": {
+		"^(?P<dataset>.+)/pipelineJobs/(?P<name>[^/]+)$",
+		"^(?P<dataset>[^/]+)/pipelineJobs?pipelineJobId=(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_healthcare_workspace": {
+		"^(?P<dataset>.+)/dataMapperWorkspaces/(?P<name>[^/]+)$",
+	},
+	"google_iam_access_boundary_policy": {
+		"^(?P<parent>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_iam_deny_policy": {
+		"^(?P<parent>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_iam_folders_policy_binding": {
+		"^folders/(?P<folder>[^/]+)/locations/(?P<location>[^/]+)/policyBindings/(?P<policy_binding_id>[^/]+)$",
+		"^(?P<folder>[^/]+)/(?P<location>[^/]+)/(?P<policy_binding_id>[^/]+)$",
+	},
+	"google_iam_organizations_policy_binding": {
+		"^organizations/(?P<organization>[^/]+)/locations/(?P<location>[^/]+)/policyBindings/(?P<policy_binding_id>[^/]+)$",
+		"^(?P<organization>[^/]+)/(?P<location>[^/]+)/(?P<policy_binding_id>[^/]+)$",
+	},
+	"google_iam_principal_access_boundary_policy": {
+		"^organizations/(?P<organization>[^/]+)/locations/(?P<location>[^/]+)/principalAccessBoundaryPolicies/(?P<principal_access_boundary_policy_id>[^/]+)$",
+		"^(?P<organization>[^/]+)/(?P<location>[^/]+)/(?P<principal_access_boundary_policy_id>[^/]+)$",
+	},
+	"google_iam_projects_policy_binding": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/policyBindings/(?P<policy_binding_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<policy_binding_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<policy_binding_id>[^/]+)$",
+	},
+	"google_iam_workforce_pool": {
+		"^locations/(?P<location>[^/]+)/workforcePools/(?P<workforce_pool_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<workforce_pool_id>[^/]+)$",
+	},
+	"google_iam_workforce_pool_provider": {
+		"^locations/(?P<location>[^/]+)/workforcePools/(?P<workforce_pool_id>[^/]+)/providers/(?P<provider_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<workforce_pool_id>[^/]+)/(?P<provider_id>[^/]+)$",
+	},
+	"google_iam_workload_identity_pool": {
+		"^projects/(?P<project>[^/]+)/locations/global/workloadIdentityPools/(?P<workload_identity_pool_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<workload_identity_pool_id>[^/]+)$",
+		"^(?P<workload_identity_pool_id>[^/]+)$",
+	},
+	"google_iam_workload_identity_pool_provider": {
+		"^projects/(?P<project>[^/]+)/locations/global/workloadIdentityPools/(?P<workload_identity_pool_id>[^/]+)/providers/(?P<workload_identity_pool_provider_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<workload_identity_pool_id>[^/]+)/(?P<workload_identity_pool_provider_id>[^/]+)$",
+		"^(?P<workload_identity_pool_id>[^/]+)/(?P<workload_identity_pool_provider_id>[^/]+)$",
+	},
+	"google_iap_brand": {
+		"(?P<name>.+)",
+	},
+	"google_iap_client": {
+		"(?P<brand>.+)",
+	},
+	"google_iap_settings": {
+		"^(?P<name>.+)/iapSettings$",
+		"^(?P<name>.+)$",
+	},
+	"google_iap_tunnel_dest_group": {
+		"^projects/(?P<project>[^/]+)/iap_tunnel/locations/(?P<region>[^/]+)/destGroups/(?P<group_name>[^/]+)$",
+		"^(?P<project>[^/]+)/iap_tunnel/locations/(?P<region>[^/]+)/destGroups/(?P<group_name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<group_name>[^/]+)$",
+		"^(?P<region>[^/]+)/destGroups/(?P<group_name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<group_name>[^/]+)$",
+		"^(?P<group_name>[^/]+)$",
+	},
+	"google_identity_platform_config": {
+		"^projects/(?P<project>[^/]+)/config$",
+		"^projects/(?P<project>[^/]+)$",
+		"^(?P<project>[^/]+)$",
+	},
+	"google_identity_platform_default_supported_idp_config": {
+		"^projects/(?P<project>[^/]+)/defaultSupportedIdpConfigs/(?P<idp_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<idp_id>[^/]+)$",
+		"^(?P<idp_id>[^/]+)$",
+	},
+	"google_identity_platform_inbound_saml_config": {
+		"^projects/(?P<project>[^/]+)/inboundSamlConfigs/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_identity_platform_oauth_idp_config": {
+		"^projects/(?P<project>[^/]+)/oauthIdpConfigs/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_identity_platform_tenant": {
+		"^projects/(?P<project>[^/]+)/tenants/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_identity_platform_tenant_default_supported_idp_config": {
+		"^projects/(?P<project>[^/]+)/tenants/(?P<tenant>[^/]+)/defaultSupportedIdpConfigs/(?P<idp_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<tenant>[^/]+)/(?P<idp_id>[^/]+)$",
+		"^(?P<tenant>[^/]+)/(?P<idp_id>[^/]+)$",
+	},
+	"google_identity_platform_tenant_inbound_saml_config": {
+		"^projects/(?P<project>[^/]+)/tenants/(?P<tenant>[^/]+)/inboundSamlConfigs/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<tenant>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<tenant>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_identity_platform_tenant_oauth_idp_config": {
+		"^projects/(?P<project>[^/]+)/tenants/(?P<tenant>[^/]+)/oauthIdpConfigs/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<tenant>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<tenant>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_integration_connectors_connection": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/connections/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_integration_connectors_endpoint_attachment": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/endpointAttachments/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_integration_connectors_managed_zone": {
+		"^projects/(?P<project>[^/]+)/locations/global/managedZones/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_integrations_auth_config": {
+		"(?P<project>[^ ]+) (?P<name>[^ ]+)",
+		"(?P<name>[^ ]+)",
+	},
+	"google_integrations_client": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/clients$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)$",
+		"^(?P<location>[^/]+)$",
+	},
+	"google_kms_ekm_connection": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/ekmConnections/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_kms_key_ring": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/keyRings/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_kms_key_ring_import_job": {
+		"(?P<name>.+)",
+	},
+	"google_logging_folder_settings": {
+		"^folders/(?P<folder>[^/]+)/settings$",
+		"^(?P<folder>[^/]+)$",
+	},
+	"google_logging_linked_dataset": {
+		"^(?P<parent>.+)/locations/(?P<location>[^/]+)/buckets/(?P<bucket>[^/]+)/links/(?P<link_id>[^/]+)$",
+	},
+	"google_logging_log_scope": {
+		"^(?P<parent>.+)/locations/(?P<location>[^/]+)/logScopes/(?P<name>[^/]+)$",
+	},
+	"google_logging_log_view": {
+		"^(?P<parent>.+)/locations/(?P<location>[^/]+)/buckets/(?P<bucket>[^/]+)/views/(?P<name>[^/]+)$",
+	},
+	"google_logging_metric": {
+		"(?P<project>[^ ]+) (?P<name>[^ ]+)",
+		"(?P<name>[^ ]+)",
+	},
+	"google_logging_organization_settings": {
+		"^organizations/(?P<organization>[^/]+)/settings$",
+		"^(?P<organization>[^/]+)$",
+	},
+	"google_looker_instance": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<region>[^/]+)/instances/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_managed_kafka_cluster": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/clusters/(?P<cluster_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<cluster_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<cluster_id>[^/]+)$",
+	},
+	"google_managed_kafka_topic": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/clusters/(?P<cluster>[^/]+)/topics/(?P<topic_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<cluster>[^/]+)/(?P<topic_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<cluster>[^/]+)/(?P<topic_id>[^/]+)$",
+	},
+	"google_memcache_instance": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<region>[^/]+)/instances/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_memorystore_instance": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/instances/(?P<instance_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<instance_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<instance_id>[^/]+)$",
+	},
+	"google_migration_center_group": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/groups/(?P<group_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<group_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<group_id>[^/]+)$",
+	},
+	"google_migration_center_preference_set": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/preferenceSets/(?P<preference_set_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<preference_set_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<preference_set_id>[^/]+)$",
+	},
+	"google_ml_engine_model": {
+		"^projects/(?P<project>[^/]+)/models/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_monitoring_alert_policy": {
+		"(?P<project>[^ ]+) (?P<name>[^ ]+)",
+		"(?P<name>[^ ]+)",
+	},
+	"google_monitoring_custom_service": {
+		"(?P<project>[^ ]+) (?P<name>[^ ]+)",
+		"(?P<name>[^ ]+)",
+	},
+	"google_monitoring_group": {
+		"(?P<project>[^ ]+) (?P<name>[^ ]+)",
+		"(?P<name>[^ ]+)",
+	},
+	"google_monitoring_metric_descriptor": {
+		"(?P<project>[^ ]+) (?P<name>[^ ]+)",
+		"(?P<name>[^ ]+)",
+	},
+	"google_monitoring_monitored_project": {
+		"locations/global/metricsScopes/(?P<metrics_scope>[^/]+)/projects/(?P<name>[^/]+)",
+		"v1/locations/global/metricsScopes/(?P<metrics_scope>[^/]+)/projects/(?P<name>[^/]+)",
+		"(?P<metrics_scope>[^/]+)/(?P<name>[^/]+)",
+	},
+	"google_monitoring_notification_channel": {
+		"(?P<name>.+)",
+	},
+	"google_monitoring_service": {
+		"^projects/(?P<project>[^/]+)/services/(?P<service_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<service_id>[^/]+)$",
+		"^(?P<service_id>[^/]+)$",
+	},
+	"google_monitoring_slo": {
+		"(?P<project>[^ ]+) (?P<name>[^ ]+)",
+		"(?P<name>[^ ]+)",
+	},
+	"google_monitoring_uptime_check_config": {
+		"(?P<project>[^ ]+) (?P<name>[^ ]+)",
+		"(?P<name>[^ ]+)",
+	},
+	"google_netapp_active_directory": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/activeDirectories/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_netapp_backup": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/backupVaults/(?P<vault_name>[^/]+)/backups/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<vault_name>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<vault_name>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_netapp_backup_policy": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/backupPolicies/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_netapp_backup_vault": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/backupVaults/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_netapp_kmsconfig": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/kmsConfigs/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_netapp_storage_pool": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/storagePools/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_netapp_volume": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/volumes/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_netapp_volume_replication": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/volumes/(?P<volume_name>[^/]+)/replications/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<volume_name>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<volume_name>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_netapp_volume_snapshot": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/volumes/(?P<volume_name>[^/]+)/snapshots/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<volume_name>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<volume_name>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_network_connectivity_group": {
+		"^projects/(?P<project>[^/]+)/locations/global/hubs/(?P<hub>[^/]+)/groups/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<hub>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<hub>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_network_connectivity_hub": {
+		"^projects/(?P<project>[^/]+)/locations/global/hubs/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_network_connectivity_internal_range": {
+		"^projects/(?P<project>[^/]+)/locations/global/internalRanges/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_network_connectivity_policy_based_route": {
+		"^projects/(?P<project>[^/]+)/locations/global/policyBasedRoutes/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_network_connectivity_regional_endpoint": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/regionalEndpoints/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_network_connectivity_service_connection_policy": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/serviceConnectionPolicies/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_network_connectivity_spoke": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/spokes/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_network_management_connectivity_test": {
+		"^projects/(?P<project>[^/]+)/locations/global/connectivityTests/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_network_management_vpc_flow_logs_config": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/vpcFlowLogsConfigs/(?P<vpc_flow_logs_config_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<vpc_flow_logs_config_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<vpc_flow_logs_config_id>[^/]+)$",
+	},
+	"google_network_security_address_group": {
+		"^(?P<parent>.+)/locations/(?P<location>[^/]+)/addressGroups/(?P<name>[^/]+)$",
+	},
+	"google_network_security_authz_policy": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/authzPolicies/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_network_security_client_tls_policy": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/clientTlsPolicies/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_network_security_firewall_endpoint": {
+		"^(?P<parent>.+)/locations/(?P<location>[^/]+)/firewallEndpoints/(?P<name>[^/]+)$",
+	},
+	"google_network_security_firewall_endpoint_association": {
+		"^(?P<parent>.+)/locations/(?P<location>[^/]+)/firewallEndpointAssociations/(?P<name>[^/]+)$",
+	},
+	"google_network_security_gateway_security_policy": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/gatewaySecurityPolicies/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_network_security_gateway_security_policy_rule": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/gatewaySecurityPolicies/(?P<gateway_security_policy>[^/]+)/rules/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<gateway_security_policy>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<gateway_security_policy>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_network_security_security_profile": {
+		"^(?P<parent>.+)/locations/(?P<location>[^/]+)/securityProfiles/(?P<name>[^/]+)$",
+	},
+	"google_network_security_security_profile_group": {
+		"^(?P<parent>.+)/locations/(?P<location>[^/]+)/securityProfileGroups/(?P<name>[^/]+)$",
+	},
+	"google_network_security_server_tls_policy": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/serverTlsPolicies/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_network_security_tls_inspection_policy": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/tlsInspectionPolicies/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_network_security_url_lists": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/urlLists/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_network_services_authz_extension": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/authzExtensions/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_network_services_edge_cache_keyset": {
+		"^projects/(?P<project>[^/]+)/locations/global/edgeCacheKeysets/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_network_services_edge_cache_origin": {
+		"^projects/(?P<project>[^/]+)/locations/global/edgeCacheOrigins/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_network_services_edge_cache_service": {
+		"^projects/(?P<project>[^/]+)/locations/global/edgeCacheServices/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_network_services_gateway": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/gateways/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_network_services_lb_route_extension": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/lbRouteExtensions/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_network_services_lb_traffic_extension": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/lbTrafficExtensions/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_notebooks_environment": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/environments/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_notebooks_instance": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/instances/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_notebooks_location": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_notebooks_runtime": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/runtimes/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_oracle_database_autonomous_database": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/autonomousDatabases/(?P<autonomous_database_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<autonomous_database_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<autonomous_database_id>[^/]+)$",
+	},
+	"google_oracle_database_cloud_exadata_infrastructure": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/cloudExadataInfrastructures/(?P<cloud_exadata_infrastructure_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<cloud_exadata_infrastructure_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<cloud_exadata_infrastructure_id>[^/]+)$",
+	},
+	"google_oracle_database_cloud_vm_cluster": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/cloudVmClusters/(?P<cloud_vm_cluster_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<cloud_vm_cluster_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<cloud_vm_cluster_id>[^/]+)$",
+	},
+	"google_org_policy_custom_constraint": {
+		"^(?P<parent>.+)/customConstraints/(?P<name>[^/]+)$",
+	},
+	"google_org_policy_policy": {
+		"^(?P<parent>.+)/policies/(?P<name>[^/]+)$",
+	},
+	"google_organization_access_approval_settings": {
+		"^organizations/(?P<organization_id>[^/]+)/accessApprovalSettings$",
+		"^(?P<organization_id>[^/]+)$",
+	},
+	"google_os_config_os_policy_assignment": {
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/osPolicyAssignments/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<name>[^/]+)",
+	},
+	"google_os_config_patch_deployment": {
+		"(?P<project>[^ ]+) (?P<name>[^ ]+)",
+		"(?P<name>[^ ]+)",
+	},
+	"google_os_login_ssh_public_key": {
+		"^users/(?P<user>[^/]+)/sshPublicKeys/(?P<fingerprint>[^/]+)$",
+		"^(?P<user>[^/]+)/(?P<fingerprint>[^/]+)$",
+	},
+	"google_parallelstore_instance": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/instances/(?P<instance_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<instance_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<instance_id>[^/]+)$",
+	},
+	"google_privateca_ca_pool": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/caPools/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_privateca_certificate": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/caPools/(?P<pool>[^/]+)/certificates/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<pool>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<pool>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_privateca_certificate_authority": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/caPools/(?P<pool>[^/]+)/certificateAuthorities/(?P<certificate_authority_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<pool>[^/]+)/(?P<certificate_authority_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<pool>[^/]+)/(?P<certificate_authority_id>[^/]+)$",
+	},
+	"google_privateca_certificate_template": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/certificateTemplates/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_privileged_access_manager_entitlement": {
+		"^(?P<parent>.+)/locations/(?P<location>[^/]+)/entitlements/(?P<entitlement_id>[^/]+)$",
+	},
+	"google_project_access_approval_settings": {
+		"^projects/(?P<project_id>[^/]+)/accessApprovalSettings$",
+		"^(?P<project_id>[^/]+)$",
+	},
+	"google_project_iam_custom_role": {
+		"projects/(?P<project>[^/]+)/roles/(?P<role_id>[^/]+)",
+		"(?P<project>[^/]+)/(?P<role_id>[^/]+)",
+		"(?P<role_id>[^/]+)",
+	},
+	"google_project_organization_policy": {
+		"projects/(?P<project>[^/]+):constraints/(?P<constraint>[^/]+)",
+		"(?P<project>[^/]+):constraints/(?P<constraint>[^/]+)",
+		"(?P<project>[^/]+):(?P<constraint>[^/]+)",
+	},
+	"google_pubsub_lite_reservation": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<region>[^/]+)/reservations/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_pubsub_lite_subscription": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<zone>[^/]+)/subscriptions/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<zone>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_pubsub_lite_topic": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<zone>[^/]+)/topics/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<zone>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_pubsub_schema": {
+		"^projects/(?P<project>[^/]+)/schemas/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_pubsub_subscription": {
+		"^projects/(?P<project>[^/]+)/subscriptions/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_pubsub_topic": {
+		"^projects/(?P<project>[^/]+)/topics/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_recaptcha_enterprise_key": {
+		"projects/(?P<project>[^/]+)/keys/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<name>[^/]+)",
+		"(?P<name>[^/]+)",
+	},
+	"google_redis_cluster": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<region>[^/]+)/clusters/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_redis_cluster_user_created_connections": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<region>[^/]+)/clusters/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_redis_instance": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<region>[^/]+)/instances/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_resource_manager_lien": {
+		"^(?P<parent>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_scc_event_threat_detection_custom_module": {
+		"^organizations/(?P<organization>[^/]+)/eventThreatDetectionSettings/customModules/(?P<name>[^/]+)$",
+		"^(?P<organization>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_scc_folder_custom_module": {
+		"^folders/(?P<folder>[^/]+)/securityHealthAnalyticsSettings/customModules/(?P<name>[^/]+)$",
+		"^(?P<folder>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_scc_folder_notification_config": {
+		"^folders/(?P<folder>[^/]+)/notificationConfigs/(?P<config_id>[^/]+)$",
+		"^(?P<folder>[^/]+)/(?P<config_id>[^/]+)$",
+	},
+	"google_scc_folder_scc_big_query_export": {
+		"^folders/(?P<folder>[^/]+)/bigQueryExports/(?P<big_query_export_id>[^/]+)$",
+		"^(?P<folder>[^/]+)/(?P<big_query_export_id>[^/]+)$",
+	},
+	"google_scc_management_folder_security_health_analytics_custom_module": {
+		"^folders/(?P<folder>[^/]+)/locations/(?P<location>[^/]+)/securityHealthAnalyticsCustomModules/(?P<name>[^/]+)$",
+		"^(?P<folder>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_scc_management_organization_event_threat_detection_custom_module": {
+		"^organizations/(?P<organization>[^/]+)/locations/(?P<location>[^/]+)/eventThreatDetectionCustomModules/(?P<name>[^/]+)$",
+		"^(?P<organization>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_scc_management_organization_security_health_analytics_custom_module": {
+		"^organizations/(?P<organization>[^/]+)/locations/(?P<location>[^/]+)/securityHealthAnalyticsCustomModules/(?P<name>[^/]+)$",
+		"^(?P<organization>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_scc_management_project_security_health_analytics_custom_module": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/securityHealthAnalyticsCustomModules/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_scc_mute_config": {
+		"(?P<name>.+)",
+	},
+	"google_scc_notification_config": {
+		"(?P<name>.+)",
+	},
+	"google_scc_organization_custom_module": {
+		"^organizations/(?P<organization>[^/]+)/securityHealthAnalyticsSettings/customModules/(?P<name>[^/]+)$",
+		"^(?P<organization>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_scc_organization_scc_big_query_export": {
+		"^organizations/(?P<organization>[^/]+)/bigQueryExports/(?P<big_query_export_id>[^/]+)$",
+		"^(?P<organization>[^/]+)/(?P<big_query_export_id>[^/]+)$",
+	},
+	"google_scc_project_custom_module": {
+		"^projects/(?P<project>[^/]+)/securityHealthAnalyticsSettings/customModules/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_scc_project_notification_config": {
+		"(?P<name>.+)",
+	},
+	"google_scc_project_scc_big_query_export": {
+		"^projects/(?P<project>[^/]+)/bigQueryExports/(?P<big_query_export_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<big_query_export_id>[^/]+)$",
+		"^(?P<big_query_export_id>[^/]+)$",
+	},
+	"google_scc_source": {
+		"(?P<name>.+)",
+	},
+	"google_scc_v2_folder_mute_config": {
+		"^folders/(?P<folder>[^/]+)/locations/(?P<location>[^/]+)/muteConfigs/(?P<mute_config_id>[^/]+)$",
+		"^(?P<folder>[^/]+)/(?P<location>[^/]+)/(?P<mute_config_id>[^/]+)$",
+	},
+	"google_scc_v2_folder_notification_config": {
+		"^folders/(?P<folder>[^/]+)/locations/(?P<location>[^/]+)/notificationConfigs/(?P<config_id>[^/]+)$",
+		"^(?P<folder>[^/]+)/(?P<location>[^/]+)/(?P<config_id>[^/]+)$",
+	},
+	"google_scc_v2_folder_scc_big_query_export": {
+		"^folders/(?P<folder>[^/]+)/locations/(?P<location>[^/]+)/bigQueryExports/(?P<big_query_export_id>[^/]+)$",
+		"^(?P<folder>[^/]+)/(?P<location>[^/]+)/(?P<big_query_export_id>[^/]+)$",
+	},
+	"google_scc_v2_organization_mute_config": {
+		"^organizations/(?P<organization>[^/]+)/locations/(?P<location>[^/]+)/muteConfigs/(?P<mute_config_id>[^/]+)$",
+		"^(?P<organization>[^/]+)/(?P<location>[^/]+)/(?P<mute_config_id>[^/]+)$",
+	},
+	"google_scc_v2_organization_notification_config": {
+		"(?P<name>.+)",
+	},
+	"google_scc_v2_organization_scc_big_query_export": {
+		"^organizations/(?P<organization>[^/]+)/locations/(?P<location>[^/]+)/bigQueryExports/(?P<big_query_export_id>[^/]+)$",
+		"^(?P<organization>[^/]+)/(?P<location>[^/]+)/(?P<big_query_export_id>[^/]+)$",
+	},
+	"google_scc_v2_organization_scc_big_query_exports": {
+		"^organizations/(?P<organization>[^/]+)/locations/(?P<location>[^/]+)/bigQueryExports/(?P<big_query_export_id>[^/]+)$",
+		"^(?P<organization>[^/]+)/(?P<location>[^/]+)/(?P<big_query_export_id>[^/]+)$",
+	},
+	"google_scc_v2_organization_source": {
+		"(?P<name>.+)",
+	},
+	"google_scc_v2_project_mute_config": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/muteConfigs/(?P<mute_config_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<mute_config_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<mute_config_id>[^/]+)$",
+	},
+	"google_scc_v2_project_notification_config": {
+		"(?P<name>.+)",
+	},
+	"google_scc_v2_project_scc_big_query_export": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/bigQueryExports/(?P<big_query_export_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<big_query_export_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<big_query_export_id>[^/]+)$",
+	},
+	"google_secret_manager_regional_secret": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/secrets/(?P<secret_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<secret_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<secret_id>[^/]+)$",
+	},
+	"google_secret_manager_regional_secret_version": {
+		"(?P<name>.+)",
+	},
+	"google_secret_manager_secret": {
+		"^projects/(?P<project>[^/]+)/secrets/(?P<secret_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<secret_id>[^/]+)$",
+		"^(?P<secret_id>[^/]+)$",
+	},
+	"google_secret_manager_secret_version": {
+		"(?P<name>.+)",
+	},
+	"google_secure_source_manager_branch_rule": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/repositories/(?P<repository_id>[^/]+)/branchRules/(?P<branch_rule_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<repository_id>[^/]+)/(?P<branch_rule_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<repository_id>[^/]+)/(?P<branch_rule_id>[^/]+)$",
+		"^(?P<branch_rule_id>[^/]+)$",
+	},
+	"google_secure_source_manager_instance": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/instances/(?P<instance_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<instance_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<instance_id>[^/]+)$",
+		"^(?P<instance_id>[^/]+)$",
+	},
+	"google_secure_source_manager_repository": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/repositories/(?P<repository_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<repository_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<repository_id>[^/]+)$",
+		"^(?P<repository_id>[^/]+)$",
+	},
+	"google_securityposture_posture": {
+		"^(?P<parent>.+)/locations/(?P<location>[^/]+)/postures/(?P<posture_id>[^/]+)$",
+	},
+	"google_securityposture_posture_deployment": {
+		"^(?P<parent>.+)/locations/(?P<location>[^/]+)/postureDeployments/(?P<posture_deployment_id>[^/]+)$",
+	},
+	"google_service_account": {
+		"projects/(?P<project>[^/]+)/serviceAccounts/(?P<email>[^/]+)",
+		"(?P<project>[^/]+)/(?P<email>[^/]+)",
+		"(?P<email>[^/]+)",
+	},
+	"google_service_networking_vpc_service_controls": {
+		"^services/(?P<service>[^/]+)/projects/(?P<project>[^/]+)/networks/(?P<network>[^/]+)$",
+		"^(?P<service>[^/]+)/(?P<project>[^/]+)/(?P<network>[^/]+)$",
+		"^(?P<service>[^/]+)/(?P<network>[^/]+)$",
+	},
+	"google_site_verification_owner": {
+		"^(?P<web_resource_id>webResource/[^/]+)/(?P<email>[^/]+)$",
+	},
+	"google_site_verification_web_resource": {
+		"^webResource/(?P<web_resource_id>[^/]+)$",
+		"^(?P<web_resource_id>[^/]+)$",
+	},
+	"google_sourcerepo_repository": {
+		"^projects/(?P<project>[^/]+)/repos/(?P<name>.+)$",
+		"^(?P<name>.+)$",
+	},
+	"google_spanner_backup_schedule": {
+		"^projects/(?P<project>[^/]+)/instances/(?P<instance>[^/]+)/databases/(?P<database>[^/]+)/backupSchedules/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<instance>[^/]+)/(?P<database>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<instance>[^/]+)/(?P<database>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_spanner_database": {
+		"^projects/(?P<project>[^/]+)/instances/(?P<instance>[^/]+)/databases/(?P<name>[^/]+)$",
+		"^instances/(?P<instance>[^/]+)/databases/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<instance>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<instance>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_spanner_instance": {
+		"^projects/(?P<project>[^/]+)/instances/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_spanner_instance_config": {
+		"^projects/(?P<project>[^/]+)/instanceConfigs/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_sql_database": {
+		"^projects/(?P<project>[^/]+)/instances/(?P<instance>[^/]+)/databases/(?P<name>[^/]+)$",
+		"^instances/(?P<instance>[^/]+)/databases/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<instance>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<instance>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_sql_database_instance": {
+		"projects/(?P<project>[^/]+)/instances/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<name>[^/]+)",
+		"(?P<name>[^/]+)",
+	},
+	"google_sql_source_representation_instance": {
+		"^projects/(?P<project>[^/]+)/instances/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_storage_bucket_access_control": {
+		"^(?P<bucket>[^/]+)/(?P<entity>[^/]+)$",
+	},
+	"google_storage_default_object_access_control": {
+		"^(?P<bucket>[^/]+)/(?P<entity>[^/]+)$",
+	},
+	"google_storage_folder": {
+		"^(?P<bucket>[^/]+)/folders/(?P<name>.+)$",
+		"^(?P<bucket>[^/]+)/(?P<name>.+)$",
+	},
+	"google_storage_hmac_key": {
+		"^projects/(?P<project>[^/]+)/hmacKeys/(?P<access_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<access_id>[^/]+)$",
+		"^(?P<access_id>[^/]+)$",
+	},
+	"google_storage_insights_report_config": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/reportConfigs/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_storage_managed_folder": {
+		"^(?P<bucket>[^/]+)/managedFolders/(?P<name>.+)$",
+		"^(?P<bucket>[^/]+)/(?P<name>.+)$",
+	},
+	"google_storage_object_access_control": {
+		"^(?P<bucket>[^/]+)/(?P<object>.+)/(?P<entity>[^/]+)$",
+	},
+	"google_storage_transfer_agent_pool": {
+		"^projects/(?P<project>[^/]+)/agentPools/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_tags_location_tag_binding": {
+		"(?P<location>[^/]+)/tagBindings/(?P<parent>[^/]+)/tagValues/(?P<tag_value>[^/]+)",
+	},
+	"google_tags_tag_binding": {
+		"tagBindings/(?P<name>.+)",
+		"(?P<name>.+)",
+	},
+	"google_tags_tag_key": {
+		"^tagKeys/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_tags_tag_value": {
+		"^tagValues/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_tpu_node": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<zone>[^/]+)/nodes/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<zone>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_transcoder_job": {
+		"(?P<project>[^ ]+) (?P<name>[^ ]+)",
+		"(?P<name>[^ ]+)",
+	},
+	"google_transcoder_job_template": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/jobTemplates/(?P<job_template_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<job_template_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<job_template_id>[^/]+)$",
+	},
+	"google_vertex_ai_deployment_resource_pool": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<region>[^/]+)/deploymentResourcePools/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_vertex_ai_endpoint": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/endpoints/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_vertex_ai_feature_group": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<region>[^/]+)/featureGroups/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_vertex_ai_feature_group_feature": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<region>[^/]+)/featureGroups/(?P<feature_group>[^/]+)/features/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<feature_group>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<feature_group>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<feature_group>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_vertex_ai_feature_online_store": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<region>[^/]+)/featureOnlineStores/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_vertex_ai_feature_online_store_featureview": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<region>[^/]+)/featureOnlineStores/(?P<feature_online_store>[^/]+)/featureViews/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<feature_online_store>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<feature_online_store>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<feature_online_store>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_vertex_ai_featurestore": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<region>[^/]+)/featurestores/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_vertex_ai_featurestore_entitytype": {
+		"(?P<featurestore>.+)/entityTypes/(?P<name>[^/]+)",
+	},
+	"google_vertex_ai_featurestore_entitytype_feature": {
+		"(?P<entitytype>.+)/features/(?P<name>[^/]+)",
+	},
+	"google_vertex_ai_index": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<region>[^/]+)/indexes/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_vertex_ai_index_endpoint": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<region>[^/]+)/indexEndpoints/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_vertex_ai_index_endpoint_deployed_index": {
+		"(?P<index_endpoint>.+)/deployedIndex/(?P<deployed_index_id>[^/]+)",
+	},
+	"google_vertex_ai_tensorboard": {
+		"projects/(?P<project>[^/]+)/locations/(?P<region>[^/]+)/tensorboards/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)",
+		"(?P<region>[^/]+)/(?P<name>[^/]+)",
+		"(?P<name>[^/]+)",
+	},
+	"google_vmwareengine_cluster": {
+		"^(?P<parent>.+)/clusters/(?P<name>[^/]+)$",
+	},
+	"google_vmwareengine_external_access_rule": {
+		"^(?P<parent>.+)/externalAccessRules/(?P<name>[^/]+)$",
+	},
+	"google_vmwareengine_external_address": {
+		"^(?P<parent>.+)/externalAddresses/(?P<name>[^/]+)$",
+	},
+	"google_vmwareengine_network": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/vmwareEngineNetworks/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_vmwareengine_network_peering": {
+		"^projects/(?P<project>[^/]+)/locations/global/networkPeerings/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_vmwareengine_network_policy": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/networkPolicies/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_vmwareengine_private_cloud": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/privateClouds/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+	"google_vmwareengine_subnet": {
+		"^(?P<parent>.+)/subnets/(?P<name>[^/]+)$",
+	},
+	"google_vpc_access_connector": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<region>[^/]+)/connectors/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	},
+	"google_workbench_instance": {
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/instances/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	},
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-provider-google/google/acctest"
 	"github.com/hashicorp/terraform-provider-google/google/provider"
@@ -1603,3 +1604,208 @@ func TestProvider_ProviderConfigure_batching(t *testing.T) {
 		})
 	}
 }
+
+func TestProvider_ProviderConfigure_rateLimits(t *testing.T) {
+	cases := map[string]struct {
+		ConfigValues     map[string]interface{}
+		ExpectError      bool
+		ExpectedEndpoint string
+	}{
+		"rate limits configured by custom endpoint name use the service's endpoint": {
+			ConfigValues: map[string]interface{}{
+				"credentials": transport_tpg.TestFakeCredentialsPath,
+				"rate_limits": []interface{}{
+					map[string]interface{}{
+						"service":             "compute",
+						"requests_per_second": 10.0,
+					},
+				},
+			},
+			ExpectedEndpoint: transport_tpg.DefaultBasePaths[transport_tpg.ComputeBasePathKey],
+		},
+		"rate limits configured by custom endpoint name follow the custom endpoint": {
+			ConfigValues: map[string]interface{}{
+				"credentials":             transport_tpg.TestFakeCredentialsPath,
+				"compute_custom_endpoint": "https://compute.example.com/compute/v1/",
+				"rate_limits": []interface{}{
+					map[string]interface{}{
+						"service":                 "compute",
+						"max_concurrent_requests": 5,
+					},
+				},
+			},
+			ExpectedEndpoint: "https://compute.example.com/compute/v1/",
+		},
+		"rate limits configured by host are matched against the host": {
+			ConfigValues: map[string]interface{}{
+				"credentials": transport_tpg.TestFakeCredentialsPath,
+				"rate_limits": []interface{}{
+					map[string]interface{}{
+						"service":             "pubsub.googleapis.com",
+						"requests_per_second": 10.0,
+					},
+				},
+			},
+			ExpectedEndpoint: "",
+		},
+		"rate limits configured more than once for a service cause an error": {
+			ConfigValues: map[string]interface{}{
+				"credentials": transport_tpg.TestFakeCredentialsPath,
+				"rate_limits": []interface{}{
+					map[string]interface{}{"service": "compute"},
+					map[string]interface{}{"service": "compute"},
+				},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+
+			// Arrange
+			ctx := context.Background()
+			acctest.UnsetTestProviderConfigEnvs(t)
+			p := provider.Provider()
+			d := tpgresource.SetupTestResourceDataFromConfigMap(t, p.Schema, tc.ConfigValues)
+
+			// Act
+			c, diags := provider.ProviderConfigure(ctx, d, p)
+
+			// Assert
+			if diags.HasError() && !tc.ExpectError {
+				t.Fatalf("unexpected error(s): %#v", diags)
+			}
+			if !diags.HasError() && tc.ExpectError {
+				t.Fatal("expected error(s) but got none")
+			}
+			if tc.ExpectError {
+				return
+			}
+
+			config := c.(*transport_tpg.Config)
+			if len(config.RateLimits) != 1 {
+				t.Fatalf("expected 1 rate limit in provider config, got %d", len(config.RateLimits))
+			}
+			if config.RateLimits[0].Endpoint != tc.ExpectedEndpoint {
+				t.Fatalf("expected rate limit endpoint to be %q, got %q", tc.ExpectedEndpoint, config.RateLimits[0].Endpoint)
+			}
+		})
+	}
+}
+
+func TestProvider_ProviderConfigure_retry(t *testing.T) {
+	cases := map[string]struct {
+		ConfigValues                   map[string]interface{}
+		ExpectError                    bool
+		ExpectedMaxAttempts            int
+		ExpectedMaxElapsedTime         time.Duration
+		ExpectedRetryableErrorEndpoint string
+	}{
+		"retry can be configured with values for max_attempts and max_elapsed_time": {
+			ConfigValues: map[string]interface{}{
+				"credentials": transport_tpg.TestFakeCredentialsPath,
+				"retry": []interface{}{
+					map[string]interface{}{
+						"max_attempts":     5,
+						"max_elapsed_time": "2m",
+					},
+				},
+			},
+			ExpectedMaxAttempts:    5,
+			ExpectedMaxElapsedTime: 2 * time.Minute,
+		},
+		"retryable errors scoped to a service use the service's endpoint": {
+			ConfigValues: map[string]interface{}{
+				"credentials": transport_tpg.TestFakeCredentialsPath,
+				"retry": []interface{}{
+					map[string]interface{}{
+						"retryable_error": []interface{}{
+							map[string]interface{}{
+								"code":    409,
+								"service": "compute",
+							},
+						},
+					},
+				},
+			},
+			ExpectedMaxElapsedTime:         90 * time.Second,
+			ExpectedRetryableErrorEndpoint: transport_tpg.DefaultBasePaths[transport_tpg.ComputeBasePathKey],
+		},
+		"if retry is not set, requests are retried for 90s without an attempt limit": {
+			ConfigValues: map[string]interface{}{
+				"credentials": transport_tpg.TestFakeCredentialsPath,
+			},
+			ExpectedMaxAttempts:    0,
+			ExpectedMaxElapsedTime: 90 * time.Second,
+		},
+		// Error states
+		"if retry is configured with max_elapsed_time as an invalid value, there's an error": {
+			ConfigValues: map[string]interface{}{
+				"credentials": transport_tpg.TestFakeCredentialsPath,
+				"retry": []interface{}{
+					map[string]interface{}{
+						"max_elapsed_time": "invalid value",
+					},
+				},
+			},
+			ExpectError: true,
+		},
+		"if a retryable error has no condition, there's an error": {
+			ConfigValues: map[string]interface{}{
+				"credentials": transport_tpg.TestFakeCredentialsPath,
+				"retry": []interface{}{
+					map[string]interface{}{
+						"retryable_error": []interface{}{
+							map[string]interface{}{
+								"service": "compute",
+							},
+						},
+					},
+				},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+
+			// Arrange
+			ctx := context.Background()
+			acctest.UnsetTestProviderConfigEnvs(t)
+			p := provider.Provider()
+			d := tpgresource.SetupTestResourceDataFromConfigMap(t, p.Schema, tc.ConfigValues)
+
+			// Act
+			c, diags := provider.ProviderConfigure(ctx, d, p)
+
+			// Assert
+			if diags.HasError() && !tc.ExpectError {
+				t.Fatalf("unexpected error(s): %#v", diags)
+			}
+			if !diags.HasError() && tc.ExpectError {
+				t.Fatal("expected error(s) but got none")
+			}
+			if tc.ExpectError {
+				return
+			}
+
+			config := c.(*transport_tpg.Config)
+			if config.RetryConfig.MaxAttempts != tc.ExpectedMaxAttempts {
+				t.Fatalf("expected max_attempts to be %d, got %d", tc.ExpectedMaxAttempts, config.RetryConfig.MaxAttempts)
+			}
+			if config.RetryConfig.MaxElapsedTime != tc.ExpectedMaxElapsedTime {
+				t.Fatalf("expected max_elapsed_time to be %s, got %s", tc.ExpectedMaxElapsedTime, config.RetryConfig.MaxElapsedTime)
+			}
+			if tc.ExpectedRetryableErrorEndpoint != "" {
+				if len(config.RetryConfig.RetryableErrors) != 1 {
+					t.Fatalf("expected 1 retryable error, got %d", len(config.RetryConfig.RetryableErrors))
+				}
+				if got := config.RetryConfig.RetryableErrors[0].Endpoint; got != tc.ExpectedRetryableErrorEndpoint {
+					t.Fatalf("expected retryable error endpoint to be %q, got %q", tc.ExpectedRetryableErrorEndpoint, got)
+				}
+			}
+		})
+	}
+}
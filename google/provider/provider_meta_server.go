@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+var providerMetaType = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		"module_name": tftypes.String,
+	},
+}
+
+// GRPCProviderServer returns a function creating the gRPC server of the SDK
+// provider p. Its plans are given a context carrying the `module_name` of the
+// `provider_meta` block of the module of the planned resource (see
+// transport_tpg.ModuleNameFromContext), which the SDK only makes available to
+// CRUD functions.
+func GRPCProviderServer(p *schema.Provider) func() tfprotov5.ProviderServer {
+	return func() tfprotov5.ProviderServer {
+		return &providerMetaServer{ProviderServer: p.GRPCProvider()}
+	}
+}
+
+type providerMetaServer struct {
+	tfprotov5.ProviderServer
+}
+
+func (s *providerMetaServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	if moduleName := providerMetaModuleName(req.ProviderMeta); moduleName != "" {
+		ctx = transport_tpg.ContextWithModuleName(ctx, moduleName)
+	}
+	return s.ProviderServer.PlanResourceChange(ctx, req)
+}
+
+// providerMetaModuleName returns the `module_name` of a provider meta, or ""
+// if it isn't set or can't be decoded. Decoding errors are left for the SDK
+// to report.
+func providerMetaModuleName(meta *tfprotov5.DynamicValue) string {
+	if meta == nil {
+		return ""
+	}
+	v, err := meta.Unmarshal(providerMetaType)
+	if err != nil || !v.IsKnown() || v.IsNull() {
+		return ""
+	}
+	var attrs map[string]tftypes.Value
+	if err := v.As(&attrs); err != nil {
+		return ""
+	}
+	var moduleName string
+	if err := attrs["module_name"].As(&moduleName); err != nil {
+		return ""
+	}
+	return moduleName
+}
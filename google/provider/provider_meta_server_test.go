@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestProviderMetaModuleName(t *testing.T) {
+	meta, err := tfprotov5.NewDynamicValue(providerMetaType, tftypes.NewValue(providerMetaType, map[string]tftypes.Value{
+		"module_name": tftypes.NewValue(tftypes.String, "my-module"),
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := providerMetaModuleName(&meta); got != "my-module" {
+		t.Errorf("expected the module name to be read, got %q", got)
+	}
+
+	meta, err = tfprotov5.NewDynamicValue(providerMetaType, tftypes.NewValue(providerMetaType, nil))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := providerMetaModuleName(&meta); got != "" {
+		t.Errorf("expected no module name for a null provider meta, got %q", got)
+	}
+	if got := providerMetaModuleName(nil); got != "" {
+		t.Errorf("expected no module name without a provider meta, got %q", got)
+	}
+}
@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+)
+
+// googleProviderAddresses are the addresses of the providers whose resources
+// can be moved to resources of this provider.
+var googleProviderAddresses = map[string]bool{
+	"registry.terraform.io/hashicorp/google":      true,
+	"registry.terraform.io/hashicorp/google-beta": true,
+}
+
+// resourceMove returns the identity of the API object of a resource of the
+// source type of a move, from its state, as a resource of the target type.
+type resourceMove func(attributes map[string]interface{}) (map[string]string, error)
+
+// resourceMoves are the `moved` blocks supported between resource types, by
+// target and source type. They are supported between types whose resources
+// are the same API object, like a service of the Cloud Run Admin API v1 and v2.
+//
+// A moved resource is imported as a resource of the target type with the id
+// built from its identity and read, like it would be after removing it from
+// state and importing it, so its state is given by the same flatteners.
+var resourceMoves = map[string]map[string]resourceMove{
+	"google_cloud_run_v2_service": {
+		"google_cloud_run_service": identityFromAttributes(map[string]string{
+			"project":  "project",
+			"location": "location",
+			"name":     "name",
+		}),
+	},
+}
+
+// unsupportedResourceMoves explain why resources of some successor types can't
+// be moved from their predecessors, by target and source type.
+var unsupportedResourceMoves = map[string]map[string]string{
+	"google_workbench_instance": {
+		"google_notebooks_instance": "User-managed notebooks instances aren't Workbench instances. They are migrated with the " +
+			"Notebooks API, which creates a new Workbench instance that can then be imported as a google_workbench_instance.",
+	},
+	"google_cloudfunctions2_function": {
+		"google_cloudfunctions_function": "1st gen functions aren't 2nd gen functions. The Cloud Functions v2 API returns them with an " +
+			"environment of GEN_1, and they can't be managed as a google_cloudfunctions2_function. Upgrade the function to 2nd gen " +
+			"with the Cloud Functions upgrade flow, then import it as a google_cloudfunctions2_function.",
+	},
+	"google_gke_hub_feature": {
+		"google_gke_hub_feature_membership": "A google_gke_hub_feature_membership is one of the membership_specs of a feature. " +
+			"Add it to the membership_specs of the google_gke_hub_feature managing the feature and remove it from state instead.",
+	},
+	"google_iam_access_boundary_policy":           unsupportedIamBetaMoves,
+	"google_iam_deny_policy":                      unsupportedIamBetaMoves,
+	"google_iam_folders_policy_binding":           unsupportedIamBetaMoves,
+	"google_iam_organizations_policy_binding":     unsupportedIamBetaMoves,
+	"google_iam_principal_access_boundary_policy": unsupportedIamBetaMoves,
+	"google_iam_projects_policy_binding":          unsupportedIamBetaMoves,
+}
+
+// unsupportedIamBetaMoves explain why the workload identity resources of the
+// IAM beta API can't be moved to the resources of the IAM v2 and v3 APIs.
+var unsupportedIamBetaMoves = map[string]string{
+	"google_iam_workload_identity_pool": "Workload identity pools have no successor type. The resources of the IAM v2 and v3 APIs " +
+		"manage deny, access boundary and principal access boundary policies and policy bindings, which are other API objects.",
+	"google_iam_workload_identity_pool_provider": "Workload identity pool providers have no successor type. The resources of the IAM v2 and v3 APIs " +
+		"manage deny, access boundary and principal access boundary policies and policy bindings, which are other API objects.",
+}
+
+// identityFromAttributes returns a resourceMove taking the identity fields of
+// the target type from the given attributes of the source type, by field.
+func identityFromAttributes(attributes map[string]string) resourceMove {
+	return func(state map[string]interface{}) (map[string]string, error) {
+		identity := make(map[string]string, len(attributes))
+		for field, attribute := range attributes {
+			v, ok := state[attribute].(string)
+			if !ok || v == "" {
+				return nil, fmt.Errorf("the moved resource has no %s", attribute)
+			}
+			identity[field] = v
+		}
+		return identity, nil
+	}
+}
+
+func (s *providerMetaServer) MoveResourceState(ctx context.Context, req *tfprotov5.MoveResourceStateRequest) (*tfprotov5.MoveResourceStateResponse, error) {
+	if !googleProviderAddresses[req.SourceProviderAddress] {
+		return s.ProviderServer.MoveResourceState(ctx, req)
+	}
+	if reason, ok := unsupportedResourceMoves[req.TargetTypeName][req.SourceTypeName]; ok {
+		return &tfprotov5.MoveResourceStateResponse{
+			Diagnostics: []*tfprotov5.Diagnostic{moveError(req, reason)},
+		}, nil
+	}
+	move, ok := resourceMoves[req.TargetTypeName][req.SourceTypeName]
+	if !ok {
+		return s.ProviderServer.MoveResourceState(ctx, req)
+	}
+	return moveResourceState(ctx, s.ProviderServer, req, move)
+}
+
+// moveResourceState moves a resource by importing the API object identified by
+// its state as a resource of the target type, and reading it. The move fails
+// if the identity of the read resource isn't the one of the moved resource.
+func moveResourceState(ctx context.Context, server tfprotov5.ProviderServer, req *tfprotov5.MoveResourceStateRequest, move resourceMove) (*tfprotov5.MoveResourceStateResponse, error) {
+	resp := &tfprotov5.MoveResourceStateResponse{}
+	fail := func(format string, a ...interface{}) (*tfprotov5.MoveResourceStateResponse, error) {
+		resp.Diagnostics = append(resp.Diagnostics, moveError(req, fmt.Sprintf(format, a...)))
+		return resp, nil
+	}
+
+	if req.SourceState == nil || len(req.SourceState.JSON) == 0 {
+		return fail("The state of the moved resource can't be read.")
+	}
+	var attributes map[string]interface{}
+	if err := json.Unmarshal(req.SourceState.JSON, &attributes); err != nil {
+		return fail("The state of the moved resource can't be read: %s", err)
+	}
+	identity, err := move(attributes)
+	if err != nil {
+		return fail("The identity of the moved resource can't be determined: %s", err)
+	}
+	formats := importFormats[req.TargetTypeName]
+	if len(formats) == 0 {
+		return fail("%s has no import formats.", req.TargetTypeName)
+	}
+	id, err := tpgresource.ImportIdFromFields(formats[0], identity)
+	if err != nil {
+		return fail("The import id of the moved resource can't be built: %s", err)
+	}
+
+	imported, err := server.ImportResourceState(ctx, &tfprotov5.ImportResourceStateRequest{
+		TypeName: req.TargetTypeName,
+		ID:       id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp.Diagnostics = append(resp.Diagnostics, imported.Diagnostics...)
+	if hasError(resp.Diagnostics) {
+		return resp, nil
+	}
+	if len(imported.ImportedResources) != 1 {
+		return fail("Importing %s as %s gave %d resources.", id, req.TargetTypeName, len(imported.ImportedResources))
+	}
+
+	read, err := server.ReadResource(ctx, &tfprotov5.ReadResourceRequest{
+		TypeName:        req.TargetTypeName,
+		CurrentState:    imported.ImportedResources[0].State,
+		Private:         imported.ImportedResources[0].Private,
+		CurrentIdentity: imported.ImportedResources[0].Identity,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp.Diagnostics = append(resp.Diagnostics, read.Diagnostics...)
+	if hasError(resp.Diagnostics) {
+		return resp, nil
+	}
+	if read.NewState == nil {
+		return fail("%s doesn't exist.", id)
+	}
+	if null, err := read.NewState.IsNull(); err != nil || null {
+		return fail("%s doesn't exist.", id)
+	}
+	if err := checkMovedIdentity(formats[0], identity, read.NewIdentity); err != nil {
+		return fail("%s", err)
+	}
+
+	resp.TargetState = read.NewState
+	resp.TargetPrivate = read.Private
+	resp.TargetIdentity = read.NewIdentity
+	return resp, nil
+}
+
+// checkMovedIdentity checks that the identity of a moved resource, when the
+// target type sets one, is the identity it was moved with. The identity fields
+// are the fields of the target type's first import format idFormat.
+func checkMovedIdentity(idFormat string, expected map[string]string, identity *tfprotov5.ResourceIdentityData) error {
+	if identity == nil || identity.IdentityData == nil {
+		return nil
+	}
+	fields, err := tpgresource.ImportIdFields(idFormat)
+	if err != nil {
+		return err
+	}
+	attributeTypes := make(map[string]tftypes.Type, len(fields))
+	for _, field := range fields {
+		attributeTypes[field] = tftypes.String
+	}
+	v, err := identity.IdentityData.Unmarshal(tftypes.Object{AttributeTypes: attributeTypes})
+	if err != nil {
+		return fmt.Errorf("The identity of the moved resource can't be read: %s", err)
+	}
+	var values map[string]tftypes.Value
+	if err := v.As(&values); err != nil {
+		return fmt.Errorf("The identity of the moved resource can't be read: %s", err)
+	}
+	for field, want := range expected {
+		var got string
+		if v, ok := values[field]; !ok || v.As(&got) != nil || got == "" {
+			continue
+		}
+		if got != want {
+			return fmt.Errorf("The resource was moved to a different object: its %s is %q rather than %q.", field, got, want)
+		}
+	}
+	return nil
+}
+
+func moveError(req *tfprotov5.MoveResourceStateRequest, detail string) *tfprotov5.Diagnostic {
+	return &tfprotov5.Diagnostic{
+		Severity: tfprotov5.DiagnosticSeverityError,
+		Summary:  fmt.Sprintf("Unable to move %s to %s", req.SourceTypeName, req.TargetTypeName),
+		Detail:   detail,
+	}
+}
+
+func hasError(diags []*tfprotov5.Diagnostic) bool {
+	for _, d := range diags {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var testIdentityType = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		"project":  tftypes.String,
+		"location": tftypes.String,
+		"name":     tftypes.String,
+	},
+}
+
+// testMoveServer imports and reads resources by id, giving them the identity
+// of the object named by identityName.
+type testMoveServer struct {
+	tfprotov5.ProviderServer
+
+	importedId   string
+	identityName string
+	exists       bool
+}
+
+func (s *testMoveServer) ImportResourceState(_ context.Context, req *tfprotov5.ImportResourceStateRequest) (*tfprotov5.ImportResourceStateResponse, error) {
+	s.importedId = req.ID
+	state, err := tfprotov5.NewDynamicValue(tftypes.String, tftypes.NewValue(tftypes.String, req.ID))
+	if err != nil {
+		return nil, err
+	}
+	return &tfprotov5.ImportResourceStateResponse{
+		ImportedResources: []*tfprotov5.ImportedResource{{TypeName: req.TypeName, State: &state}},
+	}, nil
+}
+
+func (s *testMoveServer) ReadResource(_ context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	if !s.exists {
+		state, err := tfprotov5.NewDynamicValue(tftypes.String, tftypes.NewValue(tftypes.String, nil))
+		return &tfprotov5.ReadResourceResponse{NewState: &state}, err
+	}
+	identity, err := tfprotov5.NewDynamicValue(testIdentityType, tftypes.NewValue(testIdentityType, map[string]tftypes.Value{
+		"project":  tftypes.NewValue(tftypes.String, "my-project"),
+		"location": tftypes.NewValue(tftypes.String, "us-central1"),
+		"name":     tftypes.NewValue(tftypes.String, s.identityName),
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return &tfprotov5.ReadResourceResponse{
+		NewState:    req.CurrentState,
+		NewIdentity: &tfprotov5.ResourceIdentityData{IdentityData: &identity},
+	}, nil
+}
+
+func (s *testMoveServer) MoveResourceState(_ context.Context, _ *tfprotov5.MoveResourceStateRequest) (*tfprotov5.MoveResourceStateResponse, error) {
+	return &tfprotov5.MoveResourceStateResponse{
+		Diagnostics: []*tfprotov5.Diagnostic{{Severity: tfprotov5.DiagnosticSeverityError, Summary: "Move Resource State Not Supported"}},
+	}, nil
+}
+
+func TestProvider_moveResourceState(t *testing.T) {
+	cases := map[string]struct {
+		SourceTypeName        string
+		TargetTypeName        string
+		SourceProviderAddress string
+		SourceState           string
+		IdentityName          string
+		NotFound              bool
+		ExpectedId            string
+		ExpectedError         string
+	}{
+		"cloud run service": {
+			SourceTypeName: "google_cloud_run_service",
+			TargetTypeName: "google_cloud_run_v2_service",
+			SourceState:    `{"id": "locations/us-central1/namespaces/my-project/services/my-service", "project": "my-project", "location": "us-central1", "name": "my-service"}`,
+			IdentityName:   "my-service",
+			ExpectedId:     "projects/my-project/locations/us-central1/services/my-service",
+		},
+		"cloud run service from google-beta": {
+			SourceTypeName:        "google_cloud_run_service",
+			TargetTypeName:        "google_cloud_run_v2_service",
+			SourceProviderAddress: "registry.terraform.io/hashicorp/google-beta",
+			SourceState:           `{"project": "my-project", "location": "us-central1", "name": "my-service"}`,
+			IdentityName:          "my-service",
+			ExpectedId:            "projects/my-project/locations/us-central1/services/my-service",
+		},
+		"missing attribute": {
+			SourceTypeName: "google_cloud_run_service",
+			TargetTypeName: "google_cloud_run_v2_service",
+			SourceState:    `{"project": "my-project", "name": "my-service"}`,
+			ExpectedError:  "has no location",
+		},
+		"different object": {
+			SourceTypeName: "google_cloud_run_service",
+			TargetTypeName: "google_cloud_run_v2_service",
+			SourceState:    `{"project": "my-project", "location": "us-central1", "name": "my-service"}`,
+			IdentityName:   "other-service",
+			ExpectedError:  "moved to a different object",
+		},
+		"deleted object": {
+			SourceTypeName: "google_cloud_run_service",
+			TargetTypeName: "google_cloud_run_v2_service",
+			SourceState:    `{"project": "my-project", "location": "us-central1", "name": "my-service"}`,
+			NotFound:       true,
+			ExpectedError:  "doesn't exist",
+		},
+		"unsupported move": {
+			SourceTypeName: "google_notebooks_instance",
+			TargetTypeName: "google_workbench_instance",
+			SourceState:    `{"project": "my-project", "location": "us-central1-a", "name": "my-instance"}`,
+			ExpectedError:  "aren't Workbench instances",
+		},
+		"unsupported cloud function move": {
+			SourceTypeName: "google_cloudfunctions_function",
+			TargetTypeName: "google_cloudfunctions2_function",
+			SourceState:    `{"project": "my-project", "region": "us-central1", "name": "my-function"}`,
+			IdentityName:   "my-function",
+			ExpectedError:  "1st gen functions aren't 2nd gen functions",
+		},
+		"unsupported iam beta move": {
+			SourceTypeName: "google_iam_workload_identity_pool",
+			TargetTypeName: "google_iam_principal_access_boundary_policy",
+			SourceState:    `{"project": "my-project", "workload_identity_pool_id": "my-pool"}`,
+			ExpectedError:  "Workload identity pools have no successor type",
+		},
+		"unknown move": {
+			SourceTypeName: "google_compute_network",
+			TargetTypeName: "google_cloud_run_v2_service",
+			SourceState:    `{"project": "my-project", "name": "my-network"}`,
+			ExpectedError:  "Move Resource State Not Supported",
+		},
+	}
+
+	for tn, tc := range cases {
+		server := &testMoveServer{identityName: tc.IdentityName, exists: !tc.NotFound}
+		address := tc.SourceProviderAddress
+		if address == "" {
+			address = "registry.terraform.io/hashicorp/google"
+		}
+		resp, err := (&providerMetaServer{ProviderServer: server}).MoveResourceState(context.Background(), &tfprotov5.MoveResourceStateRequest{
+			SourceProviderAddress: address,
+			SourceTypeName:        tc.SourceTypeName,
+			TargetTypeName:        tc.TargetTypeName,
+			SourceState:           &tfprotov5.RawState{JSON: []byte(tc.SourceState)},
+		})
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+			continue
+		}
+
+		if tc.ExpectedError != "" {
+			if len(resp.Diagnostics) == 0 {
+				t.Errorf("%s: expected an error containing %q", tn, tc.ExpectedError)
+			} else if d := resp.Diagnostics[0]; !strings.Contains(d.Summary+d.Detail, tc.ExpectedError) {
+				t.Errorf("%s: expected an error containing %q, got %s: %s", tn, tc.ExpectedError, d.Summary, d.Detail)
+			}
+			continue
+		}
+		if len(resp.Diagnostics) != 0 {
+			t.Errorf("%s: unexpected diagnostics: %s: %s", tn, resp.Diagnostics[0].Summary, resp.Diagnostics[0].Detail)
+			continue
+		}
+		if server.importedId != tc.ExpectedId {
+			t.Errorf("%s: expected the resource to be imported as %q, got %q", tn, tc.ExpectedId, server.importedId)
+		}
+		if resp.TargetState == nil || resp.TargetIdentity == nil {
+			t.Errorf("%s: expected a target state and identity", tn)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+// pendingOperationField stores the URL of the creation operation of a
+// resource that was still running when Terraform was stopped.
+const pendingOperationField = "pending_operation"
+
+// resumableResources returns copies of the given resources whose creation can
+// be resumed after Terraform is stopped while waiting for it to finish. Only
+// the resource types in resumableResourceTypes are resumable.
+//
+// If Terraform is stopped while Create waits for a resumable operation (see
+// tpgresource.ResumableWaiter), the resource is saved to state with the
+// operation's URL in `pending_operation` and a warning, rather than being
+// dropped from state with an error. The next Read of the resource resumes
+// waiting for the operation before reading the resource, so the next run
+// continues where the interrupted one stopped instead of recreating the
+// resource or failing because it already exists.
+func resumableResources(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	resumable := make(map[string]*schema.Resource, len(resources))
+	for name, r := range resources {
+		create := createContextFunc(r)
+		read := readContextFunc(r)
+		if !resumableResourceTypes[name] || create == nil || read == nil || r.Schema == nil {
+			resumable[name] = r
+			continue
+		}
+		if _, ok := r.Schema[pendingOperationField]; ok {
+			resumable[name] = r
+			continue
+		}
+
+		rr := *r
+		rr.Schema = make(map[string]*schema.Schema, len(r.Schema)+1)
+		for k, v := range r.Schema {
+			rr.Schema[k] = v
+		}
+		rr.Schema[pendingOperationField] = &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: `The URL of the operation creating this resource, if Terraform was stopped before it finished.`,
+		}
+
+		rr.Create, rr.CreateContext, rr.CreateWithoutTimeout = nil, nil, nil
+		rr.Read, rr.ReadContext, rr.ReadWithoutTimeout = nil, nil, nil
+		if r.CreateWithoutTimeout != nil {
+			rr.CreateWithoutTimeout = resumableCreateFunc(create)
+		} else {
+			rr.CreateContext = resumableCreateFunc(create)
+		}
+		if r.ReadWithoutTimeout != nil {
+			rr.ReadWithoutTimeout = resumableReadFunc(read)
+		} else {
+			rr.ReadContext = resumableReadFunc(read)
+		}
+		rr.CustomizeDiff = clearPendingOperationOnCreate
+		if r.CustomizeDiff != nil {
+			rr.CustomizeDiff = customdiff.All(clearPendingOperationOnCreate, r.CustomizeDiff)
+		}
+
+		resumable[name] = &rr
+	}
+	return resumable
+}
+
+func createContextFunc(r *schema.Resource) schema.CreateContextFunc {
+	switch {
+	case r.CreateWithoutTimeout != nil:
+		return r.CreateWithoutTimeout
+	case r.CreateContext != nil:
+		return r.CreateContext
+	case r.Create != nil:
+		return func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return diag.FromErr(r.Create(d, meta))
+		}
+	}
+	return nil
+}
+
+func readContextFunc(r *schema.Resource) schema.ReadContextFunc {
+	switch {
+	case r.ReadWithoutTimeout != nil:
+		return r.ReadWithoutTimeout
+	case r.ReadContext != nil:
+		return r.ReadContext
+	case r.Read != nil:
+		return func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return diag.FromErr(r.Read(d, meta))
+		}
+	}
+	return nil
+}
+
+// clearPendingOperationOnCreate plans new resources without a pending
+// operation, so that `pending_operation` isn't shown as unknown in every plan.
+func clearPendingOperationOnCreate(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() != "" {
+		return nil
+	}
+	return d.SetNew(pendingOperationField, "")
+}
+
+func resumableCreateFunc(create schema.CreateContextFunc) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		config, ok := meta.(*transport_tpg.Config)
+		if !ok {
+			return create(ctx, d, meta)
+		}
+
+		// Resources clear their ID when waiting for their creation fails, so
+		// it's captured when the operation is interrupted.
+		var interrupted *transport_tpg.InterruptedOperation
+		var id string
+		tracked := config.TrackInterruptedOperations(func(op transport_tpg.InterruptedOperation) {
+			if interrupted == nil {
+				interrupted, id = &op, d.Id()
+			}
+		})
+
+		diags := create(ctx, d, tracked)
+		if interrupted == nil || id == "" {
+			return diags
+		}
+
+		d.SetId(id)
+		if err := d.Set(pendingOperationField, interrupted.URL); err != nil {
+			return append(diags, diag.FromErr(fmt.Errorf("Error setting %s: %s", pendingOperationField, err))...)
+		}
+
+		var warnings diag.Diagnostics
+		for _, d := range diags {
+			if d.Severity != diag.Error {
+				warnings = append(warnings, d)
+			}
+		}
+		return append(warnings, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("%s was interrupted", interrupted.Activity),
+			Detail: fmt.Sprintf("Terraform was stopped before the operation %s finished. The resource was saved "+
+				"to state and the next run of Terraform will wait for the operation before reading it.", interrupted.URL),
+		})
+	}
+}
+
+func resumableReadFunc(read schema.ReadContextFunc) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		url := d.Get(pendingOperationField).(string)
+		config, ok := meta.(*transport_tpg.Config)
+		if url == "" || !ok {
+			return read(ctx, d, meta)
+		}
+
+		var diags diag.Diagnostics
+		userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := tpgresource.ResumeOperationWait(config, url, "resumed creation", userAgent, d.Timeout(schema.TimeoutCreate)); err != nil {
+			// The resource may still exist if its creation failed, so it's
+			// read regardless and removed from state if it doesn't.
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Resumed creation failed",
+				Detail:   fmt.Sprintf("Waiting for the interrupted operation %s failed: %s", url, err),
+			})
+		}
+		if err := d.Set(pendingOperationField, ""); err != nil {
+			return append(diags, diag.FromErr(fmt.Errorf("Error setting %s: %s", pendingOperationField, err))...)
+		}
+		return append(diags, read(ctx, d, meta)...)
+	}
+}
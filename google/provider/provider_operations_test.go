@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"testing"
+)
+
+func TestProvider_resumableResources(t *testing.T) {
+	resources := Provider().ResourcesMap
+	for name := range resumableResourceTypes {
+		r, ok := resources[name]
+		if !ok {
+			t.Errorf("resumable resource type %s isn't a resource of the provider", name)
+			continue
+		}
+		if _, ok := r.Schema[pendingOperationField]; !ok {
+			t.Errorf("expected resumable resource type %s to have %s", name, pendingOperationField)
+		}
+	}
+
+	// Resources that aren't created with a resumable operation don't have
+	// the field.
+	for _, name := range []string{"google_storage_bucket", "google_service_account"} {
+		if resumableResourceTypes[name] {
+			t.Fatalf("expected %s not to be resumable", name)
+		}
+		if _, ok := resources[name].Schema[pendingOperationField]; ok {
+			t.Errorf("expected %s not to have %s", name, pendingOperationField)
+		}
+	}
+}
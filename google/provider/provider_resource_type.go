@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+// resourceTypeResources returns copies of the given resources whose CRUD and
+// CustomizeDiff functions are given a configuration for their resource type
+// (see transport_tpg.Config.ForResourceType). This lets the shared code of
+// resources depend on their type, e.g. IAM resources decide whether to batch
+// their changes from the parent type settings of the provider's `batching`
+// block, and default labels can be scoped to some resource types.
+func resourceTypeResources(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	typed := make(map[string]*schema.Resource, len(resources))
+	for name, r := range resources {
+		rr := *r
+		rr.Create = forResourceTypeFunc(name, r.Create)
+		rr.Read = forResourceTypeFunc(name, r.Read)
+		rr.Update = forResourceTypeFunc(name, r.Update)
+		rr.Delete = forResourceTypeFunc(name, r.Delete)
+		rr.CreateContext = forResourceTypeContextFunc(name, r.CreateContext)
+		rr.ReadContext = forResourceTypeContextFunc(name, r.ReadContext)
+		rr.UpdateContext = forResourceTypeContextFunc(name, r.UpdateContext)
+		rr.DeleteContext = forResourceTypeContextFunc(name, r.DeleteContext)
+		rr.CreateWithoutTimeout = forResourceTypeContextFunc(name, r.CreateWithoutTimeout)
+		rr.ReadWithoutTimeout = forResourceTypeContextFunc(name, r.ReadWithoutTimeout)
+		rr.UpdateWithoutTimeout = forResourceTypeContextFunc(name, r.UpdateWithoutTimeout)
+		rr.DeleteWithoutTimeout = forResourceTypeContextFunc(name, r.DeleteWithoutTimeout)
+		rr.CustomizeDiff = forResourceTypeCustomizeDiff(name, r.CustomizeDiff)
+		typed[name] = &rr
+	}
+	return typed
+}
+
+func forResourceTypeMeta(resourceType string, meta interface{}) interface{} {
+	if config, ok := meta.(*transport_tpg.Config); ok {
+		return config.ForResourceType(resourceType)
+	}
+	return meta
+}
+
+func forResourceTypeFunc(resourceType string, f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if f == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, meta interface{}) error {
+		return f(d, forResourceTypeMeta(resourceType, meta))
+	}
+}
+
+func forResourceTypeContextFunc(resourceType string, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return f(ctx, d, forResourceTypeMeta(resourceType, meta))
+	}
+}
+
+func forResourceTypeCustomizeDiff(resourceType string, f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		return f(ctx, d, forResourceTypeMeta(resourceType, meta))
+	}
+}
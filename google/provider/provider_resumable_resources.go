@@ -0,0 +1,322 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+// resumableResourceTypes are the resource types whose Create waits for an
+// operation with a tpgresource.ResumableWaiter, and so get a
+// `pending_operation` field. See resumableResources.
+var resumableResourceTypes = map[string]bool{
+	"google_access_context_manager_access_level":                             true,
+	"google_access_context_manager_access_levels":                            true,
+	"google_access_context_manager_access_policy":                            true,
+	"google_access_context_manager_authorized_orgs_desc":                     true,
+	"google_access_context_manager_egress_policy":                            true,
+	"google_access_context_manager_gcp_user_access_binding":                  true,
+	"google_access_context_manager_ingress_policy":                           true,
+	"google_access_context_manager_service_perimeter":                        true,
+	"google_access_context_manager_service_perimeter_dry_run_egress_policy":  true,
+	"google_access_context_manager_service_perimeter_dry_run_ingress_policy": true,
+	"google_access_context_manager_service_perimeter_dry_run_resource":       true,
+	"google_access_context_manager_service_perimeter_egress_policy":          true,
+	"google_access_context_manager_service_perimeter_ingress_policy":         true,
+	"google_access_context_manager_service_perimeter_resource":               true,
+	"google_access_context_manager_service_perimeters":                       true,
+	"google_active_directory_domain":                                         true,
+	"google_active_directory_domain_trust":                                   true,
+	"google_alloydb_backup":                                                  true,
+	"google_alloydb_cluster":                                                 true,
+	"google_alloydb_instance":                                                true,
+	"google_apigee_addons_config":                                            true,
+	"google_apigee_endpoint_attachment":                                      true,
+	"google_apigee_envgroup":                                                 true,
+	"google_apigee_envgroup_attachment":                                      true,
+	"google_apigee_environment":                                              true,
+	"google_apigee_environment_addons_config":                                true,
+	"google_apigee_instance":                                                 true,
+	"google_apigee_instance_attachment":                                      true,
+	"google_apigee_nat_address":                                              true,
+	"google_apigee_organization":                                             true,
+	"google_apihub_api_hub_instance":                                         true,
+	"google_apphub_application":                                              true,
+	"google_apphub_service":                                                  true,
+	"google_apphub_service_project_attachment":                               true,
+	"google_apphub_workload":                                                 true,
+	"google_artifact_registry_repository":                                    true,
+	"google_backup_dr_backup_vault":                                          true,
+	"google_beyondcorp_app_connection":                                       true,
+	"google_beyondcorp_app_connector":                                        true,
+	"google_beyondcorp_app_gateway":                                          true,
+	"google_beyondcorp_application":                                          true,
+	"google_beyondcorp_security_gateway":                                     true,
+	"google_blockchain_node_engine_blockchain_nodes":                         true,
+	"google_certificate_manager_certificate":                                 true,
+	"google_certificate_manager_certificate_issuance_config":                 true,
+	"google_certificate_manager_certificate_map":                             true,
+	"google_certificate_manager_certificate_map_entry":                       true,
+	"google_certificate_manager_dns_authorization":                           true,
+	"google_certificate_manager_trust_config":                                true,
+	"google_cloud_ids_endpoint":                                              true,
+	"google_cloud_run_v2_job":                                                true,
+	"google_cloud_run_v2_service":                                            true,
+	"google_cloudbuild_bitbucket_server_config":                              true,
+	"google_cloudbuildv2_connection":                                         true,
+	"google_cloudbuildv2_repository":                                         true,
+	"google_clouddeploy_automation":                                          true,
+	"google_clouddeploy_custom_target_type":                                  true,
+	"google_clouddomains_registration":                                       true,
+	"google_cloudfunctions2_function":                                        true,
+	"google_compute_address":                                                 true,
+	"google_compute_attached_disk":                                           true,
+	"google_compute_autoscaler":                                              true,
+	"google_compute_backend_bucket":                                          true,
+	"google_compute_backend_bucket_signed_url_key":                           true,
+	"google_compute_backend_service":                                         true,
+	"google_compute_backend_service_signed_url_key":                          true,
+	"google_compute_disk":                                                    true,
+	"google_compute_disk_resource_policy_attachment":                         true,
+	"google_compute_external_vpn_gateway":                                    true,
+	"google_compute_firewall":                                                true,
+	"google_compute_firewall_policy_association":                             true,
+	"google_compute_firewall_policy_rule":                                    true,
+	"google_compute_forwarding_rule":                                         true,
+	"google_compute_global_address":                                          true,
+	"google_compute_global_forwarding_rule":                                  true,
+	"google_compute_global_network_endpoint":                                 true,
+	"google_compute_global_network_endpoint_group":                           true,
+	"google_compute_ha_vpn_gateway":                                          true,
+	"google_compute_health_check":                                            true,
+	"google_compute_http_health_check":                                       true,
+	"google_compute_https_health_check":                                      true,
+	"google_compute_image":                                                   true,
+	"google_compute_instance":                                                true,
+	"google_compute_instance_from_template":                                  true,
+	"google_compute_instance_group":                                          true,
+	"google_compute_instance_group_manager":                                  true,
+	"google_compute_instance_group_membership":                               true,
+	"google_compute_instance_group_named_port":                               true,
+	"google_compute_instance_settings":                                       true,
+	"google_compute_instance_template":                                       true,
+	"google_compute_interconnect":                                            true,
+	"google_compute_interconnect_attachment":                                 true,
+	"google_compute_managed_ssl_certificate":                                 true,
+	"google_compute_network":                                                 true,
+	"google_compute_network_attachment":                                      true,
+	"google_compute_network_endpoint":                                        true,
+	"google_compute_network_endpoint_group":                                  true,
+	"google_compute_network_endpoints":                                       true,
+	"google_compute_network_firewall_policy":                                 true,
+	"google_compute_network_firewall_policy_association":                     true,
+	"google_compute_network_firewall_policy_rule":                            true,
+	"google_compute_network_peering":                                         true,
+	"google_compute_network_peering_routes_config":                           true,
+	"google_compute_node_group":                                              true,
+	"google_compute_node_template":                                           true,
+	"google_compute_packet_mirroring":                                        true,
+	"google_compute_per_instance_config":                                     true,
+	"google_compute_project_cloud_armor_tier":                                true,
+	"google_compute_project_default_network_tier":                            true,
+	"google_compute_public_advertised_prefix":                                true,
+	"google_compute_public_delegated_prefix":                                 true,
+	"google_compute_region_autoscaler":                                       true,
+	"google_compute_region_backend_service":                                  true,
+	"google_compute_region_commitment":                                       true,
+	"google_compute_region_disk":                                             true,
+	"google_compute_region_disk_resource_policy_attachment":                  true,
+	"google_compute_region_health_check":                                     true,
+	"google_compute_region_instance_group_manager":                           true,
+	"google_compute_region_instance_template":                                true,
+	"google_compute_region_network_endpoint":                                 true,
+	"google_compute_region_network_endpoint_group":                           true,
+	"google_compute_region_network_firewall_policy":                          true,
+	"google_compute_region_network_firewall_policy_association":              true,
+	"google_compute_region_network_firewall_policy_rule":                     true,
+	"google_compute_region_per_instance_config":                              true,
+	"google_compute_region_ssl_certificate":                                  true,
+	"google_compute_region_ssl_policy":                                       true,
+	"google_compute_region_target_http_proxy":                                true,
+	"google_compute_region_target_https_proxy":                               true,
+	"google_compute_region_target_tcp_proxy":                                 true,
+	"google_compute_region_url_map":                                          true,
+	"google_compute_reservation":                                             true,
+	"google_compute_resize_request":                                          true,
+	"google_compute_resource_policy":                                         true,
+	"google_compute_route":                                                   true,
+	"google_compute_router":                                                  true,
+	"google_compute_router_interface":                                        true,
+	"google_compute_router_nat":                                              true,
+	"google_compute_router_nat_address":                                      true,
+	"google_compute_router_peer":                                             true,
+	"google_compute_security_policy":                                         true,
+	"google_compute_security_policy_rule":                                    true,
+	"google_compute_service_attachment":                                      true,
+	"google_compute_shared_vpc_host_project":                                 true,
+	"google_compute_shared_vpc_service_project":                              true,
+	"google_compute_snapshot":                                                true,
+	"google_compute_ssl_certificate":                                         true,
+	"google_compute_ssl_policy":                                              true,
+	"google_compute_subnetwork":                                              true,
+	"google_compute_target_grpc_proxy":                                       true,
+	"google_compute_target_http_proxy":                                       true,
+	"google_compute_target_https_proxy":                                      true,
+	"google_compute_target_instance":                                         true,
+	"google_compute_target_pool":                                             true,
+	"google_compute_target_ssl_proxy":                                        true,
+	"google_compute_target_tcp_proxy":                                        true,
+	"google_compute_url_map":                                                 true,
+	"google_compute_vpn_gateway":                                             true,
+	"google_compute_vpn_tunnel":                                              true,
+	"google_container_cluster":                                               true,
+	"google_container_node_pool":                                             true,
+	"google_data_fusion_instance":                                            true,
+	"google_database_migration_service_connection_profile":                   true,
+	"google_database_migration_service_migration_job":                        true,
+	"google_database_migration_service_private_connection":                   true,
+	"google_dataplex_aspect_type":                                            true,
+	"google_dataplex_datascan":                                               true,
+	"google_dataplex_entry_group":                                            true,
+	"google_dataplex_entry_type":                                             true,
+	"google_dataplex_task":                                                   true,
+	"google_dataproc_batch":                                                  true,
+	"google_dataproc_gdc_service_instance":                                   true,
+	"google_dataproc_gdc_spark_application":                                  true,
+	"google_dataproc_metastore_federation":                                   true,
+	"google_dataproc_metastore_service":                                      true,
+	"google_datastream_connection_profile":                                   true,
+	"google_datastream_private_connection":                                   true,
+	"google_datastream_stream":                                               true,
+	"google_developer_connect_connection":                                    true,
+	"google_developer_connect_git_repository_link":                           true,
+	"google_discovery_engine_chat_engine":                                    true,
+	"google_discovery_engine_data_store":                                     true,
+	"google_discovery_engine_schema":                                         true,
+	"google_discovery_engine_search_engine":                                  true,
+	"google_discovery_engine_target_site":                                    true,
+	"google_document_ai_warehouse_location":                                  true,
+	"google_edgecontainer_cluster":                                           true,
+	"google_edgecontainer_node_pool":                                         true,
+	"google_edgecontainer_vpn_connection":                                    true,
+	"google_edgenetwork_interconnect_attachment":                             true,
+	"google_edgenetwork_network":                                             true,
+	"google_edgenetwork_subnet":                                              true,
+	"google_filestore_backup":                                                true,
+	"google_filestore_instance":                                              true,
+	"google_filestore_snapshot":                                              true,
+	"google_firestore_database":                                              true,
+	"google_firestore_field":                                                 true,
+	"google_firestore_index":                                                 true,
+	"google_folder":                                                          true,
+	"google_gemini_code_repository_index":                                    true,
+	"google_gemini_repository_group":                                         true,
+	"google_gke_backup_backup_plan":                                          true,
+	"google_gke_backup_restore_plan":                                         true,
+	"google_gke_hub_feature":                                                 true,
+	"google_gke_hub_fleet":                                                   true,
+	"google_gke_hub_membership":                                              true,
+	"google_gke_hub_membership_binding":                                      true,
+	"google_gke_hub_namespace":                                               true,
+	"google_gke_hub_scope":                                                   true,
+	"google_gke_hub_scope_rbac_role_binding":                                 true,
+	"google_gkeonprem_bare_metal_admin_cluster":                              true,
+	"google_gkeonprem_bare_metal_cluster":                                    true,
+	"google_gkeonprem_bare_metal_node_pool":                                  true,
+	"google_gkeonprem_vmware_cluster":                                        true,
+	"google_gkeonprem_vmware_node_pool":                                      true,
+	"google_iam_access_boundary_policy":                                      true,
+	"google_iam_deny_policy":                                                 true,
+	"google_iam_folders_policy_binding":                                      true,
+	"google_iam_organizations_policy_binding":                                true,
+	"google_iam_principal_access_boundary_policy":                            true,
+	"google_iam_projects_policy_binding":                                     true,
+	"google_iam_workforce_pool":                                              true,
+	"google_iam_workforce_pool_provider":                                     true,
+	"google_iam_workload_identity_pool":                                      true,
+	"google_iam_workload_identity_pool_provider":                             true,
+	"google_integration_connectors_connection":                               true,
+	"google_integration_connectors_endpoint_attachment":                      true,
+	"google_integration_connectors_managed_zone":                             true,
+	"google_logging_linked_dataset":                                          true,
+	"google_logging_project_bucket_config":                                   true,
+	"google_looker_instance":                                                 true,
+	"google_managed_kafka_cluster":                                           true,
+	"google_memcache_instance":                                               true,
+	"google_memorystore_instance":                                            true,
+	"google_migration_center_group":                                          true,
+	"google_migration_center_preference_set":                                 true,
+	"google_netapp_active_directory":                                         true,
+	"google_netapp_backup":                                                   true,
+	"google_netapp_backup_policy":                                            true,
+	"google_netapp_backup_vault":                                             true,
+	"google_netapp_kmsconfig":                                                true,
+	"google_netapp_storage_pool":                                             true,
+	"google_netapp_volume":                                                   true,
+	"google_netapp_volume_replication":                                       true,
+	"google_netapp_volume_snapshot":                                          true,
+	"google_network_connectivity_group":                                      true,
+	"google_network_connectivity_hub":                                        true,
+	"google_network_connectivity_internal_range":                             true,
+	"google_network_connectivity_policy_based_route":                         true,
+	"google_network_connectivity_regional_endpoint":                          true,
+	"google_network_connectivity_service_connection_policy":                  true,
+	"google_network_connectivity_spoke":                                      true,
+	"google_network_management_connectivity_test":                            true,
+	"google_network_management_vpc_flow_logs_config":                         true,
+	"google_network_security_address_group":                                  true,
+	"google_network_security_authz_policy":                                   true,
+	"google_network_security_client_tls_policy":                              true,
+	"google_network_security_firewall_endpoint":                              true,
+	"google_network_security_firewall_endpoint_association":                  true,
+	"google_network_security_gateway_security_policy":                        true,
+	"google_network_security_gateway_security_policy_rule":                   true,
+	"google_network_security_security_profile":                               true,
+	"google_network_security_security_profile_group":                         true,
+	"google_network_security_server_tls_policy":                              true,
+	"google_network_security_tls_inspection_policy":                          true,
+	"google_network_security_url_lists":                                      true,
+	"google_network_services_authz_extension":                                true,
+	"google_network_services_edge_cache_keyset":                              true,
+	"google_network_services_edge_cache_origin":                              true,
+	"google_network_services_edge_cache_service":                             true,
+	"google_network_services_gateway":                                        true,
+	"google_network_services_lb_route_extension":                             true,
+	"google_network_services_lb_traffic_extension":                           true,
+	"google_notebooks_environment":                                           true,
+	"google_notebooks_instance":                                              true,
+	"google_notebooks_location":                                              true,
+	"google_notebooks_runtime":                                               true,
+	"google_oracle_database_autonomous_database":                             true,
+	"google_oracle_database_cloud_exadata_infrastructure":                    true,
+	"google_oracle_database_cloud_vm_cluster":                                true,
+	"google_parallelstore_instance":                                          true,
+	"google_privateca_ca_pool":                                               true,
+	"google_privateca_certificate_authority":                                 true,
+	"google_privateca_certificate_template":                                  true,
+	"google_privileged_access_manager_entitlement":                           true,
+	"google_project":                                                         true,
+	"google_project_usage_export_bucket":                                     true,
+	"google_redis_cluster":                                                   true,
+	"google_redis_cluster_user_created_connections":                          true,
+	"google_redis_instance":                                                  true,
+	"google_secure_source_manager_branch_rule":                               true,
+	"google_secure_source_manager_instance":                                  true,
+	"google_secure_source_manager_repository":                                true,
+	"google_securityposture_posture":                                         true,
+	"google_securityposture_posture_deployment":                              true,
+	"google_spanner_database":                                                true,
+	"google_spanner_instance":                                                true,
+	"google_spanner_instance_config":                                         true,
+	"google_tags_tag_binding":                                                true,
+	"google_tags_tag_key":                                                    true,
+	"google_tags_tag_value":                                                  true,
+	"google_tpu_node":                                                        true,
+	"google_vmwareengine_cluster":                                            true,
+	"google_vmwareengine_external_access_rule":                               true,
+	"google_vmwareengine_external_address":                                   true,
+	"google_vmwareengine_network":                                            true,
+	"google_vmwareengine_network_peering":                                    true,
+	"google_vmwareengine_network_policy":                                     true,
+	"google_vmwareengine_private_cloud":                                      true,
+	"google_vmwareengine_subnet":                                             true,
+	"google_vpc_access_connector":                                            true,
+	"google_workbench_instance":                                              true,
+	"google_workflows_workflow":                                              true,
+}
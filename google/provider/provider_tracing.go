@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.opentelemetry.io/otel/attribute"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+// tracedResources returns copies of the given resources whose CRUD and import
+// functions record a span for each call when tracing is configured. The span
+// is passed down to the resource through the meta configuration, so requests
+// and operation polls made by the resource are recorded as its children.
+func tracedResources(resources map[string]*schema.Resource, prefix string) map[string]*schema.Resource {
+	traced := make(map[string]*schema.Resource, len(resources))
+	for name, r := range resources {
+		tr := *r
+		typeName := prefix + name
+
+		tr.Create = tracedCRUDFunc(typeName, "Create", tr.Create)
+		tr.Read = tracedCRUDFunc(typeName, "Read", tr.Read)
+		tr.Update = tracedCRUDFunc(typeName, "Update", tr.Update)
+		tr.Delete = tracedCRUDFunc(typeName, "Delete", tr.Delete)
+		tr.CreateContext = tracedCRUDContextFunc(typeName, "Create", tr.CreateContext)
+		tr.ReadContext = tracedCRUDContextFunc(typeName, "Read", tr.ReadContext)
+		tr.UpdateContext = tracedCRUDContextFunc(typeName, "Update", tr.UpdateContext)
+		tr.DeleteContext = tracedCRUDContextFunc(typeName, "Delete", tr.DeleteContext)
+		tr.CreateWithoutTimeout = tracedCRUDContextFunc(typeName, "Create", tr.CreateWithoutTimeout)
+		tr.ReadWithoutTimeout = tracedCRUDContextFunc(typeName, "Read", tr.ReadWithoutTimeout)
+		tr.UpdateWithoutTimeout = tracedCRUDContextFunc(typeName, "Update", tr.UpdateWithoutTimeout)
+		tr.DeleteWithoutTimeout = tracedCRUDContextFunc(typeName, "Delete", tr.DeleteWithoutTimeout)
+
+		if r.Importer != nil {
+			importer := *r.Importer
+			importer.State = tracedImportFunc(typeName, importer.State)
+			importer.StateContext = tracedImportContextFunc(typeName, importer.StateContext)
+			tr.Importer = &importer
+		}
+
+		traced[name] = &tr
+	}
+	return traced
+}
+
+// startResourceSpan starts the span of a single resource operation. If meta
+// isn't a provider configuration (e.g. in unit tests), meta is returned
+// unchanged along with a nil end function.
+func startResourceSpan(typeName, operation string, d *schema.ResourceData, meta interface{}) (interface{}, func(error)) {
+	config, ok := meta.(*transport_tpg.Config)
+	if !ok {
+		return meta, nil
+	}
+	traced, span := config.StartSpan(fmt.Sprintf("%s %s", operation, typeName),
+		attribute.String("terraform.resource_type", typeName),
+		attribute.String("terraform.operation", operation),
+		attribute.String("terraform.resource_id", d.Id()),
+	)
+	return traced, func(err error) {
+		if id := d.Id(); id != "" {
+			span.SetAttributes(attribute.String("terraform.resource_id", id))
+		}
+		transport_tpg.EndSpan(span, err)
+	}
+}
+
+func tracedCRUDFunc(typeName, operation string, f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if f == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, meta interface{}) (err error) {
+		meta, end := startResourceSpan(typeName, operation, d, meta)
+		if end != nil {
+			defer func() { end(err) }()
+		}
+		return f(d, meta)
+	}
+}
+
+func tracedCRUDContextFunc(typeName, operation string, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+		meta, end := startResourceSpan(typeName, operation, d, meta)
+		if end != nil {
+			defer func() { end(diagnosticsError(diags)) }()
+		}
+		return f(ctx, d, meta)
+	}
+}
+
+func tracedImportFunc(typeName string, f schema.StateFunc) schema.StateFunc {
+	if f == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, meta interface{}) (_ []*schema.ResourceData, err error) {
+		meta, end := startResourceSpan(typeName, "Import", d, meta)
+		if end != nil {
+			defer func() { end(err) }()
+		}
+		return f(d, meta)
+	}
+}
+
+func tracedImportContextFunc(typeName string, f schema.StateContextFunc) schema.StateContextFunc {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) (_ []*schema.ResourceData, err error) {
+		meta, end := startResourceSpan(typeName, "Import", d, meta)
+		if end != nil {
+			defer func() { end(err) }()
+		}
+		return f(ctx, d, meta)
+	}
+}
+
+// diagnosticsError returns the first error diagnostic as an error, or nil if
+// there is none.
+func diagnosticsError(diags diag.Diagnostics) error {
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			return fmt.Errorf("%s", d.Summary)
+		}
+	}
+	return nil
+}
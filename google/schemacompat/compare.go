@@ -0,0 +1,402 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package schemacompat
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Kinds of changes. The breaking ones can make configurations that work with
+// the old version of the provider fail, or plan changes, with the new one.
+const (
+	ResourceRemoved     = "resource_removed"
+	FieldRemoved        = "field_removed"
+	OptionalToRequired  = "optional_to_required"
+	RequiredFieldAdded  = "required_field_added"
+	ForceNewAdded       = "force_new_added"
+	TypeChanged         = "type_changed"
+	ValidationTightened = "validation_tightened"
+	DefaultChanged      = "default_changed"
+	ComputedRemoved     = "computed_removed"
+	SensitiveAdded      = "sensitive_added"
+	ImportFormatRemoved = "import_format_removed"
+	ResourceAdded       = "resource_added"
+	FieldAdded          = "field_added"
+	RequiredToOptional  = "required_to_optional"
+	ForceNewRemoved     = "force_new_removed"
+	ValidationLoosened  = "validation_loosened"
+	ImportFormatAdded   = "import_format_added"
+	// ValidationChanged is a change to the arguments of a validation
+	// function that can't be told to be tightened or loosened.
+	ValidationChanged = "validation_changed"
+)
+
+// breakingKinds are the kinds of breaking changes.
+var breakingKinds = map[string]bool{
+	ResourceRemoved:     true,
+	FieldRemoved:        true,
+	OptionalToRequired:  true,
+	RequiredFieldAdded:  true,
+	ForceNewAdded:       true,
+	TypeChanged:         true,
+	ValidationTightened: true,
+	DefaultChanged:      true,
+	ComputedRemoved:     true,
+	SensitiveAdded:      true,
+	ImportFormatRemoved: true,
+}
+
+// Change is a change to the schema of a resource, data source or ephemeral
+// resource.
+type Change struct {
+	Kind     string `json:"kind"`
+	Breaking bool   `json:"breaking"`
+	// Category is resource, data_source or ephemeral_resource.
+	Category string `json:"category"`
+	Name     string `json:"name"`
+	// Path is the path of the changed field, e.g. boot_disk.initialize_params.image.
+	Path    string `json:"path,omitempty"`
+	Old     string `json:"old,omitempty"`
+	New     string `json:"new,omitempty"`
+	Message string `json:"message"`
+}
+
+// Compare returns the changes from the old snapshot to the new one, sorted by
+// category, name and path. Import formats are only compared if they are known
+// for both versions.
+func Compare(old, new *Snapshot) []Change {
+	c := &comparison{}
+	c.compareSchemas("resource", old.Resources, new.Resources)
+	c.compareSchemas("data_source", old.DataSources, new.DataSources)
+	c.compareSchemas("ephemeral_resource", old.EphemeralResources, new.EphemeralResources)
+	c.compareImportFormats(old, new)
+
+	sort.SliceStable(c.changes, func(i, j int) bool {
+		a, b := c.changes[i], c.changes[j]
+		if a.Category != b.Category {
+			return a.Category < b.Category
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Path < b.Path
+	})
+	return c.changes
+}
+
+// HasBreakingChanges returns whether any of the changes is breaking.
+func HasBreakingChanges(changes []Change) bool {
+	for _, c := range changes {
+		if c.Breaking {
+			return true
+		}
+	}
+	return false
+}
+
+type comparison struct {
+	changes []Change
+
+	// The schema being compared.
+	category string
+	name     string
+}
+
+func (c *comparison) add(kind, path, old, new, message string, args ...interface{}) {
+	c.changes = append(c.changes, Change{
+		Kind:     kind,
+		Breaking: breakingKinds[kind],
+		Category: c.category,
+		Name:     c.name,
+		Path:     path,
+		Old:      old,
+		New:      new,
+		Message:  fmt.Sprintf(message, args...),
+	})
+}
+
+func (c *comparison) compareSchemas(category string, old, new map[string]map[string]*Attribute) {
+	c.category = category
+	for _, name := range sortedKeys(old) {
+		c.name = name
+		if _, ok := new[name]; !ok {
+			c.add(ResourceRemoved, "", "", "", "%s %s was removed", strings.ReplaceAll(category, "_", " "), name)
+			continue
+		}
+		c.compareAttributes("", old[name], new[name])
+	}
+	for _, name := range sortedKeys(new) {
+		c.name = name
+		if _, ok := old[name]; !ok {
+			c.add(ResourceAdded, "", "", "", "%s %s was added", strings.ReplaceAll(category, "_", " "), name)
+		}
+	}
+}
+
+func (c *comparison) compareAttributes(parent string, old, new map[string]*Attribute) {
+	for _, k := range sortedKeys(old) {
+		path := joinPath(parent, k)
+		if _, ok := new[k]; !ok {
+			c.add(FieldRemoved, path, "", "", "field %s was removed", path)
+			continue
+		}
+		c.compareAttribute(path, old[k], new[k])
+	}
+	for _, k := range sortedKeys(new) {
+		if _, ok := old[k]; ok {
+			continue
+		}
+		path := joinPath(parent, k)
+		if new[k].Required {
+			c.add(RequiredFieldAdded, path, "", "", "required field %s was added", path)
+		} else {
+			c.add(FieldAdded, path, "", "", "field %s was added", path)
+		}
+	}
+}
+
+func (c *comparison) compareAttribute(path string, old, new *Attribute) {
+	if old.Type != new.Type {
+		c.add(TypeChanged, path, old.Type, new.Type, "type of %s changed from %s to %s", path, old.Type, new.Type)
+		// Types are different, other changes won't make sense
+		return
+	}
+
+	switch {
+	case !old.Required && new.Required:
+		c.add(OptionalToRequired, path, "", "", "field %s is now required", path)
+	case old.Required && !new.Required:
+		c.add(RequiredToOptional, path, "", "", "field %s is no longer required", path)
+	}
+	if old.Computed && !new.Computed {
+		c.add(ComputedRemoved, path, "", "", "field %s is no longer computed, and is null when not set", path)
+	}
+	switch {
+	case !old.ForceNew && new.ForceNew:
+		c.add(ForceNewAdded, path, "", "", "changing field %s now recreates the resource", path)
+	case old.ForceNew && !new.ForceNew:
+		c.add(ForceNewRemoved, path, "", "", "changing field %s no longer recreates the resource", path)
+	}
+	if !old.Sensitive && new.Sensitive {
+		c.add(SensitiveAdded, path, "", "", "field %s is now sensitive, and outputs referencing it must be marked as sensitive", path)
+	}
+	if old.Default != new.Default {
+		c.add(DefaultChanged, path, old.Default, new.Default, "default of %s changed from %s to %s", path, orNone(old.Default), orNone(new.Default))
+	}
+	c.compareValidation(path, old, new)
+
+	if old.Elem != nil && new.Elem != nil {
+		c.compareAttribute(path+".*", old.Elem, new.Elem)
+	} else if (old.Elem == nil) != (new.Elem == nil) {
+		c.add(TypeChanged, path, elemType(old), elemType(new), "element type of %s changed from %s to %s", path, elemType(old), elemType(new))
+	}
+	if old.Attributes != nil || new.Attributes != nil {
+		c.compareAttributes(path, old.Attributes, new.Attributes)
+	}
+}
+
+func (c *comparison) compareValidation(path string, old, new *Attribute) {
+	if new.MaxItems != 0 && (old.MaxItems == 0 || new.MaxItems < old.MaxItems) {
+		c.add(ValidationTightened, path, itemsLimit(old.MaxItems), itemsLimit(new.MaxItems), "maximum number of items of %s lowered from %s to %d", path, itemsLimit(old.MaxItems), new.MaxItems)
+	} else if old.MaxItems != 0 && (new.MaxItems == 0 || new.MaxItems > old.MaxItems) {
+		c.add(ValidationLoosened, path, itemsLimit(old.MaxItems), itemsLimit(new.MaxItems), "maximum number of items of %s raised from %d to %s", path, old.MaxItems, itemsLimit(new.MaxItems))
+	}
+	if new.MinItems > old.MinItems {
+		c.add(ValidationTightened, path, fmt.Sprint(old.MinItems), fmt.Sprint(new.MinItems), "minimum number of items of %s raised from %d to %d", path, old.MinItems, new.MinItems)
+	} else if new.MinItems < old.MinItems {
+		c.add(ValidationLoosened, path, fmt.Sprint(old.MinItems), fmt.Sprint(new.MinItems), "minimum number of items of %s lowered from %d to %d", path, old.MinItems, new.MinItems)
+	}
+
+	// A validation replaced by one with the same name had its arguments
+	// changed. Other new validations are assumed to be tightened.
+	added := difference(new.Validation, old.Validation)
+	removed := difference(old.Validation, new.Validation)
+	var unmatched []string
+	for _, a := range added {
+		i := indexOfValidator(removed, validatorName(a))
+		if i < 0 {
+			unmatched = append(unmatched, a)
+			continue
+		}
+		r := removed[i]
+		removed = append(removed[:i:i], removed[i+1:]...)
+		switch validationChangeKind(r, a) {
+		case ValidationTightened:
+			c.add(ValidationTightened, path, r, a, "validation of %s tightened from %s to %s", path, r, a)
+		case ValidationLoosened:
+			c.add(ValidationLoosened, path, r, a, "validation of %s loosened from %s to %s", path, r, a)
+		default:
+			c.add(ValidationChanged, path, r, a, "validation of %s changed from %s to %s, check whether values that were valid still are", path, r, a)
+		}
+	}
+	switch {
+	case len(unmatched) > 0:
+		c.add(ValidationTightened, path, strings.Join(old.Validation, "; "), strings.Join(new.Validation, "; "), "validation of %s added or changed: %s", path, strings.Join(unmatched, "; "))
+	case len(removed) > 0:
+		c.add(ValidationLoosened, path, strings.Join(old.Validation, "; "), strings.Join(new.Validation, "; "), "validation of %s removed: %s", path, strings.Join(removed, "; "))
+	}
+}
+
+// validatorName returns the name of the function of a validation, as
+// described by validatorDescription, or the description of a framework
+// validator.
+func validatorName(v string) string {
+	if i := strings.Index(v, ": "); i >= 0 {
+		return v[:i]
+	}
+	return v
+}
+
+func indexOfValidator(validations []string, name string) int {
+	for i, v := range validations {
+		if validatorName(v) == name {
+			return i
+		}
+	}
+	return -1
+}
+
+var (
+	// validationEnum matches the accepted values in the errors of
+	// validation.StringInSlice, and in the descriptions of the framework's
+	// OneOf validators.
+	validationEnum = regexp.MustCompile(`one of:? \[((?:"(?:[^"\\]|\\.)*" ?)*)\]`)
+	// validationRange matches the range in the errors of
+	// validation.IntBetween, FloatBetween and StringLenBetween.
+	validationRange = regexp.MustCompile(`in the range \((\S+) - (\S+)\)`)
+	// validationAtLeast and validationAtMost match the bound in the errors
+	// of validation.IntAtLeast, FloatAtLeast, IntAtMost and FloatAtMost.
+	validationAtLeast = regexp.MustCompile(`at least \((\S+)\)`)
+	validationAtMost  = regexp.MustCompile(`at most \((\S+)\)`)
+	quotedString      = regexp.MustCompile(`"(?:[^"\\]|\\.)*"`)
+)
+
+// validationChangeKind returns whether the validation old was tightened or
+// loosened to new, from the arguments in their descriptions, or
+// ValidationChanged if that can't be told.
+func validationChangeKind(old, new string) string {
+	if o, n := validationEnum.FindStringSubmatch(old), validationEnum.FindStringSubmatch(new); o != nil && n != nil {
+		oldValues, newValues := quotedString.FindAllString(o[1], -1), quotedString.FindAllString(n[1], -1)
+		if len(difference(oldValues, newValues)) > 0 {
+			return ValidationTightened
+		}
+		if len(difference(newValues, oldValues)) > 0 {
+			return ValidationLoosened
+		}
+	}
+	if o, n := validationRange.FindStringSubmatch(old), validationRange.FindStringSubmatch(new); o != nil && n != nil {
+		return boundsChangeKind([]string{o[1], n[1]}, []string{o[2], n[2]})
+	}
+	if o, n := validationAtLeast.FindStringSubmatch(old), validationAtLeast.FindStringSubmatch(new); o != nil && n != nil {
+		return boundsChangeKind([]string{o[1], n[1]}, nil)
+	}
+	if o, n := validationAtMost.FindStringSubmatch(old), validationAtMost.FindStringSubmatch(new); o != nil && n != nil {
+		return boundsChangeKind(nil, []string{o[1], n[1]})
+	}
+	return ValidationChanged
+}
+
+// boundsChangeKind returns whether the range of valid values was tightened or
+// loosened, given the old and new minimums and maximums, either of which can
+// be nil if the range has no such bound.
+func boundsChangeKind(min, max []string) string {
+	tightened, loosened := false, false
+	compare := func(bounds []string, sign float64) bool {
+		if bounds == nil {
+			return true
+		}
+		o, err := strconv.ParseFloat(bounds[0], 64)
+		if err != nil {
+			return false
+		}
+		n, err := strconv.ParseFloat(bounds[1], 64)
+		if err != nil {
+			return false
+		}
+		// A higher minimum or a lower maximum tightens the range.
+		switch {
+		case sign*(n-o) > 0:
+			tightened = true
+		case sign*(n-o) < 0:
+			loosened = true
+		}
+		return true
+	}
+	if !compare(min, 1) || !compare(max, -1) {
+		return ValidationChanged
+	}
+	switch {
+	case tightened:
+		return ValidationTightened
+	case loosened:
+		return ValidationLoosened
+	}
+	return ValidationChanged
+}
+
+func (c *comparison) compareImportFormats(old, new *Snapshot) {
+	c.category = "resource"
+	if old.ImportFormats == nil || new.ImportFormats == nil {
+		return
+	}
+	for _, name := range sortedKeys(old.ImportFormats) {
+		if _, ok := new.Resources[name]; !ok {
+			// The removal of the resource is already reported.
+			continue
+		}
+		c.name = name
+		for _, f := range difference(old.ImportFormats[name], new.ImportFormats[name]) {
+			c.add(ImportFormatRemoved, "", f, "", "import id format %s was removed", f)
+		}
+		for _, f := range difference(new.ImportFormats[name], old.ImportFormats[name]) {
+			c.add(ImportFormatAdded, "", "", f, "import id format %s was added", f)
+		}
+	}
+}
+
+// difference returns the elements of a that aren't in b.
+func difference(a, b []string) []string {
+	in := make(map[string]bool, len(b))
+	for _, s := range b {
+		in[s] = true
+	}
+	var diff []string
+	for _, s := range a {
+		if !in[s] {
+			diff = append(diff, s)
+		}
+	}
+	return diff
+}
+
+func joinPath(parent, k string) string {
+	if parent == "" {
+		return k
+	}
+	return parent + "." + k
+}
+
+func elemType(a *Attribute) string {
+	if a.Elem == nil {
+		return "object"
+	}
+	return a.Elem.Type
+}
+
+func itemsLimit(n int) string {
+	if n == 0 {
+		return "unlimited"
+	}
+	return fmt.Sprint(n)
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+	return s
+}
@@ -0,0 +1,375 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package schemacompat
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-google/google/fwprovider"
+	"github.com/hashicorp/terraform-provider-google/google/provider"
+	"github.com/hashicorp/terraform-provider-google/google/verify"
+)
+
+func testSnapshot(resource map[string]*schema.Schema) *Snapshot {
+	s := NewSnapshot()
+	s.AddSdkProvider(&schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"google_thing": {Schema: resource},
+		},
+	})
+	return s
+}
+
+func TestCompare(t *testing.T) {
+	base := func() map[string]*schema.Schema {
+		return map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^[a-z]*$"), ""),
+			},
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"disk": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"SSD", "HDD"}, false),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		update   func(s map[string]*schema.Schema)
+		kind     string
+		path     string
+		breaking bool
+	}{
+		"field removed": {
+			update:   func(s map[string]*schema.Schema) { delete(s, "description") },
+			kind:     FieldRemoved,
+			path:     "description",
+			breaking: true,
+		},
+		"optional field added": {
+			update:   func(s map[string]*schema.Schema) { s["zone"] = &schema.Schema{Type: schema.TypeString, Optional: true} },
+			kind:     FieldAdded,
+			path:     "zone",
+			breaking: false,
+		},
+		"required field added": {
+			update:   func(s map[string]*schema.Schema) { s["zone"] = &schema.Schema{Type: schema.TypeString, Required: true} },
+			kind:     RequiredFieldAdded,
+			path:     "zone",
+			breaking: true,
+		},
+		"optional to required": {
+			update: func(s map[string]*schema.Schema) {
+				s["description"].Optional, s["description"].Computed, s["description"].Required = false, true, true
+			},
+			kind:     OptionalToRequired,
+			path:     "description",
+			breaking: true,
+		},
+		"computed removed": {
+			update:   func(s map[string]*schema.Schema) { s["description"].Computed = false },
+			kind:     ComputedRemoved,
+			path:     "description",
+			breaking: true,
+		},
+		"force new added": {
+			update:   func(s map[string]*schema.Schema) { s["size"].ForceNew = true },
+			kind:     ForceNewAdded,
+			path:     "size",
+			breaking: true,
+		},
+		"force new removed": {
+			update:   func(s map[string]*schema.Schema) { s["name"].ForceNew = false },
+			kind:     ForceNewRemoved,
+			path:     "name",
+			breaking: false,
+		},
+		"type changed": {
+			update:   func(s map[string]*schema.Schema) { s["size"].Type = schema.TypeString },
+			kind:     TypeChanged,
+			path:     "size",
+			breaking: true,
+		},
+		"element type changed": {
+			update:   func(s map[string]*schema.Schema) { s["tags"].Elem = &schema.Schema{Type: schema.TypeInt} },
+			kind:     TypeChanged,
+			path:     "tags.*",
+			breaking: true,
+		},
+		"default changed": {
+			update:   func(s map[string]*schema.Schema) { s["size"].Default = 20 },
+			kind:     DefaultChanged,
+			path:     "size",
+			breaking: true,
+		},
+		"validation added": {
+			update: func(s map[string]*schema.Schema) {
+				s["name"].ValidateFunc = validation.StringLenBetween(1, 63)
+			},
+			kind:     ValidationTightened,
+			path:     "name",
+			breaking: true,
+		},
+		"validation removed": {
+			update:   func(s map[string]*schema.Schema) { s["size"].ValidateFunc = nil },
+			kind:     ValidationLoosened,
+			path:     "size",
+			breaking: false,
+		},
+		"enum value removed": {
+			update: func(s map[string]*schema.Schema) {
+				s["disk"].Elem.(*schema.Resource).Schema["type"].ValidateFunc = validation.StringInSlice([]string{"SSD"}, false)
+			},
+			kind:     ValidationTightened,
+			path:     "disk.type",
+			breaking: true,
+		},
+		"enum value added": {
+			update: func(s map[string]*schema.Schema) {
+				s["disk"].Elem.(*schema.Resource).Schema["type"].ValidateFunc = validation.StringInSlice([]string{"SSD", "HDD", "BALANCED"}, false)
+			},
+			kind:     ValidationLoosened,
+			path:     "disk.type",
+			breaking: false,
+		},
+		"minimum raised": {
+			update:   func(s map[string]*schema.Schema) { s["size"].ValidateFunc = validation.IntAtLeast(2) },
+			kind:     ValidationTightened,
+			path:     "size",
+			breaking: true,
+		},
+		"validation function replaced": {
+			update:   func(s map[string]*schema.Schema) { s["size"].ValidateFunc = validation.IntBetween(0, 100) },
+			kind:     ValidationTightened,
+			path:     "size",
+			breaking: true,
+		},
+		"regexp changed": {
+			update: func(s map[string]*schema.Schema) {
+				s["description"].ValidateFunc = validation.StringMatch(regexp.MustCompile("^[a-z ]*$"), "")
+			},
+			kind:     ValidationChanged,
+			path:     "description",
+			breaking: false,
+		},
+		"max items lowered": {
+			update:   func(s map[string]*schema.Schema) { s["disk"].MaxItems = 1 },
+			kind:     ValidationTightened,
+			path:     "disk",
+			breaking: true,
+		},
+		"max items removed": {
+			update:   func(s map[string]*schema.Schema) { s["disk"].MaxItems = 0 },
+			kind:     ValidationLoosened,
+			path:     "disk",
+			breaking: false,
+		},
+		"sensitive added": {
+			update:   func(s map[string]*schema.Schema) { s["description"].Sensitive = true },
+			kind:     SensitiveAdded,
+			path:     "description",
+			breaking: true,
+		},
+		"nested field removed": {
+			update: func(s map[string]*schema.Schema) {
+				delete(s["disk"].Elem.(*schema.Resource).Schema, "type")
+			},
+			kind:     FieldRemoved,
+			path:     "disk.type",
+			breaking: true,
+		},
+		"nested field required": {
+			update: func(s map[string]*schema.Schema) {
+				s["disk"].Elem.(*schema.Resource).Schema["type"].Optional = false
+				s["disk"].Elem.(*schema.Resource).Schema["type"].Required = true
+			},
+			kind:     OptionalToRequired,
+			path:     "disk.type",
+			breaking: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			new := base()
+			tc.update(new)
+			changes := Compare(testSnapshot(base()), testSnapshot(new))
+			if len(changes) != 1 {
+				t.Fatalf("Expected 1 change, got %+v", changes)
+			}
+			c := changes[0]
+			if c.Kind != tc.kind || c.Path != tc.path || c.Breaking != tc.breaking || c.Name != "google_thing" || c.Category != "resource" {
+				t.Errorf("Expected %s change of google_thing.%s with breaking %t, got %+v", tc.kind, tc.path, tc.breaking, c)
+			}
+			if HasBreakingChanges(changes) != tc.breaking {
+				t.Errorf("Expected HasBreakingChanges to be %t", tc.breaking)
+			}
+		})
+	}
+}
+
+func TestCompare_closureRenumbered(t *testing.T) {
+	// Closures of the same function with the same arguments are the same
+	// validation, whatever their number.
+	old := testSnapshot(map[string]*schema.Schema{"name": {Type: schema.TypeString, Optional: true}})
+	old.Resources["google_thing"]["name"].Validation = []string{"verify.ValidateRegexp: \"field\" (<value>) doesn't match regexp \"^a$\""}
+	new := testSnapshot(map[string]*schema.Schema{
+		"name": {Type: schema.TypeString, Optional: true, ValidateFunc: verify.ValidateRegexp("^a$")},
+	})
+	if changes := Compare(old, new); len(changes) != 0 {
+		t.Errorf("Expected no changes, got %+v", changes)
+	}
+}
+
+func TestCompare_resources(t *testing.T) {
+	old := testSnapshot(map[string]*schema.Schema{})
+	new := NewSnapshot()
+	new.AddSdkProvider(&schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"google_other_thing": {Schema: map[string]*schema.Schema{}},
+		},
+	})
+
+	changes := Compare(old, new)
+	if len(changes) != 2 {
+		t.Fatalf("Expected 2 changes, got %+v", changes)
+	}
+	if changes[0].Kind != ResourceAdded || changes[0].Name != "google_other_thing" {
+		t.Errorf("Expected google_other_thing to be added, got %+v", changes[0])
+	}
+	if changes[1].Kind != ResourceRemoved || changes[1].Name != "google_thing" || !changes[1].Breaking {
+		t.Errorf("Expected google_thing to be removed, got %+v", changes[1])
+	}
+}
+
+func TestCompare_importFormats(t *testing.T) {
+	old := testSnapshot(map[string]*schema.Schema{})
+	new := testSnapshot(map[string]*schema.Schema{})
+	old.ImportFormats = map[string][]string{
+		"google_thing": {"projects/{{project}}/things/{{name}}", "{{name}}"},
+	}
+
+	if changes := Compare(old, new); len(changes) != 0 {
+		t.Errorf("Expected no changes when the new import formats are unknown, got %+v", changes)
+	}
+
+	new.ImportFormats = map[string][]string{
+		"google_thing": {"projects/{{project}}/things/{{name}}", "{{project}}/{{name}}"},
+	}
+	changes := Compare(old, new)
+	if len(changes) != 2 {
+		t.Fatalf("Expected 2 changes, got %+v", changes)
+	}
+	if changes[0].Kind != ImportFormatRemoved || changes[0].Old != "{{name}}" || !changes[0].Breaking {
+		t.Errorf("Expected {{name}} to be removed, got %+v", changes[0])
+	}
+	if changes[1].Kind != ImportFormatAdded || changes[1].New != "{{project}}/{{name}}" || changes[1].Breaking {
+		t.Errorf("Expected {{project}}/{{name}} to be added, got %+v", changes[1])
+	}
+}
+
+func TestSnapshot_provider(t *testing.T) {
+	ctx := context.Background()
+	snapshot := func() *Snapshot {
+		s := NewSnapshot()
+		primary := provider.Provider()
+		s.AddSdkProvider(primary)
+		if err := s.AddFrameworkProvider(ctx, fwprovider.New(primary)); err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+
+	s := snapshot()
+	name := s.Resources["google_compute_instance"]["name"]
+	if name == nil || name.Type != "string" || !name.Required || !name.ForceNew {
+		t.Errorf("Expected google_compute_instance.name to be a required force new string, got %+v", name)
+	}
+	image := s.Resources["google_compute_instance"]["boot_disk"].Attributes["initialize_params"].Attributes["image"]
+	if image == nil || image.Type != "string" {
+		t.Errorf("Expected google_compute_instance.boot_disk.initialize_params.image to be a string, got %+v", image)
+	}
+	if _, ok := s.DataSources["google_client_config"]; !ok {
+		t.Errorf("Expected the framework data source google_client_config")
+	}
+	token := s.EphemeralResources["google_service_account_access_token"]["access_token"]
+	if token == nil || !token.Computed || !token.Sensitive {
+		t.Errorf("Expected google_service_account_access_token.access_token to be computed and sensitive, got %+v", token)
+	}
+
+	if changes := Compare(s, snapshot()); len(changes) != 0 {
+		t.Errorf("Expected no changes between snapshots of the same provider, got %+v", changes)
+	}
+}
+
+func TestReport(t *testing.T) {
+	changes := []Change{
+		{Kind: FieldRemoved, Breaking: true, Category: "resource", Name: "google_thing", Path: "description", Message: "field description was removed"},
+		{Kind: FieldAdded, Category: "resource", Name: "google_thing", Path: "zone", Message: "field zone was added"},
+		{Kind: ValidationChanged, Category: "resource", Name: "google_thing", Path: "name", Message: "validation of name changed"},
+	}
+	r := NewReport(changes)
+	if !r.Breaking || r.BreakingCount != 1 || r.NonBreakingCount != 2 || r.UnknownCount != 1 {
+		t.Errorf("Expected 1 breaking and 2 non-breaking changes, 1 of them unknown, got %+v", r)
+	}
+
+	var b bytes.Buffer
+	if err := r.WriteMarkdown(&b); err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{
+		"## Breaking changes",
+		"| resource | `google_thing` | `description` | field_removed | field description was removed |",
+		"## Non-breaking changes",
+		"| resource | `google_thing` | `zone` | field_added | field zone was added |",
+		"## Changes to review",
+		"| resource | `google_thing` | `name` | validation_changed | validation of name changed |",
+	} {
+		if !strings.Contains(b.String(), s) {
+			t.Errorf("Expected markdown report to contain %q, got:\n%s", s, b.String())
+		}
+	}
+
+	b.Reset()
+	if err := NewReport(nil).WriteJSON(&b); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(b.String(), `"breaking": false`) || !strings.Contains(b.String(), `"changes": []`) {
+		t.Errorf("Expected an empty JSON report, got:\n%s", b.String())
+	}
+}
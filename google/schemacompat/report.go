@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package schemacompat
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Report is the result of a comparison, as written by WriteJSON.
+type Report struct {
+	Breaking         bool `json:"breaking"`
+	BreakingCount    int  `json:"breaking_count"`
+	NonBreakingCount int  `json:"non_breaking_count"`
+	// UnknownCount is the number of non-breaking changes that can't be told
+	// to be breaking or not, such as ValidationChanged, and need a review.
+	UnknownCount int      `json:"unknown_count"`
+	Changes      []Change `json:"changes"`
+}
+
+// unknownKinds are the kinds of changes that can't be told to be breaking or
+// not.
+var unknownKinds = map[string]bool{
+	ValidationChanged: true,
+}
+
+// NewReport returns the report of the changes.
+func NewReport(changes []Change) Report {
+	r := Report{Changes: changes}
+	if r.Changes == nil {
+		r.Changes = []Change{}
+	}
+	for _, c := range changes {
+		if c.Breaking {
+			r.BreakingCount++
+		} else {
+			r.NonBreakingCount++
+		}
+		if unknownKinds[c.Kind] {
+			r.UnknownCount++
+		}
+	}
+	r.Breaking = r.BreakingCount > 0
+	return r
+}
+
+// WriteJSON writes the report as JSON.
+func (r Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// WriteMarkdown writes the report as markdown, with a table of the breaking
+// changes, a table of the changes that need a review and a table of the other
+// changes.
+func (r Report) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Schema compatibility report\n\n")
+	if r.Breaking {
+		fmt.Fprintf(&b, "Found **%d breaking** and %d non-breaking changes.\n", r.BreakingCount, r.NonBreakingCount)
+	} else {
+		fmt.Fprintf(&b, "Found no breaking changes, and %d non-breaking changes.\n", r.NonBreakingCount)
+	}
+	if r.UnknownCount > 0 {
+		fmt.Fprintf(&b, "%d of the non-breaking changes can't be told to be breaking or not, and need a review.\n", r.UnknownCount)
+	}
+	sections := []struct {
+		title   string
+		matches func(c Change) bool
+	}{
+		{"Breaking changes", func(c Change) bool { return c.Breaking }},
+		{"Changes to review", func(c Change) bool { return !c.Breaking && unknownKinds[c.Kind] }},
+		{"Non-breaking changes", func(c Change) bool { return !c.Breaking && !unknownKinds[c.Kind] }},
+	}
+	for _, section := range sections {
+		var rows []Change
+		for _, c := range r.Changes {
+			if section.matches(c) {
+				rows = append(rows, c)
+			}
+		}
+		if len(rows) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "\n## %s\n\n", section.title)
+		fmt.Fprintf(&b, "| Type | Name | Field | Kind | Change |\n")
+		fmt.Fprintf(&b, "| --- | --- | --- | --- | --- |\n")
+		for _, c := range rows {
+			fmt.Fprintf(&b, "| %s | `%s` | %s | %s | %s |\n", c.Category, c.Name, markdownCode(c.Path), c.Kind, markdownEscape(c.Message))
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func markdownCode(s string) string {
+	if s == "" {
+		return ""
+	}
+	return "`" + s + "`"
+}
+
+// markdownEscape escapes the characters of s that would break a table cell.
+func markdownEscape(s string) string {
+	return strings.NewReplacer("|", `\|`, "\n", " ").Replace(s)
+}
@@ -0,0 +1,459 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package schemacompat detects the changes to the schemas of the provider's
+// resources and data sources that break existing configurations, between two
+// versions of the provider. It is used by scripts/diff.go to gate provider
+// upgrades.
+//
+// The schemas of both versions are first reduced to a Snapshot, which only
+// keeps what matters to compatibility and is the same for SDK and
+// plugin-framework schemas, then compared with Compare.
+package schemacompat
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Snapshot is the schema of every resource, data source and ephemeral
+// resource of a version of the provider, keyed by type name, and the import
+// id formats of its resources.
+type Snapshot struct {
+	Resources          map[string]map[string]*Attribute `json:"resources"`
+	DataSources        map[string]map[string]*Attribute `json:"data_sources"`
+	EphemeralResources map[string]map[string]*Attribute `json:"ephemeral_resources"`
+	// ImportFormats are the import id formats of the resources, if known.
+	ImportFormats map[string][]string `json:"import_formats,omitempty"`
+}
+
+// Attribute is an attribute or a nested block of a schema. Functions, such as
+// validation functions, are represented by their names, and framework
+// validators and plan modifiers by their descriptions. Validation functions
+// returned by other functions are also described by their errors, see
+// validatorDescription.
+type Attribute struct {
+	// Type is string, int, float, number, bool, list, set, map or object.
+	Type       string   `json:"type"`
+	Required   bool     `json:"required,omitempty"`
+	Optional   bool     `json:"optional,omitempty"`
+	Computed   bool     `json:"computed,omitempty"`
+	ForceNew   bool     `json:"force_new,omitempty"`
+	Sensitive  bool     `json:"sensitive,omitempty"`
+	Default    string   `json:"default,omitempty"`
+	Validation []string `json:"validation,omitempty"`
+	MinItems   int      `json:"min_items,omitempty"`
+	MaxItems   int      `json:"max_items,omitempty"`
+	// Elem is the element of a list, set or map of primitives.
+	Elem *Attribute `json:"elem,omitempty"`
+	// Attributes are the attributes of an object, or of the elements of a
+	// list, set or map of objects such as a nested block.
+	Attributes map[string]*Attribute `json:"attributes,omitempty"`
+}
+
+// NewSnapshot returns an empty snapshot.
+func NewSnapshot() *Snapshot {
+	return &Snapshot{
+		Resources:          map[string]map[string]*Attribute{},
+		DataSources:        map[string]map[string]*Attribute{},
+		EphemeralResources: map[string]map[string]*Attribute{},
+	}
+}
+
+// AddSdkProvider adds the resources and data sources of an SDK provider.
+func (s *Snapshot) AddSdkProvider(p *schema.Provider) {
+	for name, r := range p.ResourcesMap {
+		s.Resources[name] = sdkAttributes(r.SchemaMap())
+	}
+	for name, r := range p.DataSourcesMap {
+		s.DataSources[name] = sdkAttributes(r.SchemaMap())
+	}
+}
+
+// AddFrameworkProvider adds the resources, data sources and ephemeral
+// resources of a plugin-framework provider.
+func (s *Snapshot) AddFrameworkProvider(ctx context.Context, p provider.Provider) error {
+	var meta provider.MetadataResponse
+	p.Metadata(ctx, provider.MetadataRequest{}, &meta)
+
+	for _, f := range p.Resources(ctx) {
+		r := f()
+		var m resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: meta.TypeName}, &m)
+		var resp resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &resp)
+		if resp.Diagnostics.HasError() {
+			return fmt.Errorf("error getting the schema of %s: %v", m.TypeName, resp.Diagnostics)
+		}
+		s.Resources[m.TypeName] = frameworkAttributes(ctx, resp.Schema)
+	}
+	for _, f := range p.DataSources(ctx) {
+		d := f()
+		var m datasource.MetadataResponse
+		d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: meta.TypeName}, &m)
+		var resp datasource.SchemaResponse
+		d.Schema(ctx, datasource.SchemaRequest{}, &resp)
+		if resp.Diagnostics.HasError() {
+			return fmt.Errorf("error getting the schema of %s: %v", m.TypeName, resp.Diagnostics)
+		}
+		s.DataSources[m.TypeName] = frameworkAttributes(ctx, resp.Schema)
+	}
+	if p, ok := p.(provider.ProviderWithEphemeralResources); ok {
+		for _, f := range p.EphemeralResources(ctx) {
+			e := f()
+			var m ephemeral.MetadataResponse
+			e.Metadata(ctx, ephemeral.MetadataRequest{ProviderTypeName: meta.TypeName}, &m)
+			var resp ephemeral.SchemaResponse
+			e.Schema(ctx, ephemeral.SchemaRequest{}, &resp)
+			if resp.Diagnostics.HasError() {
+				return fmt.Errorf("error getting the schema of %s: %v", m.TypeName, resp.Diagnostics)
+			}
+			s.EphemeralResources[m.TypeName] = frameworkAttributes(ctx, resp.Schema)
+		}
+	}
+	return nil
+}
+
+func sdkAttributes(m map[string]*schema.Schema) map[string]*Attribute {
+	attributes := make(map[string]*Attribute, len(m))
+	for k, v := range m {
+		attributes[k] = sdkAttribute(v)
+	}
+	return attributes
+}
+
+func sdkAttribute(s *schema.Schema) *Attribute {
+	a := &Attribute{
+		Required:  s.Required,
+		Optional:  s.Optional,
+		Computed:  s.Computed,
+		ForceNew:  s.ForceNew,
+		Sensitive: s.Sensitive,
+		MinItems:  s.MinItems,
+		MaxItems:  s.MaxItems,
+	}
+	switch s.Type {
+	case schema.TypeBool:
+		a.Type = "bool"
+	case schema.TypeInt:
+		a.Type = "int"
+	case schema.TypeFloat:
+		a.Type = "float"
+	case schema.TypeString:
+		a.Type = "string"
+	case schema.TypeList:
+		a.Type = "list"
+	case schema.TypeSet:
+		a.Type = "set"
+	case schema.TypeMap:
+		a.Type = "map"
+	default:
+		a.Type = s.Type.String()
+	}
+	if s.Default != nil {
+		a.Default = fmt.Sprintf("%#v", s.Default)
+	} else if s.DefaultFunc != nil {
+		a.Default = functionName(s.DefaultFunc) + "()"
+	}
+	if s.ValidateFunc != nil {
+		a.Validation = append(a.Validation, validatorDescription(s.ValidateFunc, s.Type, func(v interface{}) []string {
+			_, errs := s.ValidateFunc(v, probeKey)
+			var messages []string
+			for _, err := range errs {
+				messages = append(messages, err.Error())
+			}
+			return messages
+		}))
+	}
+	if s.ValidateDiagFunc != nil {
+		a.Validation = append(a.Validation, validatorDescription(s.ValidateDiagFunc, s.Type, func(v interface{}) []string {
+			var messages []string
+			for _, d := range s.ValidateDiagFunc(v, cty.GetAttrPath(probeKey)) {
+				if d.Severity == diag.Error {
+					messages = append(messages, strings.TrimSpace(d.Summary+" "+d.Detail))
+				}
+			}
+			return messages
+		}))
+	}
+	switch elem := s.Elem.(type) {
+	case *schema.Resource:
+		a.Attributes = sdkAttributes(elem.SchemaMap())
+	case *schema.Schema:
+		a.Elem = sdkAttribute(elem)
+	}
+	return a
+}
+
+// functionName returns the name of a function, with its package, e.g.
+// verify.ValidateGCEName. Anonymous functions are named after the function
+// declaring them, e.g. validation.StringInSlice.func1, so the functions
+// returned by the same function with different arguments have the same name.
+func functionName(f interface{}) string {
+	fn := runtime.FuncForPC(reflect.ValueOf(f).Pointer())
+	if fn == nil {
+		return ""
+	}
+	name := fn.Name()
+	return name[strings.LastIndex(name, "/")+1:]
+}
+
+// probeKey is the name of the attribute validation functions are called with
+// by validatorDescription.
+const probeKey = "field"
+
+// probeValues are the values validatorDescription calls the validation
+// functions of each type of attribute with. They are invalid for most
+// validation functions, whose errors then include their arguments, such as
+// the values accepted by validation.StringInSlice or the range of
+// validation.IntBetween.
+var probeValues = map[schema.ValueType][]interface{}{
+	schema.TypeString: {strings.Repeat("~", 4097)},
+	schema.TypeInt:    {math.MinInt, math.MaxInt},
+	schema.TypeFloat:  {-math.MaxFloat64, math.MaxFloat64},
+}
+
+// closureSuffix matches the suffix of the names of anonymous functions, which
+// are numbered by their position in the function declaring them, e.g. .func1,
+// or .1 once inlined.
+var closureSuffix = regexp.MustCompile(`(\.func\d+|\.\d+)+$`)
+
+// validatorDescription returns the name of a validation function. The
+// functions returned by the same function, e.g. validation.StringInSlice, have
+// the same name whatever their arguments, so they're named after the function
+// declaring them, without their number, and described by the errors validate
+// returns for the probeValues of the attribute's type, with the probe value
+// replaced by <value>, e.g.
+// validation.StringInSlice: expected field to be one of ["A" "B"], got <value>.
+func validatorDescription(f interface{}, t schema.ValueType, validate func(v interface{}) []string) string {
+	name := functionName(f)
+	if !closureSuffix.MatchString(name) {
+		return name
+	}
+	name = closureSuffix.ReplaceAllString(name, "")
+
+	var messages []string
+	seen := map[string]bool{}
+	for _, v := range probeValues[t] {
+		for _, m := range probeErrors(validate, v) {
+			m = strings.ReplaceAll(m, strconv.Quote(fmt.Sprint(v)), "<value>")
+			m = strings.ReplaceAll(m, fmt.Sprint(v), "<value>")
+			if !seen[m] {
+				seen[m] = true
+				messages = append(messages, m)
+			}
+		}
+	}
+	if len(messages) == 0 {
+		return name
+	}
+	return name + ": " + strings.Join(messages, "; ")
+}
+
+// probeErrors returns the errors of validate for v. Validation functions that
+// don't expect values of the attribute's type can panic, in which case there
+// are no errors.
+func probeErrors(validate func(v interface{}) []string, v interface{}) (messages []string) {
+	defer func() {
+		if r := recover(); r != nil {
+			messages = nil
+		}
+	}()
+	return validate(v)
+}
+
+// fwAttribute is implemented by the attributes of every plugin-framework
+// schema: resources, data sources and ephemeral resources.
+type fwAttribute interface {
+	GetType() attr.Type
+	IsRequired() bool
+	IsOptional() bool
+	IsComputed() bool
+	IsSensitive() bool
+}
+
+// describer is implemented by framework validators, plan modifiers and
+// defaults.
+type describer interface {
+	Description(context.Context) string
+}
+
+// frameworkAttributes returns the attributes and blocks of a framework schema
+// or nested object, read by reflection as each kind of schema has its own
+// types.
+func frameworkAttributes(ctx context.Context, s interface{}) map[string]*Attribute {
+	v := reflect.ValueOf(s)
+	attributes := map[string]*Attribute{}
+	if f := v.FieldByName("Attributes"); f.IsValid() {
+		iter := f.MapRange()
+		for iter.Next() {
+			attributes[iter.Key().String()] = frameworkAttribute(ctx, iter.Value().Interface())
+		}
+	}
+	if f := v.FieldByName("Blocks"); f.IsValid() {
+		iter := f.MapRange()
+		for iter.Next() {
+			attributes[iter.Key().String()] = frameworkBlock(ctx, iter.Value().Interface())
+		}
+	}
+	return attributes
+}
+
+func frameworkAttribute(ctx context.Context, i interface{}) *Attribute {
+	a := &Attribute{}
+	if fa, ok := i.(fwAttribute); ok {
+		a = frameworkType(ctx, fa.GetType())
+		a.Required = fa.IsRequired()
+		a.Optional = fa.IsOptional()
+		a.Computed = fa.IsComputed()
+		a.Sensitive = fa.IsSensitive()
+	}
+	v := reflect.ValueOf(i)
+	frameworkDetails(ctx, v, a)
+	if nested := v.FieldByName("NestedObject"); nested.IsValid() {
+		a.Elem = nil
+		a.Attributes = frameworkAttributes(ctx, nested.Interface())
+	} else if v.FieldByName("Attributes").IsValid() {
+		// Single nested attribute.
+		a.Type = "object"
+		a.Attributes = frameworkAttributes(ctx, i)
+	}
+	return a
+}
+
+func frameworkBlock(ctx context.Context, i interface{}) *Attribute {
+	v := reflect.ValueOf(i)
+	a := &Attribute{Optional: true}
+	switch name := v.Type().Name(); {
+	case strings.HasPrefix(name, "List"):
+		a.Type = "list"
+	case strings.HasPrefix(name, "Set"):
+		a.Type = "set"
+	default:
+		a.Type = "object"
+	}
+	frameworkDetails(ctx, v, a)
+	if nested := v.FieldByName("NestedObject"); nested.IsValid() {
+		a.Attributes = frameworkAttributes(ctx, nested.Interface())
+	} else {
+		a.Attributes = frameworkAttributes(ctx, i)
+	}
+	return a
+}
+
+// frameworkDetails sets the validation, default and replacement of an
+// attribute or block from its validators, default and plan modifiers.
+func frameworkDetails(ctx context.Context, v reflect.Value, a *Attribute) {
+	if f := v.FieldByName("Validators"); f.IsValid() {
+		for j := 0; j < f.Len(); j++ {
+			if d, ok := f.Index(j).Interface().(describer); ok {
+				a.Validation = append(a.Validation, d.Description(ctx))
+			}
+		}
+	}
+	if f := v.FieldByName("PlanModifiers"); f.IsValid() {
+		for j := 0; j < f.Len(); j++ {
+			m := f.Index(j).Interface()
+			d, _ := m.(describer)
+			if strings.Contains(reflect.TypeOf(m).String(), "equiresReplace") || (d != nil && strings.Contains(d.Description(ctx), "destroy and recreate")) {
+				a.ForceNew = true
+			}
+		}
+	}
+	if f := v.FieldByName("Default"); f.IsValid() && !f.IsNil() {
+		a.Default = frameworkDefault(ctx, f.Interface())
+	}
+}
+
+func frameworkDefault(ctx context.Context, d interface{}) string {
+	switch d := d.(type) {
+	case defaults.String:
+		var resp defaults.StringResponse
+		d.DefaultString(ctx, defaults.StringRequest{}, &resp)
+		return resp.PlanValue.String()
+	case defaults.Bool:
+		var resp defaults.BoolResponse
+		d.DefaultBool(ctx, defaults.BoolRequest{}, &resp)
+		return resp.PlanValue.String()
+	case defaults.Int64:
+		var resp defaults.Int64Response
+		d.DefaultInt64(ctx, defaults.Int64Request{}, &resp)
+		return resp.PlanValue.String()
+	case defaults.Float64:
+		var resp defaults.Float64Response
+		d.DefaultFloat64(ctx, defaults.Float64Request{}, &resp)
+		return resp.PlanValue.String()
+	case describer:
+		return d.Description(ctx)
+	}
+	return fmt.Sprintf("%T", d)
+}
+
+// frameworkType returns an attribute of the given type, with the element of a
+// list, set or map of primitives, or the attributes of an object.
+func frameworkType(ctx context.Context, t attr.Type) *Attribute {
+	switch t := t.(type) {
+	case basetypes.StringType:
+		return &Attribute{Type: "string"}
+	case basetypes.BoolType:
+		return &Attribute{Type: "bool"}
+	case basetypes.Int64Type, basetypes.Int32Type:
+		return &Attribute{Type: "int"}
+	case basetypes.Float64Type, basetypes.Float32Type:
+		return &Attribute{Type: "float"}
+	case basetypes.NumberType:
+		return &Attribute{Type: "number"}
+	case basetypes.ListType:
+		return &Attribute{Type: "list", Elem: frameworkType(ctx, t.ElemType)}
+	case basetypes.SetType:
+		return &Attribute{Type: "set", Elem: frameworkType(ctx, t.ElemType)}
+	case basetypes.MapType:
+		return &Attribute{Type: "map", Elem: frameworkType(ctx, t.ElemType)}
+	case basetypes.ObjectType:
+		a := &Attribute{Type: "object", Attributes: map[string]*Attribute{}}
+		for k, v := range t.AttrTypes {
+			a.Attributes[k] = frameworkType(ctx, v)
+		}
+		return a
+	}
+	// Custom types, e.g. timetypes.RFC3339Type, by their Terraform type.
+	tf := t.TerraformType(ctx)
+	switch {
+	case tf.Is(tftypes.String):
+		return &Attribute{Type: "string"}
+	case tf.Is(tftypes.Bool):
+		return &Attribute{Type: "bool"}
+	case tf.Is(tftypes.Number):
+		return &Attribute{Type: "number"}
+	}
+	return &Attribute{Type: tf.String()}
+}
+
+// sortedKeys returns the keys of a map in order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -69,7 +69,7 @@ func testSweepAccessContextManagerPolicies(region string) error {
 	log.Printf("[DEBUG] Deleting test Access Policies %q", policy["name"])
 
 	policyUrl := config.AccessContextManagerBasePath + policy["name"].(string)
-	if _, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+	if _, err := sweeper.SendDeleteRequest(transport_tpg.SendRequestOptions{
 		Config:    config,
 		Method:    "DELETE",
 		RawURL:    policyUrl,
@@ -89,7 +89,7 @@ func testSweepAlloydbCluster(region string) error {
 		name := obj["name"].(string)
 		shortname := tpgresource.GetResourceNameFromSelfLink(name)
 		// Skip resources that shouldn't be sweeped
-		if !sweeper.IsSweepableTestResource(shortname) {
+		if !sweeper.IsSweepableResource(shortname, obj) {
 			nonPrefixCount++
 			continue
 		}
@@ -98,7 +98,7 @@ func testSweepAlloydbCluster(region string) error {
 		deleteUrl := fmt.Sprintf(deleteTemplate, name)
 
 		// Don't wait on operations as we may have a lot to delete
-		_, err = transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		_, err = sweeper.SendDeleteRequest(transport_tpg.SendRequestOptions{
 			Config:    config,
 			Method:    "DELETE",
 			Project:   config.Project,
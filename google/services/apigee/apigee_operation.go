@@ -33,12 +33,20 @@ type ApigeeOperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *ApigeeOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
+func (w *ApigeeOperationWaiter) OperationURL() string {
+	return fmt.Sprintf("%s%s", w.Config.ApigeeBasePath, w.CommonOperationWaiter.Op.Name)
+}
+
 func (w *ApigeeOperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
 	}
 	// Returns the proper get.
-	url := fmt.Sprintf("%s%s", w.Config.ApigeeBasePath, w.CommonOperationWaiter.Op.Name)
+	url := w.OperationURL()
 
 	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
 		Config:    w.Config,
@@ -92,7 +92,7 @@ func testSweepApigeeKeystoresAliasesKeyCertFile(region string) error {
 			return nil
 		}
 		// Skip resources that shouldn't be sweeped
-		if !sweeper.IsSweepableTestResource(name) {
+		if !sweeper.IsSweepableResource(name, obj) {
 			nonPrefixCount++
 			continue
 		}
@@ -106,7 +106,7 @@ func testSweepApigeeKeystoresAliasesKeyCertFile(region string) error {
 		deleteUrl = deleteUrl + name
 
 		// Don't wait on operations as we may have a lot to delete
-		_, err = transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		_, err = sweeper.SendDeleteRequest(transport_tpg.SendRequestOptions{
 			Config:    config,
 			Method:    "DELETE",
 			Project:   config.Project,
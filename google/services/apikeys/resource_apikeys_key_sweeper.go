@@ -69,5 +69,5 @@ func testSweepApikeysKey(region string) error {
 }
 
 func isDeletableApikeysKey(r *apikeys.Key) bool {
-	return sweeper.IsSweepableTestResource(*r.Name)
+	return sweeper.IsSweepableDCLResource(*r.Name, sweeper.ResourceObject(r))
 }
@@ -66,43 +66,22 @@ func DataSourceGoogleBigQueryTablesRead(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error fetching project: %s", err)
 	}
 
-	params := make(map[string]string)
-	tables := make([]map[string]interface{}, 0)
-
-	for {
-
-		url, err := tpgresource.ReplaceVars(d, config, "{{BigQueryBasePath}}projects/{{project}}/datasets/{{dataset_id}}/tables")
-		if err != nil {
-			return err
-		}
-
-		url, err = transport_tpg.AddQueryParams(url, params)
-		if err != nil {
-			return err
-		}
-
-		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
-			Config:    config,
-			Method:    "GET",
-			RawURL:    url,
-			UserAgent: userAgent,
-		})
-		if err != nil {
-			return fmt.Errorf("Error retrieving tables: %s", err)
-		}
-
-		pageTables := flattenDataSourceGoogleBigQueryTablesList(res["tables"])
-		tables = append(tables, pageTables...)
+	url, err := tpgresource.ReplaceVars(d, config, "{{BigQueryBasePath}}projects/{{project}}/datasets/{{dataset_id}}/tables")
+	if err != nil {
+		return err
+	}
 
-		pToken, ok := res["nextPageToken"]
-		if ok && pToken != nil && pToken.(string) != "" {
-			params["pageToken"] = pToken.(string)
-		} else {
-			break
-		}
+	items, err := transport_tpg.ListAll(transport_tpg.ListRequestOptions{
+		Config:     config,
+		RawURL:     url,
+		UserAgent:  userAgent,
+		ItemsField: "tables",
+	})
+	if err != nil {
+		return fmt.Errorf("Error retrieving tables: %s", err)
 	}
 
-	if err := d.Set("tables", tables); err != nil {
+	if err := d.Set("tables", flattenDataSourceGoogleBigQueryTablesList(items)); err != nil {
 		return fmt.Errorf("Error retrieving tables: %s", err)
 	}
 
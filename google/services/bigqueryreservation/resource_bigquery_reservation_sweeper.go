@@ -67,7 +67,7 @@ func testSweepBigqueryReservation(region string) error {
 		reservationNameParts := strings.Split(reservationName, "/")
 		reservationShortName := reservationNameParts[len(reservationNameParts)-1]
 		// Increment count and skip if resource is not sweepable.
-		if !sweeper.IsSweepableTestResource(reservationShortName) {
+		if !sweeper.IsSweepableResource(reservationShortName, obj) {
 			nonPrefixCount++
 			continue
 		}
@@ -76,7 +76,7 @@ func testSweepBigqueryReservation(region string) error {
 
 		deleteUrl := servicesUrl + "/" + reservationShortName
 		// Don't wait on operations as we may have a lot to delete
-		_, err = transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		_, err = sweeper.SendDeleteRequest(transport_tpg.SendRequestOptions{
 			Config:    config,
 			Method:    "DELETE",
 			Project:   config.Project,
@@ -125,7 +125,7 @@ func deleteAllAssignments(config *transport_tpg.Config, reservationName string)
 		name := obj["name"].(string)
 
 		deleteUrl := config.BigqueryReservationBasePath + name
-		_, err = transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		_, err = sweeper.SendDeleteRequest(transport_tpg.SendRequestOptions{
 			Config:    config,
 			Method:    "DELETE",
 			Project:   config.Project,
@@ -34,12 +34,20 @@ type BlockchainNodeEngineOperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *BlockchainNodeEngineOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
+func (w *BlockchainNodeEngineOperationWaiter) OperationURL() string {
+	return fmt.Sprintf("%s%s", w.Config.BlockchainNodeEngineBasePath, w.CommonOperationWaiter.Op.Name)
+}
+
 func (w *BlockchainNodeEngineOperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
 	}
 	// Returns the proper get.
-	url := fmt.Sprintf("%s%s", w.Config.BlockchainNodeEngineBasePath, w.CommonOperationWaiter.Op.Name)
+	url := w.OperationURL()
 
 	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
 		Config:    w.Config,
@@ -18,6 +18,10 @@ type ChronicleOperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *ChronicleOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
 func (w *ChronicleOperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
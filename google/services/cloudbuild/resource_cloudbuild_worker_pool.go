@@ -83,6 +83,12 @@ func ResourceCloudbuildWorkerPool() *schema.Resource {
 				Description: "All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.",
 			},
 
+			"terraform_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The combination of annotations configured directly on the resource and default annotations configured on the provider.",
+			},
+
 			"network_config": {
 				Type:          schema.TypeList,
 				Optional:      true,
@@ -69,5 +69,5 @@ func testSweepCloudbuildWorkerPool(region string) error {
 }
 
 func isDeletableCloudbuildWorkerPool(r *cloudbuild.WorkerPool) bool {
-	return sweeper.IsSweepableTestResource(*r.Name)
+	return sweeper.IsSweepableDCLResource(*r.Name, sweeper.ResourceObject(r))
 }
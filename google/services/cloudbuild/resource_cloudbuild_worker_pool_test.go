@@ -40,7 +40,7 @@ func TestAccCloudbuildWorkerPool_withComputedAnnotations(t *testing.T) {
 			{
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations"},
+				ImportStateVerifyIgnore: []string{"annotations", "terraform_annotations"},
 				ResourceName:            "google_cloudbuild_worker_pool.pool",
 			},
 			{
@@ -49,7 +49,7 @@ func TestAccCloudbuildWorkerPool_withComputedAnnotations(t *testing.T) {
 			{
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations"},
+				ImportStateVerifyIgnore: []string{"annotations", "terraform_annotations"},
 				ResourceName:            "google_cloudbuild_worker_pool.pool",
 			},
 		},
@@ -91,7 +91,7 @@ func TestAccCloudbuildWorkerPool_basic(t *testing.T) {
 			{
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations"},
+				ImportStateVerifyIgnore: []string{"annotations", "terraform_annotations"},
 				ResourceName:            "google_cloudbuild_worker_pool.pool",
 			},
 			{
@@ -34,12 +34,20 @@ type Cloudbuildv2OperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *Cloudbuildv2OperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
+func (w *Cloudbuildv2OperationWaiter) OperationURL() string {
+	return fmt.Sprintf("%s%s", w.Config.Cloudbuildv2BasePath, w.CommonOperationWaiter.Op.Name)
+}
+
 func (w *Cloudbuildv2OperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
 	}
 	// Returns the proper get.
-	url := fmt.Sprintf("%s%s", w.Config.Cloudbuildv2BasePath, w.CommonOperationWaiter.Op.Name)
+	url := w.OperationURL()
 
 	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
 		Config:    w.Config,
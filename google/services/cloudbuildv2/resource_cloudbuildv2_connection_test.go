@@ -31,7 +31,7 @@ func TestAccCloudbuildv2Connection_GheCompleteConnection(t *testing.T) {
 				ResourceName:            "google_cloudbuildv2_connection.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "name"},
+				ImportStateVerifyIgnore: []string{"annotations", "name", "terraform_annotations"},
 			},
 		},
 	})
@@ -58,7 +58,7 @@ func TestAccCloudbuildv2Connection_GheConnection(t *testing.T) {
 				ResourceName:            "google_cloudbuildv2_connection.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations"},
+				ImportStateVerifyIgnore: []string{"annotations", "terraform_annotations"},
 			},
 			{
 				Config: testAccCloudbuildv2Connection_GheConnectionUpdate0(context),
@@ -67,7 +67,7 @@ func TestAccCloudbuildv2Connection_GheConnection(t *testing.T) {
 				ResourceName:            "google_cloudbuildv2_connection.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations"},
+				ImportStateVerifyIgnore: []string{"annotations", "terraform_annotations"},
 			},
 		},
 	})
@@ -94,7 +94,7 @@ func TestAccCloudbuildv2Connection_GhePrivConnection(t *testing.T) {
 				ResourceName:            "google_cloudbuildv2_connection.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations"},
+				ImportStateVerifyIgnore: []string{"annotations", "terraform_annotations"},
 			},
 		},
 	})
@@ -121,7 +121,7 @@ func TestAccCloudbuildv2Connection_GhePrivUpdateConnection(t *testing.T) {
 				ResourceName:            "google_cloudbuildv2_connection.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations"},
+				ImportStateVerifyIgnore: []string{"annotations", "terraform_annotations"},
 			},
 			{
 				Config: testAccCloudbuildv2Connection_GhePrivUpdateConnectionUpdate0(context),
@@ -130,7 +130,7 @@ func TestAccCloudbuildv2Connection_GhePrivUpdateConnection(t *testing.T) {
 				ResourceName:            "google_cloudbuildv2_connection.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations"},
+				ImportStateVerifyIgnore: []string{"annotations", "terraform_annotations"},
 			},
 		},
 	})
@@ -157,7 +157,7 @@ func TestAccCloudbuildv2Connection_GithubConnection(t *testing.T) {
 				ResourceName:            "google_cloudbuildv2_connection.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations"},
+				ImportStateVerifyIgnore: []string{"annotations", "terraform_annotations"},
 			},
 			{
 				Config: testAccCloudbuildv2Connection_GithubConnectionUpdate0(context),
@@ -166,7 +166,7 @@ func TestAccCloudbuildv2Connection_GithubConnection(t *testing.T) {
 				ResourceName:            "google_cloudbuildv2_connection.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations"},
+				ImportStateVerifyIgnore: []string{"annotations", "terraform_annotations"},
 			},
 		},
 	})
@@ -192,7 +192,7 @@ func TestAccCloudbuildv2Connection_GitlabConnection(t *testing.T) {
 				ResourceName:            "google_cloudbuildv2_connection.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations"},
+				ImportStateVerifyIgnore: []string{"annotations", "terraform_annotations"},
 			},
 		},
 	})
@@ -218,7 +218,7 @@ func TestAccCloudbuildv2Connection_GleConnection(t *testing.T) {
 				ResourceName:            "google_cloudbuildv2_connection.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations"},
+				ImportStateVerifyIgnore: []string{"annotations", "terraform_annotations"},
 			},
 			{
 				Config: testAccCloudbuildv2Connection_GleConnectionUpdate0(context),
@@ -227,7 +227,7 @@ func TestAccCloudbuildv2Connection_GleConnection(t *testing.T) {
 				ResourceName:            "google_cloudbuildv2_connection.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations"},
+				ImportStateVerifyIgnore: []string{"annotations", "terraform_annotations"},
 			},
 		},
 	})
@@ -253,7 +253,7 @@ func TestAccCloudbuildv2Connection_GleOldConnection(t *testing.T) {
 				ResourceName:            "google_cloudbuildv2_connection.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations"},
+				ImportStateVerifyIgnore: []string{"annotations", "terraform_annotations"},
 			},
 			{
 				Config: testAccCloudbuildv2Connection_GleOldConnectionUpdate0(context),
@@ -262,7 +262,7 @@ func TestAccCloudbuildv2Connection_GleOldConnection(t *testing.T) {
 				ResourceName:            "google_cloudbuildv2_connection.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations"},
+				ImportStateVerifyIgnore: []string{"annotations", "terraform_annotations"},
 			},
 		},
 	})
@@ -288,7 +288,7 @@ func TestAccCloudbuildv2Connection_GlePrivConnection(t *testing.T) {
 				ResourceName:            "google_cloudbuildv2_connection.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations"},
+				ImportStateVerifyIgnore: []string{"annotations", "terraform_annotations"},
 			},
 		},
 	})
@@ -314,7 +314,7 @@ func TestAccCloudbuildv2Connection_GlePrivUpdateConnection(t *testing.T) {
 				ResourceName:            "google_cloudbuildv2_connection.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations"},
+				ImportStateVerifyIgnore: []string{"annotations", "terraform_annotations"},
 			},
 			{
 				Config: testAccCloudbuildv2Connection_GlePrivConnection(context),
@@ -323,7 +323,7 @@ func TestAccCloudbuildv2Connection_GlePrivUpdateConnection(t *testing.T) {
 				ResourceName:            "google_cloudbuildv2_connection.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations"},
+				ImportStateVerifyIgnore: []string{"annotations", "terraform_annotations"},
 			},
 		},
 	})
@@ -349,7 +349,7 @@ func TestAccCloudbuildv2Connection_BbdcPrivConnection(t *testing.T) {
 				ResourceName:            "google_cloudbuildv2_connection.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations"},
+				ImportStateVerifyIgnore: []string{"annotations", "terraform_annotations"},
 			},
 		},
 	})
@@ -375,7 +375,7 @@ func TestAccCloudbuildv2Connection_BbdcPrivUpdateConnection(t *testing.T) {
 				ResourceName:            "google_cloudbuildv2_connection.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations"},
+				ImportStateVerifyIgnore: []string{"annotations", "terraform_annotations"},
 			},
 			{
 				Config: testAccCloudbuildv2Connection_BbdcPrivConnection(context),
@@ -384,7 +384,7 @@ func TestAccCloudbuildv2Connection_BbdcPrivUpdateConnection(t *testing.T) {
 				ResourceName:            "google_cloudbuildv2_connection.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations"},
+				ImportStateVerifyIgnore: []string{"annotations", "terraform_annotations"},
 			},
 		},
 	})
@@ -410,7 +410,7 @@ func TestAccCloudbuildv2Connection_BbcConnection(t *testing.T) {
 				ResourceName:            "google_cloudbuildv2_connection.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations"},
+				ImportStateVerifyIgnore: []string{"annotations", "terraform_annotations"},
 			},
 		},
 	})
@@ -31,7 +31,7 @@ func TestAccClouddeployAutomation_update(t *testing.T) {
 				ResourceName:            "google_clouddeploy_automation.automation",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"location", "delivery_pipeline", "annotations", "labels", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"location", "delivery_pipeline", "annotations", "labels", "terraform_labels", "terraform_annotations"},
 			},
 			{
 				Config: testAccClouddeployAutomation_update(context),
@@ -40,7 +40,7 @@ func TestAccClouddeployAutomation_update(t *testing.T) {
 				ResourceName:            "google_clouddeploy_automation.automation",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"location", "delivery_pipeline", "annotations", "labels", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"location", "delivery_pipeline", "annotations", "labels", "terraform_labels", "terraform_annotations"},
 			},
 		},
 	})
@@ -29,7 +29,7 @@ func TestAccClouddeployCustomTargetType_update(t *testing.T) {
 				ResourceName:            "google_clouddeploy_custom_target_type.custom-target-type",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"name", "location", "annotations", "labels", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"name", "location", "annotations", "labels", "terraform_labels", "terraform_annotations"},
 			},
 			{
 				Config: testAccClouddeployCustomTargetType_update(context),
@@ -38,7 +38,7 @@ func TestAccClouddeployCustomTargetType_update(t *testing.T) {
 				ResourceName:            "google_clouddeploy_custom_target_type.custom-target-type",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"name", "location", "annotations", "labels", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"name", "location", "annotations", "labels", "terraform_labels", "terraform_annotations"},
 			},
 		},
 	})
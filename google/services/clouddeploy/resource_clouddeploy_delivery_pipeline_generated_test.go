@@ -54,7 +54,7 @@ func TestAccClouddeployDeliveryPipeline_DeliveryPipeline(t *testing.T) {
 				ResourceName:            "google_clouddeploy_delivery_pipeline.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "annotations"},
+				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "annotations", "terraform_annotations"},
 			},
 			{
 				Config: testAccClouddeployDeliveryPipeline_DeliveryPipelineUpdate0(context),
@@ -63,7 +63,7 @@ func TestAccClouddeployDeliveryPipeline_DeliveryPipeline(t *testing.T) {
 				ResourceName:            "google_clouddeploy_delivery_pipeline.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "annotations"},
+				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "annotations", "terraform_annotations"},
 			},
 		},
 	})
@@ -69,5 +69,5 @@ func testSweepClouddeployDeliveryPipeline(region string) error {
 }
 
 func isDeletableClouddeployDeliveryPipeline(r *clouddeploy.DeliveryPipeline) bool {
-	return sweeper.IsSweepableTestResource(*r.Name)
+	return sweeper.IsSweepableDCLResource(*r.Name, sweeper.ResourceObject(r))
 }
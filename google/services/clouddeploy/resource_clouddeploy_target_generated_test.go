@@ -54,7 +54,7 @@ func TestAccClouddeployTarget_Target(t *testing.T) {
 				ResourceName:            "google_clouddeploy_target.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "annotations"},
+				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "annotations", "terraform_annotations"},
 			},
 			{
 				Config: testAccClouddeployTarget_TargetUpdate0(context),
@@ -63,7 +63,7 @@ func TestAccClouddeployTarget_Target(t *testing.T) {
 				ResourceName:            "google_clouddeploy_target.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "annotations"},
+				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "annotations", "terraform_annotations"},
 			},
 			{
 				Config: testAccClouddeployTarget_TargetUpdate1(context),
@@ -72,7 +72,7 @@ func TestAccClouddeployTarget_Target(t *testing.T) {
 				ResourceName:            "google_clouddeploy_target.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "annotations"},
+				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "annotations", "terraform_annotations"},
 			},
 			{
 				Config: testAccClouddeployTarget_TargetUpdate2(context),
@@ -81,7 +81,7 @@ func TestAccClouddeployTarget_Target(t *testing.T) {
 				ResourceName:            "google_clouddeploy_target.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "annotations"},
+				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "annotations", "terraform_annotations"},
 			},
 			{
 				Config: testAccClouddeployTarget_TargetUpdate3(context),
@@ -90,7 +90,7 @@ func TestAccClouddeployTarget_Target(t *testing.T) {
 				ResourceName:            "google_clouddeploy_target.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "annotations"},
+				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "annotations", "terraform_annotations"},
 			},
 		},
 	})
@@ -69,5 +69,5 @@ func testSweepClouddeployTarget(region string) error {
 }
 
 func isDeletableClouddeployTarget(r *clouddeploy.Target) bool {
-	return sweeper.IsSweepableTestResource(*r.Name)
+	return sweeper.IsSweepableDCLResource(*r.Name, sweeper.ResourceObject(r))
 }
@@ -47,7 +47,7 @@ func TestAccClouddeployTarget_withProviderDefaultLabels(t *testing.T) {
 				ResourceName:            "google_clouddeploy_target.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "annotations"},
+				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "annotations", "terraform_annotations"},
 			},
 			{
 				Config: testAccClouddeployTarget_resourceLabelsOverridesProviderDefaultLabels(context),
@@ -69,7 +69,7 @@ func TestAccClouddeployTarget_withProviderDefaultLabels(t *testing.T) {
 				ResourceName:            "google_clouddeploy_target.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "annotations"},
+				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "annotations", "terraform_annotations"},
 			},
 			{
 				Config: testAccClouddeployTarget_moveResourceLabelToProviderDefaultLabels(context),
@@ -90,7 +90,7 @@ func TestAccClouddeployTarget_withProviderDefaultLabels(t *testing.T) {
 				ResourceName:            "google_clouddeploy_target.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "annotations"},
+				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "annotations", "terraform_annotations"},
 			},
 			{
 				Config: testAccClouddeployTarget_resourceLabelsOverridesProviderDefaultLabels(context),
@@ -112,7 +112,7 @@ func TestAccClouddeployTarget_withProviderDefaultLabels(t *testing.T) {
 				ResourceName:            "google_clouddeploy_target.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "annotations"},
+				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "annotations", "terraform_annotations"},
 			},
 			{
 				Config: testAccClouddeployTarget_withoutLabels(context),
@@ -126,7 +126,7 @@ func TestAccClouddeployTarget_withProviderDefaultLabels(t *testing.T) {
 				ResourceName:            "google_clouddeploy_target.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "annotations"},
+				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "annotations", "terraform_annotations"},
 			},
 		},
 	})
@@ -321,7 +321,7 @@ func TestAccClouddeployTarget_withAttributionDisabled(t *testing.T) {
 				ResourceName:            "google_clouddeploy_target.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "annotations"},
+				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "annotations", "terraform_annotations"},
 			},
 			{
 				Config: testAccClouddeployTarget_updateWithAttribution(context),
@@ -342,7 +342,7 @@ func TestAccClouddeployTarget_withAttributionDisabled(t *testing.T) {
 				ResourceName:            "google_clouddeploy_target.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "annotations"},
+				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "annotations", "terraform_annotations"},
 			},
 			{
 				Config: testAccClouddeployTarget_clearWithAttribution(context),
@@ -395,7 +395,7 @@ func TestAccClouddeployTarget_withCreationOnlyAttribution(t *testing.T) {
 				ResourceName:            "google_clouddeploy_target.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "annotations"},
+				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "annotations", "terraform_annotations"},
 			},
 			{
 				Config: testAccClouddeployTarget_updateWithAttribution(context),
@@ -417,7 +417,7 @@ func TestAccClouddeployTarget_withCreationOnlyAttribution(t *testing.T) {
 				ResourceName:            "google_clouddeploy_target.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "annotations"},
+				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "annotations", "terraform_annotations"},
 			},
 			{
 				Config: testAccClouddeployTarget_clearWithAttribution(context),
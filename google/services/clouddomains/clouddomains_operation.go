@@ -34,12 +34,20 @@ type ClouddomainsOperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *ClouddomainsOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
+func (w *ClouddomainsOperationWaiter) OperationURL() string {
+	return fmt.Sprintf("%s%s", w.Config.ClouddomainsBasePath, w.CommonOperationWaiter.Op.Name)
+}
+
 func (w *ClouddomainsOperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
 	}
 	// Returns the proper get.
-	url := fmt.Sprintf("%s%s", w.Config.ClouddomainsBasePath, w.CommonOperationWaiter.Op.Name)
+	url := w.OperationURL()
 
 	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
 		Config:    w.Config,
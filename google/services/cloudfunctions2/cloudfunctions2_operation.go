@@ -34,12 +34,20 @@ type Cloudfunctions2OperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *Cloudfunctions2OperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
+func (w *Cloudfunctions2OperationWaiter) OperationURL() string {
+	return fmt.Sprintf("%s%s", w.Config.Cloudfunctions2BasePath, w.CommonOperationWaiter.Op.Name)
+}
+
 func (w *Cloudfunctions2OperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
 	}
 	// Returns the proper get.
-	url := fmt.Sprintf("%s%s", w.Config.Cloudfunctions2BasePath, w.CommonOperationWaiter.Op.Name)
+	url := w.OperationURL()
 
 	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
 		Config:    w.Config,
@@ -285,7 +285,7 @@ func resourceCloudIdentityGroupCreate(d *schema.ResourceData, meta interface{})
 	}
 	d.SetId(name.(string))
 
-	err = transport_tpg.PollingWaitTime(resourceCloudIdentityGroupPollRead(d, meta), transport_tpg.PollCheckForExistenceWith403, "Creating Group", d.Timeout(schema.TimeoutCreate), 10)
+	err = transport_tpg.PollingWaitTimeContext(config.TraceContext(), resourceCloudIdentityGroupPollRead(d, meta), transport_tpg.PollCheckForExistenceWith403, "Creating Group", d.Timeout(schema.TimeoutCreate), 10)
 	if err != nil {
 		return fmt.Errorf("Error waiting to create Group: %s", err)
 	}
@@ -474,7 +474,7 @@ func resourceCloudIdentityGroupUpdate(d *schema.ResourceData, meta interface{})
 			log.Printf("[DEBUG] Finished updating Group %q: %#v", d.Id(), res)
 		}
 
-		err = transport_tpg.PollingWaitTime(resourceCloudIdentityGroupPollRead(d, meta), transport_tpg.PollCheckForExistenceWith403, "Updating Group", d.Timeout(schema.TimeoutUpdate), 10)
+		err = transport_tpg.PollingWaitTimeContext(config.TraceContext(), resourceCloudIdentityGroupPollRead(d, meta), transport_tpg.PollCheckForExistenceWith403, "Updating Group", d.Timeout(schema.TimeoutUpdate), 10)
 		if err != nil {
 			return err
 		}
@@ -521,7 +521,7 @@ func resourceCloudIdentityGroupDelete(d *schema.ResourceData, meta interface{})
 		return transport_tpg.HandleNotFoundError(err, d, "Group")
 	}
 
-	err = transport_tpg.PollingWaitTime(resourceCloudIdentityGroupPollRead(d, meta), transport_tpg.PollCheckForAbsenceWith403, "Deleting Group", d.Timeout(schema.TimeoutCreate), 10)
+	err = transport_tpg.PollingWaitTimeContext(config.TraceContext(), resourceCloudIdentityGroupPollRead(d, meta), transport_tpg.PollCheckForAbsenceWith403, "Deleting Group", d.Timeout(schema.TimeoutCreate), 10)
 	if err != nil {
 		return fmt.Errorf("Error waiting to delete Group: %s", err)
 	}
@@ -89,7 +89,7 @@ func testSweepCloudIdentityGroup(region string) error {
 
 		name := obj["name"].(string)
 		// Skip resources that shouldn't be sweeped
-		if !sweeper.IsSweepableTestResource(obj["displayName"].(string)) {
+		if !sweeper.IsSweepableResource(obj["displayName"].(string), obj) {
 			nonPrefixCount++
 			continue
 		}
@@ -103,7 +103,7 @@ func testSweepCloudIdentityGroup(region string) error {
 		deleteUrl = deleteUrl + name
 
 		// Don't wait on operations as we may have a lot to delete
-		_, err = transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		_, err = sweeper.SendDeleteRequest(transport_tpg.SendRequestOptions{
 			Config:    config,
 			Method:    "DELETE",
 			Project:   config.Project,
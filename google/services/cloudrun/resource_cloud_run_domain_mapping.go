@@ -176,6 +176,13 @@ Please refer to the field 'effective_labels' for all of the labels present on th
 							Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
 							Elem:        &schema.Schema{Type: schema.TypeString},
 						},
+						"terraform_annotations": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							ForceNew:    true,
+							Description: `The combination of annotations configured directly on the resource and default annotations configured on the provider.`,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
 						"effective_labels": {
 							Type:        schema.TypeMap,
 							Computed:    true,
@@ -382,7 +389,7 @@ func resourceCloudRunDomainMappingCreate(d *schema.ResourceData, meta interface{
 	}
 	d.SetId(id)
 
-	err = transport_tpg.PollingWaitTime(resourceCloudRunDomainMappingPollRead(d, meta), PollCheckKnativeStatusFunc(res), "Creating DomainMapping", d.Timeout(schema.TimeoutCreate), 1)
+	err = transport_tpg.PollingWaitTimeContext(config.TraceContext(), resourceCloudRunDomainMappingPollRead(d, meta), PollCheckKnativeStatusFunc(res), "Creating DomainMapping", d.Timeout(schema.TimeoutCreate), 1)
 	if err != nil {
 		return fmt.Errorf("Error waiting to create DomainMapping: %s", err)
 	}
@@ -748,6 +755,8 @@ func flattenCloudRunDomainMappingMetadata(v interface{}, d *schema.ResourceData,
 		flattenCloudRunDomainMappingMetadataEffectiveLabels(original["labels"], d, config)
 	transformed["effective_annotations"] =
 		flattenCloudRunDomainMappingMetadataEffectiveAnnotations(original["annotations"], d, config)
+	transformed["terraform_annotations"] =
+		flattenCloudRunDomainMappingMetadataTerraformAnnotations(original["annotations"], d, config)
 	return []interface{}{transformed}
 }
 func flattenCloudRunDomainMappingMetadataLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -836,6 +845,21 @@ func flattenCloudRunDomainMappingMetadataEffectiveAnnotations(v interface{}, d *
 	return v
 }
 
+func flattenCloudRunDomainMappingMetadataTerraformAnnotations(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	if v == nil {
+		return v
+	}
+
+	transformed := make(map[string]interface{})
+	if l, ok := d.GetOkExists("metadata.0.terraform_annotations"); ok {
+		for k := range l.(map[string]interface{}) {
+			transformed[k] = v.(map[string]interface{})[k]
+		}
+	}
+
+	return transformed
+}
+
 func expandCloudRunDomainMappingSpec(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
 	l := v.([]interface{})
 	if len(l) == 0 || l[0] == nil {
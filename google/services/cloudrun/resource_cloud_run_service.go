@@ -1024,6 +1024,12 @@ project ID or project number.`,
 							Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
 							Elem:        &schema.Schema{Type: schema.TypeString},
 						},
+						"terraform_annotations": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: `The combination of annotations configured directly on the resource and default annotations configured on the provider.`,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
 						"effective_labels": {
 							Type:        schema.TypeMap,
 							Computed:    true,
@@ -1314,7 +1320,7 @@ func resourceCloudRunServiceCreate(d *schema.ResourceData, meta interface{}) err
 	}
 	d.SetId(id)
 
-	err = transport_tpg.PollingWaitTime(resourceCloudRunServicePollRead(d, meta), PollCheckKnativeStatusFunc(res), "Creating Service", d.Timeout(schema.TimeoutCreate), 1)
+	err = transport_tpg.PollingWaitTimeContext(config.TraceContext(), resourceCloudRunServicePollRead(d, meta), PollCheckKnativeStatusFunc(res), "Creating Service", d.Timeout(schema.TimeoutCreate), 1)
 	if err != nil {
 		return fmt.Errorf("Error waiting to create Service: %s", err)
 	}
@@ -1526,7 +1532,7 @@ func resourceCloudRunServiceUpdate(d *schema.ResourceData, meta interface{}) err
 		log.Printf("[DEBUG] Finished updating Service %q: %#v", d.Id(), res)
 	}
 
-	err = transport_tpg.PollingWaitTime(resourceCloudRunServicePollRead(d, meta), PollCheckKnativeStatusFunc(res), "Updating Service", d.Timeout(schema.TimeoutUpdate), 1)
+	err = transport_tpg.PollingWaitTimeContext(config.TraceContext(), resourceCloudRunServicePollRead(d, meta), PollCheckKnativeStatusFunc(res), "Updating Service", d.Timeout(schema.TimeoutUpdate), 1)
 	if err != nil {
 		return err
 	}
@@ -2898,6 +2904,8 @@ func flattenCloudRunServiceMetadata(v interface{}, d *schema.ResourceData, confi
 		flattenCloudRunServiceMetadataEffectiveLabels(original["labels"], d, config)
 	transformed["effective_annotations"] =
 		flattenCloudRunServiceMetadataEffectiveAnnotations(original["annotations"], d, config)
+	transformed["terraform_annotations"] =
+		flattenCloudRunServiceMetadataTerraformAnnotations(original["annotations"], d, config)
 	return []interface{}{transformed}
 }
 func flattenCloudRunServiceMetadataLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -2986,6 +2994,21 @@ func flattenCloudRunServiceMetadataEffectiveAnnotations(v interface{}, d *schema
 	return v
 }
 
+func flattenCloudRunServiceMetadataTerraformAnnotations(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	if v == nil {
+		return v
+	}
+
+	transformed := make(map[string]interface{})
+	if l, ok := d.GetOkExists("metadata.0.terraform_annotations"); ok {
+		for k := range l.(map[string]interface{}) {
+			transformed[k] = v.(map[string]interface{})[k]
+		}
+	}
+
+	return transformed
+}
+
 func expandCloudRunServiceSpec(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
 	transformed := make(map[string]interface{})
 	transformedTraffic, err := expandCloudRunServiceSpecTraffic(d.Get("traffic"), d, config)
@@ -51,7 +51,7 @@ func TestAccCloudRunService_cloudRunServiceBasicExample(t *testing.T) {
 				ResourceName:            "google_cloud_run_service.default",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"location", "metadata.0.annotations", "metadata.0.labels", "metadata.0.terraform_labels", "name"},
+				ImportStateVerifyIgnore: []string{"location", "metadata.0.annotations", "metadata.0.labels", "metadata.0.terraform_annotations", "metadata.0.terraform_labels", "name"},
 			},
 		},
 	})
@@ -99,7 +99,7 @@ func TestAccCloudRunService_cloudRunServiceSqlExample(t *testing.T) {
 				ResourceName:            "google_cloud_run_service.default",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"autogenerate_revision_name", "location", "metadata.0.annotations", "metadata.0.labels", "metadata.0.terraform_labels", "name"},
+				ImportStateVerifyIgnore: []string{"autogenerate_revision_name", "location", "metadata.0.annotations", "metadata.0.labels", "metadata.0.terraform_annotations", "metadata.0.terraform_labels", "name"},
 			},
 		},
 	})
@@ -162,7 +162,7 @@ func TestAccCloudRunService_cloudRunServiceNoauthExample(t *testing.T) {
 				ResourceName:            "google_cloud_run_service.default",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"location", "metadata.0.annotations", "metadata.0.labels", "metadata.0.terraform_labels", "name"},
+				ImportStateVerifyIgnore: []string{"location", "metadata.0.annotations", "metadata.0.labels", "metadata.0.terraform_annotations", "metadata.0.terraform_labels", "name"},
 			},
 		},
 	})
@@ -223,7 +223,7 @@ func TestAccCloudRunService_cloudRunServiceMultipleEnvironmentVariablesExample(t
 				ResourceName:            "google_cloud_run_service.default",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"autogenerate_revision_name", "location", "metadata.0.annotations", "metadata.0.labels", "metadata.0.terraform_labels", "name"},
+				ImportStateVerifyIgnore: []string{"autogenerate_revision_name", "location", "metadata.0.annotations", "metadata.0.labels", "metadata.0.terraform_annotations", "metadata.0.terraform_labels", "name"},
 			},
 		},
 	})
@@ -292,7 +292,7 @@ func TestAccCloudRunService_cloudRunServiceSecretEnvironmentVariablesExample(t *
 				ResourceName:            "google_cloud_run_service.default",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"autogenerate_revision_name", "location", "metadata.0.annotations", "metadata.0.labels", "metadata.0.terraform_labels", "name"},
+				ImportStateVerifyIgnore: []string{"autogenerate_revision_name", "location", "metadata.0.annotations", "metadata.0.labels", "metadata.0.terraform_annotations", "metadata.0.terraform_labels", "name"},
 			},
 		},
 	})
@@ -386,7 +386,7 @@ func TestAccCloudRunService_cloudRunServiceSecretVolumesExample(t *testing.T) {
 				ResourceName:            "google_cloud_run_service.default",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"autogenerate_revision_name", "location", "metadata.0.annotations", "metadata.0.labels", "metadata.0.terraform_labels", "name"},
+				ImportStateVerifyIgnore: []string{"autogenerate_revision_name", "location", "metadata.0.annotations", "metadata.0.labels", "metadata.0.terraform_annotations", "metadata.0.terraform_labels", "name"},
 			},
 		},
 	})
@@ -487,7 +487,7 @@ func TestAccCloudRunService_cloudRunServiceProbesExample(t *testing.T) {
 				ResourceName:            "google_cloud_run_service.default",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"location", "metadata.0.annotations", "metadata.0.labels", "metadata.0.terraform_labels", "name"},
+				ImportStateVerifyIgnore: []string{"location", "metadata.0.annotations", "metadata.0.labels", "metadata.0.terraform_annotations", "metadata.0.terraform_labels", "name"},
 			},
 		},
 	})
@@ -34,12 +34,20 @@ type CloudRunV2OperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *CloudRunV2OperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
+func (w *CloudRunV2OperationWaiter) OperationURL() string {
+	return fmt.Sprintf("%s%s", w.Config.CloudRunV2BasePath, w.CommonOperationWaiter.Op.Name)
+}
+
 func (w *CloudRunV2OperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
 	}
 	// Returns the proper get.
-	url := fmt.Sprintf("%s%s", w.Config.CloudRunV2BasePath, w.CommonOperationWaiter.Op.Name)
+	url := w.OperationURL()
 
 	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
 		Config:    w.Config,
@@ -20,11 +20,19 @@ type RunAdminV2OperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *RunAdminV2OperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
+func (w *RunAdminV2OperationWaiter) OperationURL() string {
+	return fmt.Sprintf("%s%s", w.Config.CloudRunV2BasePath, w.CommonOperationWaiter.Op.Name)
+}
+
 func (w *RunAdminV2OperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
 	}
-	url := fmt.Sprintf("%s%s", w.Config.CloudRunV2BasePath, w.CommonOperationWaiter.Op.Name)
+	url := w.OperationURL()
 
 	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
 		Config:    w.Config,
@@ -19,6 +19,10 @@ type ColabOperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *ColabOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
 func (w *ColabOperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
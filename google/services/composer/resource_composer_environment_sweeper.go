@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-provider-google/google/sweeper"
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
 
 	"log"
@@ -70,14 +71,11 @@ func testSweepComposerEnvironments(config *transport_tpg.Config, region string)
 
 	var allErrors error
 	for _, e := range found.Environments {
-		createdAt, err := time.Parse(time.RFC3339Nano, e.CreateTime)
-		if err != nil {
-			return fmt.Errorf("composer: environment %q has invalid create time %q", e.Name, e.CreateTime)
-		}
 		// Skip environments that were created in same day
 		// This sweeper should really only clean out very old environments.
-		if time.Since(createdAt) < time.Hour*24 {
-			log.Printf("composer: skipped environment %q, it was created today", e.Name)
+		name := tpgresource.GetResourceNameFromSelfLink(e.Name)
+		if !sweeper.IsSweepableResourceOlderThan(name, sweeper.ResourceObject(e), time.Hour*24) {
+			log.Printf("composer: skipped environment %q", e.Name)
 			continue
 		}
 
@@ -93,14 +91,15 @@ func testSweepComposerEnvironments(config *transport_tpg.Config, region string)
 		case "ERROR":
 			fallthrough
 		default:
-			op, deleteErr := config.NewComposerClient(config.UserAgent).Projects.Locations.Environments.Delete(e.Name).Do()
+			deleteErr := sweeper.DeleteResource(name, e.Name, func() error {
+				op, err := config.NewComposerClient(config.UserAgent).Projects.Locations.Environments.Delete(e.Name).Do()
+				if err != nil {
+					return err
+				}
+				return ComposerOperationWaitTime(config, op, config.Project, "Sweeping old test environments", config.UserAgent, 10*time.Minute)
+			})
 			if deleteErr != nil {
 				allErrors = multierror.Append(allErrors, fmt.Errorf("composer: unable to delete environment %q: %s", e.Name, deleteErr))
-				continue
-			}
-			waitErr := ComposerOperationWaitTime(config, op, config.Project, "Sweeping old test environments", config.UserAgent, 10*time.Minute)
-			if waitErr != nil {
-				allErrors = multierror.Append(allErrors, fmt.Errorf("composer: unable to delete environment %q: %s", e.Name, waitErr))
 			}
 		}
 	}
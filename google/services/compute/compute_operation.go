@@ -19,6 +19,7 @@ import (
 )
 
 type ComputeOperationWaiter struct {
+	Config  *transport_tpg.Config
 	Service *compute.Service
 	Op      *compute.Operation
 	Context context.Context
@@ -26,6 +27,17 @@ type ComputeOperationWaiter struct {
 	Parent  string
 }
 
+func (w *ComputeOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
+func (w *ComputeOperationWaiter) OperationURL() string {
+	if w == nil || w.Op == nil {
+		return ""
+	}
+	return w.Op.SelfLink
+}
+
 func (w *ComputeOperationWaiter) State() string {
 	if w == nil || w.Op == nil {
 		return "<nil>"
@@ -110,6 +122,7 @@ func ComputeOperationWaitTime(config *transport_tpg.Config, res interface{}, pro
 	}
 
 	w := &ComputeOperationWaiter{
+		Config:  config,
 		Service: config.NewComputeClient(userAgent),
 		Context: config.Context,
 		Op:      op,
@@ -130,6 +143,7 @@ func ComputeOrgOperationWaitTimeWithResponse(config *transport_tpg.Config, res i
 	}
 
 	w := &ComputeOperationWaiter{
+		Config:  config,
 		Service: config.NewComputeClient(userAgent),
 		Op:      op,
 		Parent:  parent,
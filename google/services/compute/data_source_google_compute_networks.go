@@ -47,15 +47,33 @@ func dataSourceGoogleComputeNetworksRead(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	networkList, err := config.NewComputeClient(userAgent).Networks.List(project).Do()
+	url, err := tpgresource.ReplaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/global/networks")
 	if err != nil {
-		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Network Not Found : %s", project))
+		return err
 	}
 
-	var networks = make([]string, len(networkList.Items))
-
-	for i := 0; i < len(networkList.Items); i++ {
-		networks[i] = networkList.Items[i].Name
+	var selfLink string
+	networks := make([]string, 0)
+	it := transport_tpg.NewListIterator(transport_tpg.ListRequestOptions{
+		Config:     config,
+		Project:    project,
+		RawURL:     url,
+		UserAgent:  userAgent,
+		ItemsField: "items",
+	})
+	for !it.Done() {
+		page, err := it.Next()
+		if err != nil {
+			return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Network Not Found : %s", project))
+		}
+		if v, ok := page.Response["selfLink"].(string); ok {
+			selfLink = v
+		}
+		for _, item := range page.Items {
+			if network, ok := item.(map[string]interface{}); ok {
+				networks = append(networks, network["name"].(string))
+			}
+		}
 	}
 
 	if err := d.Set("networks", networks); err != nil {
@@ -66,7 +84,7 @@ func dataSourceGoogleComputeNetworksRead(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("Error setting the network names: %s", err)
 	}
 
-	if err := d.Set("self_link", networkList.SelfLink); err != nil {
+	if err := d.Set("self_link", selfLink); err != nil {
 		return fmt.Errorf("Error setting self_link: %s", err)
 	}
 
@@ -1362,6 +1362,7 @@ func expandComputeInstance(project string, d *schema.ResourceData, config *trans
 	if err != nil {
 		return nil, fmt.Errorf("Error creating params: %s", err)
 	}
+	params.ResourceManagerTags = tpgresource.MergeDefaultResourceTags(config, params.ResourceManagerTags)
 
 	metadata, err := resourceInstanceMetadata(d)
 	if err != nil {
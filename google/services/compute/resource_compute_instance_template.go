@@ -1426,6 +1426,7 @@ func resourceComputeInstanceTemplateCreate(d *schema.ResourceData, meta interfac
 	if _, ok := d.GetOk("resource_manager_tags"); ok {
 		instanceProperties.ResourceManagerTags = tpgresource.ExpandStringMap(d, "resource_manager_tags")
 	}
+	instanceProperties.ResourceManagerTags = tpgresource.MergeDefaultResourceTags(config, instanceProperties.ResourceManagerTags)
 
 	var itName string
 	if v, ok := d.GetOk("name"); ok {
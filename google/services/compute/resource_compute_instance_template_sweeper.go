@@ -48,13 +48,16 @@ func testSweepComputeInstanceTemplate(region string) error {
 	nonPrefixCount := 0
 	for _, instanceTemplate := range instanceTemplates.Items {
 		// Increment count and skip if resource is not sweepable.
-		if !sweeper.IsSweepableTestResource(instanceTemplate.Name) {
+		if !sweeper.IsSweepableResource(instanceTemplate.Name, sweeper.ResourceObject(instanceTemplate)) {
 			nonPrefixCount++
 			continue
 		}
 
 		// Don't wait on operations as we may have a lot to delete
-		_, err := config.NewComputeClient(config.UserAgent).InstanceTemplates.Delete(config.Project, instanceTemplate.Name).Do()
+		err := sweeper.DeleteResource(instanceTemplate.Name, instanceTemplate.SelfLink, func() error {
+			_, err := config.NewComputeClient(config.UserAgent).InstanceTemplates.Delete(config.Project, instanceTemplate.Name).Do()
+			return err
+		})
 		if err != nil {
 			log.Printf("[INFO][SWEEPER_LOG] Error deleting instance template: %s", instanceTemplate.Name)
 		} else {
@@ -1132,6 +1132,7 @@ func resourceComputeRegionInstanceTemplateCreate(d *schema.ResourceData, meta in
 	if _, ok := d.GetOk("resource_manager_tags"); ok {
 		instanceProperties.ResourceManagerTags = tpgresource.ExpandStringMap(d, "resource_manager_tags")
 	}
+	instanceProperties.ResourceManagerTags = tpgresource.MergeDefaultResourceTags(config, instanceProperties.ResourceManagerTags)
 
 	var itName string
 	if v, ok := d.GetOk("name"); ok {
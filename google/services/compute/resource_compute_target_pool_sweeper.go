@@ -42,13 +42,16 @@ func testSweepTargetPool(region string) error {
 	nonPrefixCount := 0
 	for zone, itemList := range found.Items {
 		for _, tp := range itemList.TargetPools {
-			if !sweeper.IsSweepableTestResource(tp.Name) {
+			if !sweeper.IsSweepableResource(tp.Name, sweeper.ResourceObject(tp)) {
 				nonPrefixCount++
 				continue
 			}
 
 			// Don't wait on operations as we may have a lot to delete
-			_, err := config.NewComputeClient(config.UserAgent).TargetPools.Delete(config.Project, tpgresource.GetResourceNameFromSelfLink(zone), tp.Name).Do()
+			err := sweeper.DeleteResource(tp.Name, tp.SelfLink, func() error {
+				_, err := config.NewComputeClient(config.UserAgent).TargetPools.Delete(config.Project, tpgresource.GetResourceNameFromSelfLink(zone), tp.Name).Do()
+				return err
+			})
 			if err != nil {
 				log.Printf("[INFO][SWEEPER_LOG] Error deleting %s resource %s : %s", resourceName, tp.Name, err)
 			} else {
@@ -16,6 +16,7 @@ import (
 )
 
 type ContainerOperationWaiter struct {
+	Config              *transport_tpg.Config
 	Service             *container.Service
 	Context             context.Context
 	Op                  *container.Operation
@@ -24,6 +25,17 @@ type ContainerOperationWaiter struct {
 	UserProjectOverride bool
 }
 
+func (w *ContainerOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
+func (w *ContainerOperationWaiter) OperationURL() string {
+	if w == nil || w.Op == nil {
+		return ""
+	}
+	return w.Op.SelfLink
+}
+
 func (w *ContainerOperationWaiter) State() string {
 	if w == nil || w.Op == nil {
 		return "<nil>"
@@ -112,6 +124,7 @@ func (w *ContainerOperationWaiter) TargetStates() []string {
 
 func ContainerOperationWait(config *transport_tpg.Config, op *container.Operation, project, location, activity, userAgent string, timeout time.Duration) error {
 	w := &ContainerOperationWaiter{
+		Config:              config,
 		Service:             config.NewContainerClient(userAgent),
 		Context:             config.Context,
 		Op:                  op,
@@ -38,10 +38,16 @@ func testSweepContainerClusters(region string) error {
 	}
 
 	for _, cluster := range found.Clusters {
-		if sweeper.IsSweepableTestResource(cluster.Name) {
+		obj := sweeper.ResourceObject(cluster)
+		// Clusters have their labels in resourceLabels.
+		obj["labels"] = obj["resourceLabels"]
+		if sweeper.IsSweepableResource(cluster.Name, obj) {
 			log.Printf("Sweeping Container Cluster: %s", cluster.Name)
 			clusterURL := fmt.Sprintf("projects/%s/locations/%s/clusters/%s", config.Project, cluster.Location, cluster.Name)
-			_, err := config.NewContainerClient(config.UserAgent).Projects.Locations.Clusters.Delete(clusterURL).Do()
+			err := sweeper.DeleteResource(cluster.Name, clusterURL, func() error {
+				_, err := config.NewContainerClient(config.UserAgent).Projects.Locations.Clusters.Delete(clusterURL).Do()
+				return err
+			})
 
 			if err != nil {
 				log.Printf("Error, failed to delete cluster %s: %s", cluster.Name, err)
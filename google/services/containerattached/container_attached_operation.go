@@ -18,6 +18,10 @@ type ContainerAttachedOperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *ContainerAttachedOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
 func (w *ContainerAttachedOperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
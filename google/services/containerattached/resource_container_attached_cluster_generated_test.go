@@ -49,7 +49,7 @@ func TestAccContainerAttachedCluster_containerAttachedClusterBasicExample(t *tes
 				ResourceName:            "google_container_attached_cluster.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "location"},
+				ImportStateVerifyIgnore: []string{"annotations", "location", "terraform_annotations"},
 			},
 		},
 	})
@@ -101,7 +101,7 @@ func TestAccContainerAttachedCluster_containerAttachedClusterFullExample(t *test
 				ResourceName:            "google_container_attached_cluster.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "location"},
+				ImportStateVerifyIgnore: []string{"annotations", "location", "terraform_annotations"},
 			},
 		},
 	})
@@ -180,7 +180,7 @@ func TestAccContainerAttachedCluster_containerAttachedClusterIgnoreErrorsExample
 				ResourceName:            "google_container_attached_cluster.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "deletion_policy", "location"},
+				ImportStateVerifyIgnore: []string{"annotations", "deletion_policy", "location", "terraform_annotations"},
 			},
 		},
 	})
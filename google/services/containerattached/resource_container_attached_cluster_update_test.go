@@ -28,7 +28,7 @@ func TestAccContainerAttachedCluster_update(t *testing.T) {
 				ResourceName:            "google_container_attached_cluster.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"location", "annotations"},
+				ImportStateVerifyIgnore: []string{"location", "annotations", "terraform_annotations"},
 			},
 			{
 				Config: testAccContainerAttachedCluster_containerAttachedCluster_update(context),
@@ -37,7 +37,7 @@ func TestAccContainerAttachedCluster_update(t *testing.T) {
 				ResourceName:            "google_container_attached_cluster.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"location", "annotations"},
+				ImportStateVerifyIgnore: []string{"location", "annotations", "terraform_annotations"},
 			},
 			{
 				Config: testAccContainerAttachedCluster_containerAttachedCluster_removeAuthorizationUsers(context),
@@ -46,7 +46,7 @@ func TestAccContainerAttachedCluster_update(t *testing.T) {
 				ResourceName:            "google_container_attached_cluster.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"location", "annotations"},
+				ImportStateVerifyIgnore: []string{"location", "annotations", "terraform_annotations"},
 			},
 			{
 				Config: testAccContainerAttachedCluster_containerAttachedCluster_removeAuthorizationGroups(context),
@@ -55,7 +55,7 @@ func TestAccContainerAttachedCluster_update(t *testing.T) {
 				ResourceName:            "google_container_attached_cluster.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"location", "annotations"},
+				ImportStateVerifyIgnore: []string{"location", "annotations", "terraform_annotations"},
 			},
 			{
 				Config: testAccContainerAttachedCluster_containerAttachedCluster_destroy(context),
@@ -64,7 +64,7 @@ func TestAccContainerAttachedCluster_update(t *testing.T) {
 				ResourceName:            "google_container_attached_cluster.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"location", "annotations"},
+				ImportStateVerifyIgnore: []string{"location", "annotations", "terraform_annotations"},
 			},
 		},
 	})
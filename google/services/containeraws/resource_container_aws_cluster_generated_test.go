@@ -63,7 +63,7 @@ func TestAccContainerAwsCluster_BasicHandWritten(t *testing.T) {
 				ResourceName:            "google_container_aws_cluster.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"fleet.0.project", "annotations"},
+				ImportStateVerifyIgnore: []string{"fleet.0.project", "annotations", "terraform_annotations"},
 			},
 			{
 				Config: testAccContainerAwsCluster_BasicHandWrittenUpdate0(context),
@@ -72,7 +72,7 @@ func TestAccContainerAwsCluster_BasicHandWritten(t *testing.T) {
 				ResourceName:            "google_container_aws_cluster.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"fleet.0.project", "annotations"},
+				ImportStateVerifyIgnore: []string{"fleet.0.project", "annotations", "terraform_annotations"},
 			},
 		},
 	})
@@ -107,7 +107,7 @@ func TestAccContainerAwsCluster_BasicEnumHandWritten(t *testing.T) {
 				ResourceName:            "google_container_aws_cluster.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"fleet.0.project", "annotations"},
+				ImportStateVerifyIgnore: []string{"fleet.0.project", "annotations", "terraform_annotations"},
 			},
 			{
 				Config: testAccContainerAwsCluster_BasicEnumHandWrittenUpdate0(context),
@@ -116,7 +116,7 @@ func TestAccContainerAwsCluster_BasicEnumHandWritten(t *testing.T) {
 				ResourceName:            "google_container_aws_cluster.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"fleet.0.project", "annotations"},
+				ImportStateVerifyIgnore: []string{"fleet.0.project", "annotations", "terraform_annotations"},
 			},
 		},
 	})
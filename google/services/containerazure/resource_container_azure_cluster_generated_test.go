@@ -59,7 +59,7 @@ func TestAccContainerAzureCluster_BasicHandWritten(t *testing.T) {
 				ResourceName:            "google_container_azure_cluster.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"fleet.0.project", "annotations"},
+				ImportStateVerifyIgnore: []string{"fleet.0.project", "annotations", "terraform_annotations"},
 			},
 			{
 				Config: testAccContainerAzureCluster_BasicHandWrittenUpdate0(context),
@@ -68,7 +68,7 @@ func TestAccContainerAzureCluster_BasicHandWritten(t *testing.T) {
 				ResourceName:            "google_container_azure_cluster.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"fleet.0.project", "annotations"},
+				ImportStateVerifyIgnore: []string{"fleet.0.project", "annotations", "terraform_annotations"},
 			},
 		},
 	})
@@ -34,12 +34,20 @@ type DatabaseMigrationServiceOperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *DatabaseMigrationServiceOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
+func (w *DatabaseMigrationServiceOperationWaiter) OperationURL() string {
+	return fmt.Sprintf("%s%s", w.Config.DatabaseMigrationServiceBasePath, w.CommonOperationWaiter.Op.Name)
+}
+
 func (w *DatabaseMigrationServiceOperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
 	}
 	// Returns the proper get.
-	url := fmt.Sprintf("%s%s", w.Config.DatabaseMigrationServiceBasePath, w.CommonOperationWaiter.Op.Name)
+	url := w.OperationURL()
 
 	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
 		Config:    w.Config,
@@ -69,5 +69,5 @@ func testSweepDataplexLake(region string) error {
 }
 
 func isDeletableDataplexLake(r *dataplex.Lake) bool {
-	return sweeper.IsSweepableTestResource(*r.Name)
+	return sweeper.IsSweepableDCLResource(*r.Name, sweeper.ResourceObject(r))
 }
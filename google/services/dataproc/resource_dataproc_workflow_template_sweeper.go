@@ -69,5 +69,5 @@ func testSweepDataprocWorkflowTemplate(region string) error {
 }
 
 func isDeletableDataprocWorkflowTemplate(r *dataproc.WorkflowTemplate) bool {
-	return sweeper.IsSweepableTestResource(*r.Name)
+	return sweeper.IsSweepableDCLResource(*r.Name, sweeper.ResourceObject(r))
 }
@@ -51,7 +51,7 @@ func TestAccDataprocGdcApplicationEnvironment_dataprocgdcApplicationenvironmentB
 				ResourceName:            "google_dataproc_gdc_application_environment.application-environment",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "application_environment_id", "labels", "location", "serviceinstance", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "application_environment_id", "labels", "location", "serviceinstance", "terraform_annotations", "terraform_labels"},
 			},
 		},
 	})
@@ -90,7 +90,7 @@ func TestAccDataprocGdcApplicationEnvironment_dataprocgdcApplicationenvironmentE
 				ResourceName:            "google_dataproc_gdc_application_environment.application-environment",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "application_environment_id", "labels", "location", "serviceinstance", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "application_environment_id", "labels", "location", "serviceinstance", "terraform_annotations", "terraform_labels"},
 			},
 		},
 	})
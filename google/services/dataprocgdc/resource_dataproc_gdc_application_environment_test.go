@@ -28,7 +28,7 @@ func TestAccDataprocGdcApplicationEnvironment_update(t *testing.T) {
 				ResourceName:            "google_dataproc_gdc_application_environment.application-environment",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "application_environment_id", "labels", "location", "serviceinstance", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "application_environment_id", "labels", "location", "serviceinstance", "terraform_annotations", "terraform_labels"},
 			},
 			{
 				Config: testAccDataprocGdcApplicationEnvironment_update(context),
@@ -37,7 +37,7 @@ func TestAccDataprocGdcApplicationEnvironment_update(t *testing.T) {
 				ResourceName:            "google_dataproc_gdc_application_environment.application-environment",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "application_environment_id", "labels", "location", "serviceinstance", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "application_environment_id", "labels", "location", "serviceinstance", "terraform_annotations", "terraform_labels"},
 			},
 		},
 	})
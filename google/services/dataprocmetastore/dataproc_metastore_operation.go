@@ -34,12 +34,20 @@ type DataprocMetastoreOperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *DataprocMetastoreOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
+func (w *DataprocMetastoreOperationWaiter) OperationURL() string {
+	return fmt.Sprintf("%s%s", w.Config.DataprocMetastoreBasePath, w.CommonOperationWaiter.Op.Name)
+}
+
 func (w *DataprocMetastoreOperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
 	}
 	// Returns the proper get.
-	url := fmt.Sprintf("%s%s", w.Config.DataprocMetastoreBasePath, w.CommonOperationWaiter.Op.Name)
+	url := w.OperationURL()
 
 	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
 		Config:    w.Config,
@@ -22,12 +22,20 @@ type DatastreamOperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *DatastreamOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
+func (w *DatastreamOperationWaiter) OperationURL() string {
+	return fmt.Sprintf("%s%s", w.Config.DatastreamBasePath, w.Op.Name)
+}
+
 func (w *DatastreamOperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
 	}
 	// Returns the proper get.
-	url := fmt.Sprintf("%s%s", w.Config.DatastreamBasePath, w.Op.Name)
+	url := w.OperationURL()
 
 	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
 		Config:    w.Config,
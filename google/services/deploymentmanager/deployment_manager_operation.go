@@ -22,6 +22,10 @@ type DeploymentManagerOperationWaiter struct {
 	tpgcompute.ComputeOperationWaiter
 }
 
+func (w *DeploymentManagerOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
 func (w *DeploymentManagerOperationWaiter) IsRetryable(error) bool {
 	return false
 }
@@ -34,12 +34,20 @@ type DeveloperConnectOperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *DeveloperConnectOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
+func (w *DeveloperConnectOperationWaiter) OperationURL() string {
+	return fmt.Sprintf("%s%s", w.Config.DeveloperConnectBasePath, w.CommonOperationWaiter.Op.Name)
+}
+
 func (w *DeveloperConnectOperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
 	}
 	// Returns the proper get.
-	url := fmt.Sprintf("%s%s", w.Config.DeveloperConnectBasePath, w.CommonOperationWaiter.Op.Name)
+	url := w.OperationURL()
 
 	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
 		Config:    w.Config,
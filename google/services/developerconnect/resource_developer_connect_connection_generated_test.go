@@ -50,7 +50,7 @@ func TestAccDeveloperConnectConnection_developerConnectConnectionExistingCredent
 				ResourceName:            "google_developer_connect_connection.my-connection",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "connection_id", "labels", "location", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "connection_id", "labels", "location", "terraform_annotations", "terraform_labels"},
 			},
 		},
 	})
@@ -97,7 +97,7 @@ func TestAccDeveloperConnectConnection_developerConnectConnectionGithubExample(t
 				ResourceName:            "google_developer_connect_connection.my-connection",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "connection_id", "labels", "location", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "connection_id", "labels", "location", "terraform_annotations", "terraform_labels"},
 			},
 		},
 	})
@@ -139,7 +139,7 @@ func TestAccDeveloperConnectConnection_developerConnectConnectionGithubEnterpris
 				ResourceName:            "google_developer_connect_connection.my-connection",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "connection_id", "labels", "location", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "connection_id", "labels", "location", "terraform_annotations", "terraform_labels"},
 			},
 		},
 	})
@@ -181,7 +181,7 @@ func TestAccDeveloperConnectConnection_developerConnectConnectionGitlabExample(t
 				ResourceName:            "google_developer_connect_connection.my-connection",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "connection_id", "labels", "location", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "connection_id", "labels", "location", "terraform_annotations", "terraform_labels"},
 			},
 		},
 	})
@@ -227,7 +227,7 @@ func TestAccDeveloperConnectConnection_developerConnectConnectionGitlabEnterpris
 				ResourceName:            "google_developer_connect_connection.my-connection",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "connection_id", "labels", "location", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "connection_id", "labels", "location", "terraform_annotations", "terraform_labels"},
 			},
 		},
 	})
@@ -27,7 +27,7 @@ func TestAccDeveloperConnectConnection_developerConnectConnectionGithubUpdate(t
 				ResourceName:            "google_developer_connect_connection.my-connection",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "connection_id", "labels", "location", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "connection_id", "labels", "location", "terraform_annotations", "terraform_labels"},
 			},
 			{
 				Config: testAccDeveloperConnectConnection_GithubUpdate(context),
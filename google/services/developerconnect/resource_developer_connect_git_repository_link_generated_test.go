@@ -49,7 +49,7 @@ func TestAccDeveloperConnectGitRepositoryLink_developerConnectGitRepositoryLinkG
 				ResourceName:            "google_developer_connect_git_repository_link.primary",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "git_repository_link_id", "labels", "location", "parent_connection", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "git_repository_link_id", "labels", "location", "parent_connection", "terraform_annotations", "terraform_labels"},
 			},
 		},
 	})
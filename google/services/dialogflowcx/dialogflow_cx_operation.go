@@ -18,6 +18,10 @@ type DialogflowCXOperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *DialogflowCXOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
 func (w *DialogflowCXOperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
@@ -33,12 +33,20 @@ type DocumentAIWarehouseOperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *DocumentAIWarehouseOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
+func (w *DocumentAIWarehouseOperationWaiter) OperationURL() string {
+	return fmt.Sprintf("%s%s", w.Config.DocumentAIWarehouseBasePath, w.CommonOperationWaiter.Op.Name)
+}
+
 func (w *DocumentAIWarehouseOperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
 	}
 	// Returns the proper get.
-	url := fmt.Sprintf("%s%s", w.Config.DocumentAIWarehouseBasePath, w.CommonOperationWaiter.Op.Name)
+	url := w.OperationURL()
 
 	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
 		Config:    w.Config,
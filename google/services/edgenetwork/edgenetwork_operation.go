@@ -34,12 +34,20 @@ type EdgenetworkOperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *EdgenetworkOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
+func (w *EdgenetworkOperationWaiter) OperationURL() string {
+	return fmt.Sprintf("%s%s", w.Config.EdgenetworkBasePath, w.CommonOperationWaiter.Op.Name)
+}
+
 func (w *EdgenetworkOperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
 	}
 	// Returns the proper get.
-	url := fmt.Sprintf("%s%s", w.Config.EdgenetworkBasePath, w.CommonOperationWaiter.Op.Name)
+	url := w.OperationURL()
 
 	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
 		Config:    w.Config,
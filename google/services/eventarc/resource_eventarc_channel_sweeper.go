@@ -69,5 +69,5 @@ func testSweepEventarcChannel(region string) error {
 }
 
 func isDeletableEventarcChannel(r *eventarc.Channel) bool {
-	return sweeper.IsSweepableTestResource(*r.Name)
+	return sweeper.IsSweepableDCLResource(*r.Name, sweeper.ResourceObject(r))
 }
@@ -69,5 +69,5 @@ func testSweepEventarcTrigger(region string) error {
 }
 
 func isDeletableEventarcTrigger(r *eventarc.Trigger) bool {
-	return sweeper.IsSweepableTestResource(*r.Name)
+	return sweeper.IsSweepableDCLResource(*r.Name, sweeper.ResourceObject(r))
 }
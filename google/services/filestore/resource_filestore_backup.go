@@ -198,7 +198,9 @@ func resourceFilestoreBackupCreate(d *schema.ResourceData, meta interface{}) err
 	tagsProp, err := expandFilestoreBackupTags(d.Get("tags"), d, config)
 	if err != nil {
 		return err
-	} else if v, ok := d.GetOkExists("tags"); !tpgresource.IsEmptyValue(reflect.ValueOf(tagsProp)) && (ok || !reflect.DeepEqual(v, tagsProp)) {
+	}
+	tagsProp = tpgresource.MergeDefaultResourceTags(config, tagsProp)
+	if v, ok := d.GetOkExists("tags"); !tpgresource.IsEmptyValue(reflect.ValueOf(tagsProp)) && (ok || !reflect.DeepEqual(v, tagsProp)) {
 		obj["tags"] = tagsProp
 	}
 	labelsProp, err := expandFilestoreBackupEffectiveLabels(d.Get("effective_labels"), d, config)
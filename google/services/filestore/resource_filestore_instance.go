@@ -513,7 +513,9 @@ func resourceFilestoreInstanceCreate(d *schema.ResourceData, meta interface{}) e
 	tagsProp, err := expandFilestoreInstanceTags(d.Get("tags"), d, config)
 	if err != nil {
 		return err
-	} else if v, ok := d.GetOkExists("tags"); !tpgresource.IsEmptyValue(reflect.ValueOf(tagsProp)) && (ok || !reflect.DeepEqual(v, tagsProp)) {
+	}
+	tagsProp = tpgresource.MergeDefaultResourceTags(config, tagsProp)
+	if v, ok := d.GetOkExists("tags"); !tpgresource.IsEmptyValue(reflect.ValueOf(tagsProp)) && (ok || !reflect.DeepEqual(v, tagsProp)) {
 		obj["tags"] = tagsProp
 	}
 	labelsProp, err := expandFilestoreInstanceEffectiveLabels(d.Get("effective_labels"), d, config)
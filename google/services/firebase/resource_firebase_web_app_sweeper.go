@@ -88,7 +88,7 @@ func testSweepFirebaseWebApp(region string) error {
 		}
 
 		// Skip resources that shouldn't be sweeped
-		if !sweeper.IsSweepableTestResource(obj["displayName"].(string)) {
+		if !sweeper.IsSweepableResource(obj["displayName"].(string), obj) {
 			nonPrefixCount++
 			continue
 		}
@@ -100,7 +100,7 @@ func testSweepFirebaseWebApp(region string) error {
 		body["immediate"] = true
 
 		// Don't wait on operations as we may have a lot to delete
-		_, err = transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		_, err = sweeper.SendDeleteRequest(transport_tpg.SendRequestOptions{
 			Config:    config,
 			Method:    "POST",
 			Project:   config.Project,
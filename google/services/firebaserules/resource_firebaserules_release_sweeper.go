@@ -69,5 +69,5 @@ func testSweepFirebaserulesRelease(region string) error {
 }
 
 func isDeletableFirebaserulesRelease(r *firebaserules.Release) bool {
-	return sweeper.IsSweepableTestResource(*r.Name)
+	return sweeper.IsSweepableDCLResource(*r.Name, sweeper.ResourceObject(r))
 }
@@ -69,5 +69,5 @@ func testSweepFirebaserulesRuleset(region string) error {
 }
 
 func isDeletableFirebaserulesRuleset(r *firebaserules.Ruleset) bool {
-	return sweeper.IsSweepableTestResource(*r.Name)
+	return sweeper.IsSweepableDCLResource(*r.Name, sweeper.ResourceObject(r))
 }
@@ -34,12 +34,20 @@ type FirestoreOperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *FirestoreOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
+func (w *FirestoreOperationWaiter) OperationURL() string {
+	return fmt.Sprintf("%s%s", w.Config.FirestoreBasePath, w.CommonOperationWaiter.Op.Name)
+}
+
 func (w *FirestoreOperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
 	}
 	// Returns the proper get.
-	url := fmt.Sprintf("%s%s", w.Config.FirestoreBasePath, w.CommonOperationWaiter.Op.Name)
+	url := w.OperationURL()
 
 	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
 		Config:    w.Config,
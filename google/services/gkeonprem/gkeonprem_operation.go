@@ -51,6 +51,14 @@ type gkeonpremOperationWaiter struct {
 	Op        tpgresource.CommonOperation
 }
 
+func (w *gkeonpremOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
+func (w *gkeonpremOperationWaiter) OperationURL() string {
+	return fmt.Sprintf("%s%s", w.Config.GkeonpremBasePath, w.Op.Name)
+}
+
 func (w *gkeonpremOperationWaiter) State() string {
 	if w == nil {
 		return fmt.Sprintf("Operation is nil!")
@@ -98,7 +106,7 @@ func (w *gkeonpremOperationWaiter) QueryOp() (interface{}, error) {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
 	}
 	// Returns the proper get.
-	url := fmt.Sprintf("%s%s", w.Config.GkeonpremBasePath, w.Op.Name)
+	url := w.OperationURL()
 
 	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
 		Config:    w.Config,
@@ -43,7 +43,7 @@ func TestAccGkeonpremBareMetalAdminCluster_gkeonpremBareMetalAdminClusterBasicEx
 				ResourceName:            "google_gkeonprem_bare_metal_admin_cluster.admin-cluster-basic",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "location", "name"},
+				ImportStateVerifyIgnore: []string{"annotations", "location", "name", "terraform_annotations"},
 			},
 		},
 	})
@@ -130,7 +130,7 @@ func TestAccGkeonpremBareMetalAdminCluster_gkeonpremBareMetalAdminClusterFullExa
 				ResourceName:            "google_gkeonprem_bare_metal_admin_cluster.admin-cluster-basic",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "location", "name"},
+				ImportStateVerifyIgnore: []string{"annotations", "location", "name", "terraform_annotations"},
 			},
 		},
 	})
@@ -28,7 +28,7 @@ func TestAccGkeonpremBareMetalCluster_bareMetalClusterUpdateBasic(t *testing.T)
 				ResourceName:            "google_gkeonprem_bare_metal_cluster.cluster-metallb",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations"},
+				ImportStateVerifyIgnore: []string{"annotations", "terraform_annotations"},
 			},
 			{
 				Config: testAccGkeonpremBareMetalCluster_bareMetalClusterUpdateMetalLb(context),
@@ -37,7 +37,7 @@ func TestAccGkeonpremBareMetalCluster_bareMetalClusterUpdateBasic(t *testing.T)
 				ResourceName:            "google_gkeonprem_bare_metal_cluster.cluster-metallb",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations"},
+				ImportStateVerifyIgnore: []string{"annotations", "terraform_annotations"},
 			},
 		},
 	})
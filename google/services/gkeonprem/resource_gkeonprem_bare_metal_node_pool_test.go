@@ -28,7 +28,7 @@ func TestAccGkeonpremBareMetalNodePool_bareMetalNodePoolUpdate(t *testing.T) {
 				ResourceName:            "google_gkeonprem_bare_metal_node_pool.nodepool",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations"},
+				ImportStateVerifyIgnore: []string{"annotations", "terraform_annotations"},
 			},
 			{
 				Config: testAccGkeonpremBareMetalNodePool_bareMetalNodePoolUpdate(context),
@@ -37,7 +37,7 @@ func TestAccGkeonpremBareMetalNodePool_bareMetalNodePoolUpdate(t *testing.T) {
 				ResourceName:            "google_gkeonprem_bare_metal_node_pool.nodepool",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations"},
+				ImportStateVerifyIgnore: []string{"annotations", "terraform_annotations"},
 			},
 		},
 	})
@@ -28,7 +28,7 @@ func TestAccGkeonpremVmwareCluster_vmwareClusterUpdateBasic(t *testing.T) {
 				ResourceName:            "google_gkeonprem_vmware_cluster.cluster",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations"},
+				ImportStateVerifyIgnore: []string{"annotations", "terraform_annotations"},
 			},
 			{
 				Config: testAccGkeonpremVmwareCluster_vmwareClusterUpdateMetalLb(context),
@@ -37,7 +37,7 @@ func TestAccGkeonpremVmwareCluster_vmwareClusterUpdateBasic(t *testing.T) {
 				ResourceName:            "google_gkeonprem_vmware_cluster.cluster",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations"},
+				ImportStateVerifyIgnore: []string{"annotations", "terraform_annotations"},
 			},
 		},
 	})
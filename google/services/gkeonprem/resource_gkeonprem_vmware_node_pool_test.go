@@ -28,7 +28,7 @@ func TestAccGkeonpremVmwareNodePool_vmwareNodePoolUpdate(t *testing.T) {
 				ResourceName:            "google_gkeonprem_vmware_node_pool.nodepool",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations"},
+				ImportStateVerifyIgnore: []string{"annotations", "terraform_annotations"},
 			},
 			{
 				Config: testAccGkeonpremVmwareNodePool_vmwareNodePoolUpdate(context),
@@ -37,7 +37,7 @@ func TestAccGkeonpremVmwareNodePool_vmwareNodePoolUpdate(t *testing.T) {
 				ResourceName:            "google_gkeonprem_vmware_node_pool.nodepool",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations"},
+				ImportStateVerifyIgnore: []string{"annotations", "terraform_annotations"},
 			},
 		},
 	})
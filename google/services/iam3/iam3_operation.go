@@ -34,12 +34,20 @@ type IAM3OperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *IAM3OperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
+func (w *IAM3OperationWaiter) OperationURL() string {
+	return fmt.Sprintf("%s%s", w.Config.IAM3BasePath, w.CommonOperationWaiter.Op.Name)
+}
+
 func (w *IAM3OperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
 	}
 	// Returns the proper get.
-	url := fmt.Sprintf("%s%s", w.Config.IAM3BasePath, w.CommonOperationWaiter.Op.Name)
+	url := w.OperationURL()
 
 	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
 		Config:    w.Config,
@@ -54,7 +54,7 @@ func TestAccIAM3FoldersPolicyBinding_iamFoldersPolicyBindingExample(t *testing.T
 				ResourceName:            "google_iam_folders_policy_binding.my-folder-binding",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "folder", "location", "policy_binding_id"},
+				ImportStateVerifyIgnore: []string{"annotations", "folder", "location", "policy_binding_id", "terraform_annotations"},
 			},
 		},
 	})
@@ -34,7 +34,7 @@ func TestAccIAM3FoldersPolicyBinding_iamFoldersPolicyBindingExample_update(t *te
 				ResourceName:            "google_iam_folders_policy_binding.my-folder-binding",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "folder", "location", "policy_binding_id"},
+				ImportStateVerifyIgnore: []string{"annotations", "folder", "location", "policy_binding_id", "terraform_annotations"},
 			},
 			{
 				Config: testAccIAM3FoldersPolicyBinding_iamFoldersPolicyBindingExample_update(context),
@@ -43,7 +43,7 @@ func TestAccIAM3FoldersPolicyBinding_iamFoldersPolicyBindingExample_update(t *te
 				ResourceName:            "google_iam_folders_policy_binding.my-folder-binding",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "folder", "location", "policy_binding_id"},
+				ImportStateVerifyIgnore: []string{"annotations", "folder", "location", "policy_binding_id", "terraform_annotations"},
 			},
 		},
 	})
@@ -51,7 +51,7 @@ func TestAccIAM3OrganizationsPolicyBinding_iamOrganizationsPolicyBindingExample(
 				ResourceName:            "google_iam_organizations_policy_binding.my-org-binding",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "location", "organization", "policy_binding_id"},
+				ImportStateVerifyIgnore: []string{"annotations", "location", "organization", "policy_binding_id", "terraform_annotations"},
 			},
 		},
 	})
@@ -31,7 +31,7 @@ func TestAccIAM3OrganizationsPolicyBinding_iam3OrganizationsPolicyBindingExample
 				ResourceName:            "google_iam_organizations_policy_binding.my_org_binding",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "location", "organization", "policy_binding_id"},
+				ImportStateVerifyIgnore: []string{"annotations", "location", "organization", "policy_binding_id", "terraform_annotations"},
 			},
 
 			{
@@ -41,7 +41,7 @@ func TestAccIAM3OrganizationsPolicyBinding_iam3OrganizationsPolicyBindingExample
 				ResourceName:            "google_iam_organizations_policy_binding.my_org_binding",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "location", "organization", "policy_binding_id"},
+				ImportStateVerifyIgnore: []string{"annotations", "location", "organization", "policy_binding_id", "terraform_annotations"},
 			},
 		},
 	})
@@ -51,7 +51,7 @@ func TestAccIAM3ProjectsPolicyBinding_iamProjectsPolicyBindingExample(t *testing
 				ResourceName:            "google_iam_projects_policy_binding.my-project-binding",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "location", "policy_binding_id"},
+				ImportStateVerifyIgnore: []string{"annotations", "location", "policy_binding_id", "terraform_annotations"},
 			},
 		},
 	})
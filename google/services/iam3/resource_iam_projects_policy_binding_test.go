@@ -31,7 +31,7 @@ func TestAccIAM3ProjectsPolicyBinding_iamProjectsPolicyBindingExample_update(t *
 				ResourceName:            "google_iam_projects_policy_binding.my-project-binding",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "location", "policy_binding_id"},
+				ImportStateVerifyIgnore: []string{"annotations", "location", "policy_binding_id", "terraform_annotations"},
 			},
 			{
 				Config: testAccIAM3ProjectsPolicyBinding_iamProjectsPolicyBindingExample_update(context),
@@ -40,7 +40,7 @@ func TestAccIAM3ProjectsPolicyBinding_iamProjectsPolicyBindingExample_update(t *
 				ResourceName:            "google_iam_projects_policy_binding.my-project-binding",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "location", "policy_binding_id"},
+				ImportStateVerifyIgnore: []string{"annotations", "location", "policy_binding_id", "terraform_annotations"},
 			},
 			{
 				Config: testAccIAM3ProjectsPolicyBinding_iamProjectsPolicyBindingExample_full(context),
@@ -49,7 +49,7 @@ func TestAccIAM3ProjectsPolicyBinding_iamProjectsPolicyBindingExample_update(t *
 				ResourceName:            "google_iam_projects_policy_binding.my-project-binding",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "location", "policy_binding_id"},
+				ImportStateVerifyIgnore: []string{"annotations", "location", "policy_binding_id", "terraform_annotations"},
 			},
 		},
 	})
@@ -33,12 +33,20 @@ type IAMWorkforcePoolOperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *IAMWorkforcePoolOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
+func (w *IAMWorkforcePoolOperationWaiter) OperationURL() string {
+	return fmt.Sprintf("%s%s", w.Config.IAMWorkforcePoolBasePath, w.CommonOperationWaiter.Op.Name)
+}
+
 func (w *IAMWorkforcePoolOperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
 	}
 	// Returns the proper get.
-	url := fmt.Sprintf("%s%s", w.Config.IAMWorkforcePoolBasePath, w.CommonOperationWaiter.Op.Name)
+	url := w.OperationURL()
 
 	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
 		Config:    w.Config,
@@ -91,15 +91,9 @@ func dataSourceGoogleKmsKeyRingsRead(d *schema.ResourceData, meta interface{}) e
 		billingProject = bp
 	}
 
-	var keyRings []interface{}
-
-	params := make(map[string]string)
-	if filter, ok := d.GetOk("filter"); ok {
-		log.Printf("[DEBUG] Search for key rings using filter ?filter=%s", filter.(string))
-		params["filter"] = filter.(string)
-		if err != nil {
-			return err
-		}
+	filter := d.Get("filter").(string)
+	if filter != "" {
+		log.Printf("[DEBUG] Search for key rings using filter ?filter=%s", filter)
 	}
 
 	url, err := tpgresource.ReplaceVars(d, config, "{{KMSBasePath}}projects/{{project}}/locations/{{location}}/keyRings")
@@ -107,39 +101,22 @@ func dataSourceGoogleKmsKeyRingsRead(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	for {
-		url, err = transport_tpg.AddQueryParams(url, params)
-		if err != nil {
-			return err
-		}
-
-		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
-			Config:               config,
-			Method:               "GET",
-			Project:              billingProject,
-			RawURL:               url,
-			UserAgent:            userAgent,
-			ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{transport_tpg.Is429RetryableQuotaError},
-		})
-		if err != nil {
-			return fmt.Errorf("Error retrieving buckets: %s", err)
-		}
-
-		if res["keyRings"] == nil {
-			break
-		}
-		pageKeyRings, err := flattenKMSKeyRingsList(config, res["keyRings"])
-		if err != nil {
-			return fmt.Errorf("error flattening key rings list: %s", err)
-		}
-		keyRings = append(keyRings, pageKeyRings...)
+	items, err := transport_tpg.ListAll(transport_tpg.ListRequestOptions{
+		Config:               config,
+		Project:              billingProject,
+		RawURL:               url,
+		UserAgent:            userAgent,
+		ItemsField:           "keyRings",
+		Filter:               filter,
+		ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{transport_tpg.Is429RetryableQuotaError},
+	})
+	if err != nil {
+		return fmt.Errorf("Error retrieving key rings: %s", err)
+	}
 
-		pToken, ok := res["nextPageToken"]
-		if ok && pToken != nil && pToken.(string) != "" {
-			params["pageToken"] = pToken.(string)
-		} else {
-			break
-		}
+	keyRings, err := flattenKMSKeyRingsList(config, items)
+	if err != nil {
+		return fmt.Errorf("error flattening key rings list: %s", err)
 	}
 
 	log.Printf("[DEBUG] Found %d key rings", len(keyRings))
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package kms
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+	"google.golang.org/api/cloudkms/v1"
+)
+
+var _ ephemeral.EphemeralResource = &googleEphemeralKmsSecret{}
+
+func GoogleEphemeralKmsSecret() ephemeral.EphemeralResource {
+	return &googleEphemeralKmsSecret{}
+}
+
+type googleEphemeralKmsSecret struct {
+	providerConfig *transport_tpg.Config
+}
+
+func (p *googleEphemeralKmsSecret) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kms_secret"
+}
+
+type ephemeralKmsSecretModel struct {
+	CryptoKey                   types.String `tfsdk:"crypto_key"`
+	Ciphertext                  types.String `tfsdk:"ciphertext"`
+	AdditionalAuthenticatedData types.String `tfsdk:"additional_authenticated_data"`
+	Plaintext                   types.String `tfsdk:"plaintext"`
+}
+
+func (p *googleEphemeralKmsSecret) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Decrypt a ciphertext encrypted with a Cloud KMS crypto key without storing the plaintext in the plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"crypto_key": schema.StringAttribute{
+				Description: "The id of the CryptoKey that will be used to decrypt the provided ciphertext, in the format `{projectId}/{location}/{keyRingName}/{cryptoKeyName}` or `{location}/{keyRingName}/{cryptoKeyName}`.",
+				Required:    true,
+			},
+			"ciphertext": schema.StringAttribute{
+				Description: "The ciphertext to be decrypted, encoded in base64.",
+				Required:    true,
+			},
+			"additional_authenticated_data": schema.StringAttribute{
+				Description: "The additional authenticated data used for integrity checks during encryption and decryption, encoded in base64.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"plaintext": schema.StringAttribute{
+				Description: "The decrypted plaintext.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (p *googleEphemeralKmsSecret) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*transport_tpg.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *transport_tpg.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	p.providerConfig = pd
+}
+
+func (p *googleEphemeralKmsSecret) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ephemeralKmsSecretModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cryptoKeyId, err := ParseKmsCryptoKeyId(data.CryptoKey.ValueString(), p.providerConfig)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid crypto_key", err.Error())
+		return
+	}
+
+	kmsDecryptRequest := &cloudkms.DecryptRequest{
+		Ciphertext:                  data.Ciphertext.ValueString(),
+		AdditionalAuthenticatedData: data.AdditionalAuthenticatedData.ValueString(),
+	}
+
+	decryptResponse, err := p.providerConfig.NewKmsClient(p.providerConfig.UserAgent).Projects.Locations.KeyRings.CryptoKeys.Decrypt(cryptoKeyId.CryptoKeyId(), kmsDecryptRequest).Do()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error decrypting ciphertext",
+			fmt.Sprintf("Error decrypting ciphertext with %s: %s", cryptoKeyId.CryptoKeyId(), err),
+		)
+		return
+	}
+
+	plaintext, err := base64.StdEncoding.DecodeString(decryptResponse.Plaintext)
+	if err != nil {
+		resp.Diagnostics.AddError("Error decoding base64 response", err.Error())
+		return
+	}
+
+	data.Plaintext = types.StringValue(string(plaintext))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccEphemeralKmsSecret_basic(t *testing.T) {
+	t.Parallel()
+
+	kms := acctest.BootstrapKMSKey(t)
+	plaintext := fmt.Sprintf("secret-%s", acctest.RandString(t, 10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemeralKmsSecret_setup(kms.CryptoKey.Name, plaintext),
+			},
+			{
+				Config: testAccEphemeralKmsSecret_basic(kms.CryptoKey.Name, plaintext),
+			},
+		},
+	})
+}
+
+func testAccEphemeralKmsSecret_setup(cryptoKey, plaintext string) string {
+	return fmt.Sprintf(`
+resource "google_kms_secret_ciphertext" "ciphertext" {
+  crypto_key = "%s"
+  plaintext  = "%s"
+}
+`, cryptoKey, plaintext)
+}
+
+func testAccEphemeralKmsSecret_basic(cryptoKey, plaintext string) string {
+	return fmt.Sprintf(`
+%s
+
+ephemeral "google_kms_secret" "secret" {
+  crypto_key = google_kms_secret_ciphertext.ciphertext.crypto_key
+  ciphertext = google_kms_secret_ciphertext.ciphertext.ciphertext
+}
+`, testAccEphemeralKmsSecret_setup(cryptoKey, plaintext))
+}
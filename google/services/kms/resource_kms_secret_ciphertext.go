@@ -26,7 +26,9 @@ import (
 	"regexp"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
@@ -36,6 +38,7 @@ func ResourceKMSSecretCiphertext() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceKMSSecretCiphertextCreate,
 		Read:   resourceKMSSecretCiphertextRead,
+		Update: resourceKMSSecretCiphertextUpdate,
 		Delete: resourceKMSSecretCiphertextDelete,
 
 		Timeouts: &schema.ResourceTimeout{
@@ -43,6 +46,10 @@ func ResourceKMSSecretCiphertext() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(cty.GetAttrPath("plaintext"), cty.GetAttrPath("plaintext_wo")),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"crypto_key": {
 				Type:     schema.TypeString,
@@ -52,11 +59,30 @@ func ResourceKMSSecretCiphertext() *schema.Resource {
 Format: ''projects/{{project}}/locations/{{location}}/keyRings/{{keyRing}}/cryptoKeys/{{cryptoKey}}''`,
 			},
 			"plaintext": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: `The plaintext to be encrypted.`,
-				Sensitive:   true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  `The plaintext to be encrypted.`,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"plaintext", "plaintext_wo"},
+			},
+			"plaintext_wo": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: `The plaintext to be encrypted, which is not stored in the Terraform state. It is only encrypted again
+when 'plaintext_wo_version' changes. Requires Terraform 1.11 or later.`,
+				Sensitive:    true,
+				WriteOnly:    true,
+				ExactlyOneOf: []string{"plaintext", "plaintext_wo"},
+				RequiredWith: []string{"plaintext_wo_version"},
+			},
+			"plaintext_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  `Triggers the encryption of the value of 'plaintext_wo' when changed.`,
+				RequiredWith: []string{"plaintext_wo"},
 			},
 			"additional_authenticated_data": {
 				Type:        schema.TypeString,
@@ -83,7 +109,14 @@ func resourceKMSSecretCiphertextCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	obj := make(map[string]interface{})
-	plaintextProp, err := expandKMSSecretCiphertextPlaintext(d.Get("plaintext"), d, config)
+	plaintext := d.Get("plaintext")
+	if plaintext == "" {
+		plaintext, err = tpgresource.GetRawConfigAttributeAsString(d, "plaintext_wo")
+		if err != nil {
+			return err
+		}
+	}
+	plaintextProp, err := expandKMSSecretCiphertextPlaintext(plaintext, d, config)
 	if err != nil {
 		return err
 	} else if v, ok := d.GetOkExists("plaintext"); !tpgresource.IsEmptyValue(reflect.ValueOf(plaintextProp)) && (ok || !reflect.DeepEqual(v, plaintextProp)) {
@@ -206,6 +239,12 @@ func resourceKMSSecretCiphertextRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+// plaintext_wo can't force a new resource as it isn't in the plan, so changes
+// to it alone are ignored; plaintext_wo_version triggers a new encryption.
+func resourceKMSSecretCiphertextUpdate(d *schema.ResourceData, meta interface{}) error {
+	return resourceKMSSecretCiphertextRead(d, meta)
+}
+
 func resourceKMSSecretCiphertextDelete(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[WARNING] KMS SecretCiphertext resources"+
 		" cannot be deleted from Google Cloud. The resource %s will be removed from Terraform"+
@@ -33,12 +33,20 @@ type LoggingOperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *LoggingOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
+func (w *LoggingOperationWaiter) OperationURL() string {
+	return fmt.Sprintf("%s%s", w.Config.LoggingBasePath, w.CommonOperationWaiter.Op.Name)
+}
+
 func (w *LoggingOperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
 	}
 	// Returns the proper get.
-	url := fmt.Sprintf("%s%s", w.Config.LoggingBasePath, w.CommonOperationWaiter.Op.Name)
+	url := w.OperationURL()
 
 	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
 		Config:    w.Config,
@@ -34,12 +34,20 @@ type ManagedKafkaOperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *ManagedKafkaOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
+func (w *ManagedKafkaOperationWaiter) OperationURL() string {
+	return fmt.Sprintf("%s%s", w.Config.ManagedKafkaBasePath, w.CommonOperationWaiter.Op.Name)
+}
+
 func (w *ManagedKafkaOperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
 	}
 	// Returns the proper get.
-	url := fmt.Sprintf("%s%s", w.Config.ManagedKafkaBasePath, w.CommonOperationWaiter.Op.Name)
+	url := w.OperationURL()
 
 	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
 		Config:    w.Config,
@@ -34,12 +34,20 @@ type MemorystoreOperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *MemorystoreOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
+func (w *MemorystoreOperationWaiter) OperationURL() string {
+	return fmt.Sprintf("%s%s", w.Config.MemorystoreBasePath, w.CommonOperationWaiter.Op.Name)
+}
+
 func (w *MemorystoreOperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
 	}
 	// Returns the proper get.
-	url := fmt.Sprintf("%s%s", w.Config.MemorystoreBasePath, w.CommonOperationWaiter.Op.Name)
+	url := w.OperationURL()
 
 	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
 		Config:    w.Config,
@@ -34,12 +34,20 @@ type MigrationCenterOperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *MigrationCenterOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
+func (w *MigrationCenterOperationWaiter) OperationURL() string {
+	return fmt.Sprintf("%s%s", w.Config.MigrationCenterBasePath, w.CommonOperationWaiter.Op.Name)
+}
+
 func (w *MigrationCenterOperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
 	}
 	// Returns the proper get.
-	url := fmt.Sprintf("%s%s", w.Config.MigrationCenterBasePath, w.CommonOperationWaiter.Op.Name)
+	url := w.OperationURL()
 
 	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
 		Config:    w.Config,
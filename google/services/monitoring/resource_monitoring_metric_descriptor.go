@@ -305,7 +305,7 @@ func resourceMonitoringMetricDescriptorCreate(d *schema.ResourceData, meta inter
 	}
 	d.SetId(id)
 
-	err = transport_tpg.PollingWaitTime(resourceMonitoringMetricDescriptorPollRead(d, meta), transport_tpg.PollCheckForExistence, "Creating MetricDescriptor", d.Timeout(schema.TimeoutCreate), 20)
+	err = transport_tpg.PollingWaitTimeContext(config.TraceContext(), resourceMonitoringMetricDescriptorPollRead(d, meta), transport_tpg.PollCheckForExistence, "Creating MetricDescriptor", d.Timeout(schema.TimeoutCreate), 20)
 	if err != nil {
 		return fmt.Errorf("Error waiting to create MetricDescriptor: %s", err)
 	}
@@ -534,7 +534,7 @@ func resourceMonitoringMetricDescriptorUpdate(d *schema.ResourceData, meta inter
 		log.Printf("[DEBUG] Finished updating MetricDescriptor %q: %#v", d.Id(), res)
 	}
 
-	err = transport_tpg.PollingWaitTime(resourceMonitoringMetricDescriptorPollRead(d, meta), transport_tpg.PollCheckForExistence, "Updating MetricDescriptor", d.Timeout(schema.TimeoutUpdate), 20)
+	err = transport_tpg.PollingWaitTimeContext(config.TraceContext(), resourceMonitoringMetricDescriptorPollRead(d, meta), transport_tpg.PollCheckForExistence, "Updating MetricDescriptor", d.Timeout(schema.TimeoutUpdate), 20)
 	if err != nil {
 		return err
 	}
@@ -587,7 +587,7 @@ func resourceMonitoringMetricDescriptorDelete(d *schema.ResourceData, meta inter
 		return transport_tpg.HandleNotFoundError(err, d, "MetricDescriptor")
 	}
 
-	err = transport_tpg.PollingWaitTime(resourceMonitoringMetricDescriptorPollRead(d, meta), transport_tpg.PollCheckForAbsence, "Deleting MetricDescriptor", d.Timeout(schema.TimeoutCreate), 20)
+	err = transport_tpg.PollingWaitTimeContext(config.TraceContext(), resourceMonitoringMetricDescriptorPollRead(d, meta), transport_tpg.PollCheckForAbsence, "Deleting MetricDescriptor", d.Timeout(schema.TimeoutCreate), 20)
 	if err != nil {
 		return fmt.Errorf("Error waiting to delete MetricDescriptor: %s", err)
 	}
@@ -34,12 +34,20 @@ type NetworkConnectivityOperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *NetworkConnectivityOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
+func (w *NetworkConnectivityOperationWaiter) OperationURL() string {
+	return fmt.Sprintf("%s%s", w.Config.NetworkConnectivityBasePath, w.CommonOperationWaiter.Op.Name)
+}
+
 func (w *NetworkConnectivityOperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
 	}
 	// Returns the proper get.
-	url := fmt.Sprintf("%s%s", w.Config.NetworkConnectivityBasePath, w.CommonOperationWaiter.Op.Name)
+	url := w.OperationURL()
 
 	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
 		Config:    w.Config,
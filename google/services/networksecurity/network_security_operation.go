@@ -34,12 +34,20 @@ type NetworkSecurityOperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *NetworkSecurityOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
+func (w *NetworkSecurityOperationWaiter) OperationURL() string {
+	return fmt.Sprintf("%s%s", w.Config.NetworkSecurityBasePath, w.CommonOperationWaiter.Op.Name)
+}
+
 func (w *NetworkSecurityOperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
 	}
 	// Returns the proper get.
-	url := fmt.Sprintf("%s%s", w.Config.NetworkSecurityBasePath, w.CommonOperationWaiter.Op.Name)
+	url := w.OperationURL()
 
 	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
 		Config:    w.Config,
@@ -34,12 +34,20 @@ type NetworkServicesOperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *NetworkServicesOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
+func (w *NetworkServicesOperationWaiter) OperationURL() string {
+	return fmt.Sprintf("%s%s", w.Config.NetworkServicesBasePath, w.CommonOperationWaiter.Op.Name)
+}
+
 func (w *NetworkServicesOperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
 	}
 	// Returns the proper get.
-	url := fmt.Sprintf("%s%s", w.Config.NetworkServicesBasePath, w.CommonOperationWaiter.Op.Name)
+	url := w.OperationURL()
 
 	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
 		Config:    w.Config,
@@ -19,6 +19,10 @@ type OSConfigOperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *OSConfigOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
 func (w *OSConfigOperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
@@ -34,12 +34,20 @@ type PrivatecaOperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *PrivatecaOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
+func (w *PrivatecaOperationWaiter) OperationURL() string {
+	return fmt.Sprintf("%s%s", w.Config.PrivatecaBasePath, w.CommonOperationWaiter.Op.Name)
+}
+
 func (w *PrivatecaOperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
 	}
 	// Returns the proper get.
-	url := fmt.Sprintf("%s%s", w.Config.PrivatecaBasePath, w.CommonOperationWaiter.Op.Name)
+	url := w.OperationURL()
 
 	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
 		Config:    w.Config,
@@ -103,7 +103,7 @@ func testSweepCertificateAuthority(region string) error {
 			// Increment count and skip if resource is not sweepable.
 			nameParts := strings.Split(caName, "/")
 			id := nameParts[len(nameParts)-1]
-			if !sweeper.IsSweepableTestResource(id) {
+			if !sweeper.IsSweepableResource(id, obj) {
 				nonPrefixCount++
 				continue
 			}
@@ -129,7 +129,7 @@ func testSweepCertificateAuthority(region string) error {
 			}
 
 			deleteUrl := config.PrivatecaBasePath + caName
-			_, err = transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			_, err = sweeper.SendDeleteRequest(transport_tpg.SendRequestOptions{
 				Config:    config,
 				Method:    "DELETE",
 				Project:   config.Project,
@@ -69,5 +69,5 @@ func testSweepRecaptchaEnterpriseKey(region string) error {
 }
 
 func isDeletableRecaptchaEnterpriseKey(r *recaptchaenterprise.Key) bool {
-	return sweeper.IsSweepableTestResource(*r.Name)
+	return sweeper.IsSweepableDCLResource(*r.Name, sweeper.ResourceObject(r))
 }
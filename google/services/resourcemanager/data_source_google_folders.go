@@ -69,40 +69,24 @@ func dataSourceGoogleFoldersRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	params := make(map[string]string)
-	folders := make([]map[string]interface{}, 0)
-
-	for {
-		params["parent"] = d.Get("parent_id").(string)
-		url := "https://cloudresourcemanager.googleapis.com/v3/folders"
-
-		url, err := transport_tpg.AddQueryParams(url, params)
-		if err != nil {
-			return err
-		}
-
-		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
-			Config:    config,
-			Method:    "GET",
-			RawURL:    url,
-			UserAgent: userAgent,
-		})
-		if err != nil {
-			return fmt.Errorf("Error retrieving folders: %s", err)
-		}
-
-		pageFolders := flattenDataSourceGoogleFoldersList(res["folders"])
-		folders = append(folders, pageFolders...)
+	url, err := transport_tpg.AddQueryParams("https://cloudresourcemanager.googleapis.com/v3/folders", map[string]string{
+		"parent": d.Get("parent_id").(string),
+	})
+	if err != nil {
+		return err
+	}
 
-		pToken, ok := res["nextPageToken"]
-		if ok && pToken != nil && pToken.(string) != "" {
-			params["pageToken"] = pToken.(string)
-		} else {
-			break
-		}
+	items, err := transport_tpg.ListAll(transport_tpg.ListRequestOptions{
+		Config:     config,
+		RawURL:     url,
+		UserAgent:  userAgent,
+		ItemsField: "folders",
+	})
+	if err != nil {
+		return fmt.Errorf("Error retrieving folders: %s", err)
 	}
 
-	if err := d.Set("folders", folders); err != nil {
+	if err := d.Set("folders", flattenDataSourceGoogleFoldersList(items)); err != nil {
 		return fmt.Errorf("Error retrieving folders: %s", err)
 	}
 
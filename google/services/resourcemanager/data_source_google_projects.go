@@ -72,40 +72,18 @@ func datasourceGoogleProjectsRead(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	params := make(map[string]string)
-	projects := make([]map[string]interface{}, 0)
-
-	for {
-		params["filter"] = d.Get("filter").(string)
-		url := "https://cloudresourcemanager.googleapis.com/v1/projects"
-
-		url, err := transport_tpg.AddQueryParams(url, params)
-		if err != nil {
-			return err
-		}
-
-		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
-			Config:    config,
-			Method:    "GET",
-			RawURL:    url,
-			UserAgent: userAgent,
-		})
-		if err != nil {
-			return fmt.Errorf("Error retrieving projects: %s", err)
-		}
-
-		pageProjects := flattenDatasourceGoogleProjectsList(res["projects"])
-		projects = append(projects, pageProjects...)
-
-		pToken, ok := res["nextPageToken"]
-		if ok && pToken != nil && pToken.(string) != "" {
-			params["pageToken"] = pToken.(string)
-		} else {
-			break
-		}
+	items, err := transport_tpg.ListAll(transport_tpg.ListRequestOptions{
+		Config:     config,
+		RawURL:     "https://cloudresourcemanager.googleapis.com/v1/projects",
+		UserAgent:  userAgent,
+		ItemsField: "projects",
+		Filter:     d.Get("filter").(string),
+	})
+	if err != nil {
+		return fmt.Errorf("Error retrieving projects: %s", err)
 	}
 
-	if err := d.Set("projects", projects); err != nil {
+	if err := d.Set("projects", flattenDatasourceGoogleProjectsList(items)); err != nil {
 		return fmt.Errorf("Error retrieving projects: %s", err)
 	}
 
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package resourcemanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+var _ ephemeral.EphemeralResource = &googleEphemeralClientConfig{}
+
+func GoogleEphemeralClientConfig() ephemeral.EphemeralResource {
+	return &googleEphemeralClientConfig{}
+}
+
+type googleEphemeralClientConfig struct {
+	providerConfig *transport_tpg.Config
+}
+
+func (p *googleEphemeralClientConfig) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_client_config"
+}
+
+type ephemeralClientConfigModel struct {
+	Project     types.String `tfsdk:"project"`
+	Region      types.String `tfsdk:"region"`
+	Zone        types.String `tfsdk:"zone"`
+	AccessToken types.String `tfsdk:"access_token"`
+}
+
+func (p *googleEphemeralClientConfig) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Get the configuration of the Google Cloud provider, including an access token that is not stored in the plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Description: "The ID of the project to apply any resources to.",
+				Computed:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "The region to operate under.",
+				Computed:    true,
+			},
+			"zone": schema.StringAttribute{
+				Description: "The zone to operate under.",
+				Computed:    true,
+			},
+			"access_token": schema.StringAttribute{
+				Description: "The OAuth2 access token used by the client to authenticate against the Google Cloud API.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (p *googleEphemeralClientConfig) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*transport_tpg.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *transport_tpg.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	p.providerConfig = pd
+}
+
+func (p *googleEphemeralClientConfig) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ephemeralClientConfigModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := p.providerConfig.TokenSource.Token()
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting access_token", err.Error())
+		return
+	}
+
+	data.Project = types.StringValue(p.providerConfig.Project)
+	data.Region = types.StringValue(p.providerConfig.Region)
+	data.Zone = types.StringValue(p.providerConfig.Zone)
+	data.AccessToken = types.StringValue(token.AccessToken)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
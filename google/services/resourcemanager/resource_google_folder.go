@@ -102,6 +102,7 @@ func resourceGoogleFolderCreate(d *schema.ResourceData, meta interface{}) error
 	if _, ok := d.GetOk("tags"); ok {
 		folder.Tags = tpgresource.ExpandStringMap(d, "tags")
 	}
+	folder.Tags = tpgresource.MergeDefaultResourceTags(config, folder.Tags)
 
 	var op *resourceManagerV3.Operation
 	err = transport_tpg.Retry(transport_tpg.RetryOptions{
@@ -179,6 +179,7 @@ func resourceGoogleProjectCreate(d *schema.ResourceData, meta interface{}) error
 	if _, ok := d.GetOk("tags"); ok {
 		project.Tags = tpgresource.ExpandStringMap(d, "tags")
 	}
+	project.Tags = tpgresource.MergeDefaultResourceTags(config, project.Tags)
 
 	var op *cloudresourcemanager.Operation
 	err = transport_tpg.Retry(transport_tpg.RetryOptions{
@@ -60,8 +60,15 @@ func testSweepProject(region string) error {
 		}
 
 		for _, project := range found.Projects {
+			if !sweeper.IsSweepableResource(project.ProjectId, sweeper.ResourceObject(project)) {
+				log.Printf("[INFO][SWEEPER_LOG] Skipping Project id: %s", project.ProjectId)
+				continue
+			}
 			log.Printf("[INFO][SWEEPER_LOG] Sweeping Project id: %s", project.ProjectId)
-			_, err := config.NewResourceManagerClient(config.UserAgent).Projects.Delete(project.ProjectId).Do()
+			err := sweeper.DeleteResource(project.ProjectId, "projects/"+project.ProjectId, func() error {
+				_, err := config.NewResourceManagerClient(config.UserAgent).Projects.Delete(project.ProjectId).Do()
+				return err
+			})
 			if err != nil {
 				log.Printf("[INFO][SWEEPER_LOG] Error, failed to delete project %s: %s", project.Name, err)
 				continue
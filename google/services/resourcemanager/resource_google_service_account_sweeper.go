@@ -64,14 +64,14 @@ func testSweepServiceAccount(region string) error {
 
 		id := tpgresource.GetResourceNameFromSelfLink(obj["name"].(string))
 		// Increment count and skip if resource is not sweepable.
-		if !sweeper.IsSweepableTestResource(id) {
+		if !sweeper.IsSweepableResource(id, obj) {
 			nonPrefixCount++
 			continue
 		}
 
 		deleteUrl := servicesUrl + "/" + id
 		// Don't wait on operations as we may have a lot to delete
-		_, err = transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		_, err = sweeper.SendDeleteRequest(transport_tpg.SendRequestOptions{
 			Config:    config,
 			Method:    "DELETE",
 			Project:   config.Project,
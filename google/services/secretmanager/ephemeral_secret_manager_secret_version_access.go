@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package secretmanager
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-google/google/fwresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+var _ ephemeral.EphemeralResource = &googleEphemeralSecretManagerSecretVersionAccess{}
+
+func GoogleEphemeralSecretManagerSecretVersionAccess() ephemeral.EphemeralResource {
+	return &googleEphemeralSecretManagerSecretVersionAccess{}
+}
+
+type googleEphemeralSecretManagerSecretVersionAccess struct {
+	providerConfig *transport_tpg.Config
+}
+
+func (p *googleEphemeralSecretManagerSecretVersionAccess) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret_manager_secret_version_access"
+}
+
+type ephemeralSecretManagerSecretVersionAccessModel struct {
+	Project            types.String `tfsdk:"project"`
+	Secret             types.String `tfsdk:"secret"`
+	Version            types.String `tfsdk:"version"`
+	Name               types.String `tfsdk:"name"`
+	SecretData         types.String `tfsdk:"secret_data"`
+	IsSecretDataBase64 types.Bool   `tfsdk:"is_secret_data_base64"`
+}
+
+func (p *googleEphemeralSecretManagerSecretVersionAccess) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Get the value of a Secret Manager secret version without storing it in the plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Description: "The project to get the secret version from. If it is not provided, the project of the secret or the provider project is used.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"secret": schema.StringAttribute{
+				Description: "The secret to get the secret version for, as a name or in the format `projects/{{project}}/secrets/{{secret}}`.",
+				Required:    true,
+			},
+			"version": schema.StringAttribute{
+				Description: "The version of the secret to get. If it is not provided, the latest version is retrieved.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The resource name of the secret version, in the format `projects/*/secrets/*/versions/*`.",
+				Computed:    true,
+			},
+			"secret_data": schema.StringAttribute{
+				Description: "The secret data.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"is_secret_data_base64": schema.BoolAttribute{
+				Description: "If set to `true`, the secret data is returned base64-encoded, as received from the API.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (p *googleEphemeralSecretManagerSecretVersionAccess) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*transport_tpg.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *transport_tpg.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	p.providerConfig = pd
+}
+
+func (p *googleEphemeralSecretManagerSecretVersionAccess) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ephemeralSecretManagerSecretVersionAccessModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fv := fwresource.ParseProjectFieldValueFramework("secrets", data.Secret.ValueString(), "project", data.Project, types.StringValue(p.providerConfig.Project), false, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if project := data.Project.ValueString(); project != "" && project != fv.Project {
+		resp.Diagnostics.AddError(
+			"Invalid project",
+			fmt.Sprintf("project field value (%s) does not match project of secret (%s).", project, fv.Project),
+		)
+		return
+	}
+
+	version := data.Version.ValueString()
+	if version == "" {
+		version = "latest"
+	}
+	url := fmt.Sprintf("%sprojects/%s/secrets/%s/versions/%s:access", p.providerConfig.SecretManagerBasePath, fv.Project, fv.Name, version)
+
+	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    p.providerConfig,
+		Method:    "GET",
+		Project:   fv.Project,
+		RawURL:    url,
+		UserAgent: p.providerConfig.UserAgent,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error accessing secret version",
+			fmt.Sprintf("Error accessing secret version %s of %s: %s", version, fv.Name, err),
+		)
+		return
+	}
+
+	name, _ := res["name"].(string)
+	parts := regexp.MustCompile("projects/(.+)/secrets/(.+)/versions/(.+)$").FindStringSubmatch(name)
+	if len(parts) != 4 {
+		resp.Diagnostics.AddError(
+			"Unexpected secret version name",
+			fmt.Sprintf("secret version name %q does not match format projects/{{project}}/secrets/{{secret}}/versions/{{version}}", name),
+		)
+		return
+	}
+
+	secretData, err := decodeSecretVersionPayload(res, data.IsSecretDataBase64.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Error decoding secret version data", err.Error())
+		return
+	}
+
+	data.Project = types.StringValue(fv.Project)
+	data.Secret = types.StringValue(fv.Name)
+	data.Version = types.StringValue(parts[3])
+	data.Name = types.StringValue(name)
+	data.SecretData = types.StringValue(secretData)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// decodeSecretVersionPayload returns the data of an access response, decoded
+// from base64 unless isBase64 is set.
+func decodeSecretVersionPayload(res map[string]interface{}, isBase64 bool) (string, error) {
+	payload, ok := res["payload"].(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("access response didn't contain a payload")
+	}
+	encoded, _ := payload["data"].(string)
+	if isBase64 {
+		return encoded, nil
+	}
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", err
+	}
+	return string(decoded), nil
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package secretmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccEphemeralSecretManagerSecretVersionAccess_basic(t *testing.T) {
+	t.Parallel()
+
+	randomString := acctest.RandString(t, 10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemeralSecretManagerSecretVersionAccess_setup(randomString),
+			},
+			{
+				Config: testAccEphemeralSecretManagerSecretVersionAccess_basic(randomString),
+			},
+		},
+	})
+}
+
+func testAccEphemeralSecretManagerSecretVersionAccess_setup(randomString string) string {
+	return fmt.Sprintf(`
+resource "google_secret_manager_secret" "secret-basic" {
+  secret_id = "tf-test-secret-version-%s"
+  replication {
+    auto {}
+  }
+}
+
+resource "google_secret_manager_secret_version" "secret-version-basic" {
+  secret      = google_secret_manager_secret.secret-basic.name
+  secret_data = "my-tf-test-secret-%s"
+}
+`, randomString, randomString)
+}
+
+func testAccEphemeralSecretManagerSecretVersionAccess_basic(randomString string) string {
+	return fmt.Sprintf(`
+%s
+
+ephemeral "google_secret_manager_secret_version_access" "basic" {
+  secret  = google_secret_manager_secret_version.secret-version-basic.secret
+  version = google_secret_manager_secret_version.secret-version-basic.version
+}
+
+ephemeral "google_secret_manager_secret_version_access" "latest" {
+  secret = google_secret_manager_secret.secret-basic.secret_id
+
+  depends_on = [google_secret_manager_secret_version.secret-version-basic]
+}
+`, testAccEphemeralSecretManagerSecretVersionAccess_setup(randomString))
+}
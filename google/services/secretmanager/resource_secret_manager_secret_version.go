@@ -27,7 +27,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
@@ -52,13 +54,36 @@ func ResourceSecretManagerSecretVersion() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(cty.GetAttrPath("secret_data"), cty.GetAttrPath("secret_data_wo")),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"secret_data": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: `The secret data. Must be no larger than 64KiB.`,
-				Sensitive:   true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  `The secret data. Must be no larger than 64KiB.`,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"secret_data", "secret_data_wo"},
+			},
+			"secret_data_wo": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: `The secret data, which is not stored in the Terraform state. Must be no larger than 64KiB.
+A new version is only created when 'secret_data_wo_version' changes. Requires Terraform 1.11 or later.`,
+				Sensitive:    true,
+				WriteOnly:    true,
+				ExactlyOneOf: []string{"secret_data", "secret_data_wo"},
+				RequiredWith: []string{"secret_data_wo_version"},
+			},
+			"secret_data_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  `Triggers the creation of a new version with the value of 'secret_data_wo' when changed.`,
+				RequiredWith: []string{"secret_data_wo"},
 			},
 
 			"secret": {
@@ -419,6 +444,11 @@ func flattenSecretManagerSecretVersionDestroyTime(v interface{}, d *schema.Resou
 func flattenSecretManagerSecretVersionPayload(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	transformed := make(map[string]interface{})
 
+	// the secret data was set through secret_data_wo, it must not be stored in the state
+	if _, ok := d.GetOk("secret_data_wo_version"); ok {
+		return []interface{}{transformed}
+	}
+
 	// if this secret version is disabled, the api will return an error, as the value cannot be accessed, return what we have
 	if d.Get("enabled").(bool) == false {
 		transformed["secret_data"] = d.Get("secret_data")
@@ -502,7 +532,15 @@ func expandSecretManagerSecretVersionEnabled(v interface{}, d tpgresource.Terraf
 
 func expandSecretManagerSecretVersionPayload(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
 	transformed := make(map[string]interface{})
-	transformedSecretData, err := expandSecretManagerSecretVersionPayloadSecretData(d.Get("secret_data"), d, config)
+	secretData := d.Get("secret_data")
+	if rd, ok := d.(*schema.ResourceData); ok && secretData == "" {
+		wo, err := tpgresource.GetRawConfigAttributeAsString(rd, "secret_data_wo")
+		if err != nil {
+			return nil, err
+		}
+		secretData = wo
+	}
+	transformedSecretData, err := expandSecretManagerSecretVersionPayloadSecretData(secretData, d, config)
 	if err != nil {
 		return nil, err
 	} else if val := reflect.ValueOf(transformedSecretData); val.IsValid() && !tpgresource.IsEmptyValue(val) {
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package secretmanagerregional
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-google/google/fwresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+var _ ephemeral.EphemeralResource = &googleEphemeralSecretManagerRegionalSecretVersionAccess{}
+
+func GoogleEphemeralSecretManagerRegionalSecretVersionAccess() ephemeral.EphemeralResource {
+	return &googleEphemeralSecretManagerRegionalSecretVersionAccess{}
+}
+
+type googleEphemeralSecretManagerRegionalSecretVersionAccess struct {
+	providerConfig *transport_tpg.Config
+}
+
+func (p *googleEphemeralSecretManagerRegionalSecretVersionAccess) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret_manager_regional_secret_version_access"
+}
+
+type ephemeralSecretManagerRegionalSecretVersionAccessModel struct {
+	Project            types.String `tfsdk:"project"`
+	Location           types.String `tfsdk:"location"`
+	Secret             types.String `tfsdk:"secret"`
+	Version            types.String `tfsdk:"version"`
+	Name               types.String `tfsdk:"name"`
+	SecretData         types.String `tfsdk:"secret_data"`
+	IsSecretDataBase64 types.Bool   `tfsdk:"is_secret_data_base64"`
+}
+
+func (p *googleEphemeralSecretManagerRegionalSecretVersionAccess) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Get the value of a Secret Manager regional secret version without storing it in the plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Description: "The project to get the secret version from. If it is not provided, the project of the secret or the provider project is used.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"location": schema.StringAttribute{
+				Description: "The location of the regional secret. It must be set unless `secret` is in the format `projects/{{project}}/locations/{{location}}/secrets/{{secret}}`.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"secret": schema.StringAttribute{
+				Description: "The regional secret to get the secret version for, as a name or in the format `projects/{{project}}/locations/{{location}}/secrets/{{secret}}`.",
+				Required:    true,
+			},
+			"version": schema.StringAttribute{
+				Description: "The version of the regional secret to get. If it is not provided, the latest version is retrieved.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The resource name of the regional secret version, in the format `projects/*/locations/*/secrets/*/versions/*`.",
+				Computed:    true,
+			},
+			"secret_data": schema.StringAttribute{
+				Description: "The secret data.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"is_secret_data_base64": schema.BoolAttribute{
+				Description: "If set to `true`, the secret data is returned base64-encoded, as received from the API.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (p *googleEphemeralSecretManagerRegionalSecretVersionAccess) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*transport_tpg.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *transport_tpg.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	p.providerConfig = pd
+}
+
+func (p *googleEphemeralSecretManagerRegionalSecretVersionAccess) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ephemeralSecretManagerRegionalSecretVersionAccessModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var project, location, secret string
+	if parts := regexp.MustCompile("projects/(.+)/locations/(.+)/secrets/(.+)$").FindStringSubmatch(data.Secret.ValueString()); len(parts) == 4 {
+		project, location, secret = parts[1], parts[2], parts[3]
+		if v := data.Project.ValueString(); v != "" && v != project {
+			resp.Diagnostics.AddError(
+				"Invalid project",
+				fmt.Sprintf("project field value (%s) does not match project of secret (%s).", v, project),
+			)
+			return
+		}
+		if v := data.Location.ValueString(); v != "" && v != location {
+			resp.Diagnostics.AddError(
+				"Invalid location",
+				fmt.Sprintf("location field value (%s) does not match location of secret (%s).", v, location),
+			)
+			return
+		}
+	} else {
+		project = fwresource.GetProjectFramework(data.Project, types.StringValue(p.providerConfig.Project), &resp.Diagnostics).ValueString()
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		location = data.Location.ValueString()
+		if location == "" {
+			resp.Diagnostics.AddError("Missing location", "location must be set when providing only secret name")
+			return
+		}
+		secret = data.Secret.ValueString()
+	}
+
+	version := data.Version.ValueString()
+	if version == "" {
+		version = "latest"
+	}
+	basePath := strings.ReplaceAll(p.providerConfig.SecretManagerRegionalBasePath, "{{location}}", location)
+	url := fmt.Sprintf("%sprojects/%s/locations/%s/secrets/%s/versions/%s:access", basePath, project, location, secret, version)
+
+	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    p.providerConfig,
+		Method:    "GET",
+		Project:   project,
+		RawURL:    url,
+		UserAgent: p.providerConfig.UserAgent,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error accessing regional secret version",
+			fmt.Sprintf("Error accessing regional secret version %s of %s: %s", version, secret, err),
+		)
+		return
+	}
+
+	name, _ := res["name"].(string)
+	parts := regexp.MustCompile("projects/(.+)/locations/(.+)/secrets/(.+)/versions/(.+)$").FindStringSubmatch(name)
+	if len(parts) != 5 {
+		resp.Diagnostics.AddError(
+			"Unexpected secret version name",
+			fmt.Sprintf("secret version name %q does not match format projects/{{project}}/locations/{{location}}/secrets/{{secret}}/versions/{{version}}", name),
+		)
+		return
+	}
+
+	payload, ok := res["payload"].(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Error decoding regional secret version data", "access response didn't contain a payload")
+		return
+	}
+	secretData, _ := payload["data"].(string)
+	if !data.IsSecretDataBase64.ValueBool() {
+		decoded, err := base64.StdEncoding.DecodeString(secretData)
+		if err != nil {
+			resp.Diagnostics.AddError("Error decoding regional secret version data", err.Error())
+			return
+		}
+		secretData = string(decoded)
+	}
+
+	data.Project = types.StringValue(project)
+	data.Location = types.StringValue(location)
+	data.Secret = types.StringValue(secret)
+	data.Version = types.StringValue(parts[4])
+	data.Name = types.StringValue(name)
+	data.SecretData = types.StringValue(secretData)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package secretmanagerregional_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccEphemeralSecretManagerRegionalSecretVersionAccess_basic(t *testing.T) {
+	t.Parallel()
+
+	randomString := acctest.RandString(t, 10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemeralSecretManagerRegionalSecretVersionAccess_setup(randomString),
+			},
+			{
+				Config: testAccEphemeralSecretManagerRegionalSecretVersionAccess_basic(randomString),
+			},
+		},
+	})
+}
+
+func testAccEphemeralSecretManagerRegionalSecretVersionAccess_setup(randomString string) string {
+	return fmt.Sprintf(`
+resource "google_secret_manager_regional_secret" "secret-basic" {
+  secret_id = "tf-test-secret-version-%s"
+  location  = "us-central1"
+}
+
+resource "google_secret_manager_regional_secret_version" "secret-version-basic" {
+  secret      = google_secret_manager_regional_secret.secret-basic.id
+  secret_data = "my-tf-test-secret-%s"
+}
+`, randomString, randomString)
+}
+
+func testAccEphemeralSecretManagerRegionalSecretVersionAccess_basic(randomString string) string {
+	return fmt.Sprintf(`
+%s
+
+ephemeral "google_secret_manager_regional_secret_version_access" "basic" {
+  secret  = google_secret_manager_regional_secret_version.secret-version-basic.secret
+  version = google_secret_manager_regional_secret_version.secret-version-basic.version
+}
+
+ephemeral "google_secret_manager_regional_secret_version_access" "latest" {
+  secret   = google_secret_manager_regional_secret.secret-basic.secret_id
+  location = "us-central1"
+
+  depends_on = [google_secret_manager_regional_secret_version.secret-version-basic]
+}
+`, testAccEphemeralSecretManagerRegionalSecretVersionAccess_setup(randomString))
+}
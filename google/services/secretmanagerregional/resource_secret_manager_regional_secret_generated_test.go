@@ -49,7 +49,7 @@ func TestAccSecretManagerRegionalRegionalSecret_regionalSecretConfigBasicExample
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-basic",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels", "ttl"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels", "ttl"},
 			},
 		},
 	})
@@ -94,7 +94,7 @@ func TestAccSecretManagerRegionalRegionalSecret_regionalSecretWithCmekExample(t
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-cmek",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels", "ttl"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels", "ttl"},
 			},
 		},
 	})
@@ -143,7 +143,7 @@ func TestAccSecretManagerRegionalRegionalSecret_regionalSecretWithRotationExampl
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-rotation",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels", "ttl"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels", "ttl"},
 			},
 		},
 	})
@@ -202,7 +202,7 @@ func TestAccSecretManagerRegionalRegionalSecret_regionalSecretWithTtlExample(t *
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-ttl",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels", "ttl"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels", "ttl"},
 			},
 		},
 	})
@@ -249,7 +249,7 @@ func TestAccSecretManagerRegionalRegionalSecret_regionalSecretWithExpireTimeExam
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-expire-time",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels", "ttl"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels", "ttl"},
 			},
 		},
 	})
@@ -295,7 +295,7 @@ func TestAccSecretManagerRegionalRegionalSecret_regionalSecretWithVersionDestroy
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-version-destroy-ttl",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels", "ttl"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels", "ttl"},
 			},
 		},
 	})
@@ -29,7 +29,7 @@ func TestAccSecretManagerRegionalRegionalSecret_import(t *testing.T) {
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-basic",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels"},
 			},
 		},
 	})
@@ -54,7 +54,7 @@ func TestAccSecretManagerRegionalRegionalSecret_labelsUpdate(t *testing.T) {
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-labels",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels"},
 			},
 			{
 				Config: testAccSecretManagerRegionalSecret_labelsUpdate(context),
@@ -63,7 +63,7 @@ func TestAccSecretManagerRegionalRegionalSecret_labelsUpdate(t *testing.T) {
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-labels",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels"},
 			},
 			{
 				Config: testAccSecretManagerRegionalSecret_labelsUpdateOther(context),
@@ -72,7 +72,7 @@ func TestAccSecretManagerRegionalRegionalSecret_labelsUpdate(t *testing.T) {
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-labels",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels"},
 			},
 			{
 				Config: testAccSecretManagerRegionalSecret_withoutLabels(context),
@@ -81,7 +81,7 @@ func TestAccSecretManagerRegionalRegionalSecret_labelsUpdate(t *testing.T) {
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-labels",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels"},
 			},
 		},
 	})
@@ -106,7 +106,7 @@ func TestAccSecretManagerRegionalRegionalSecret_annotationsUpdate(t *testing.T)
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-annotations",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels"},
 			},
 			{
 				Config: testAccSecretManagerRegionalSecret_annotationsUpdate(context),
@@ -115,7 +115,7 @@ func TestAccSecretManagerRegionalRegionalSecret_annotationsUpdate(t *testing.T)
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-annotations",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels"},
 			},
 			{
 				Config: testAccSecretManagerRegionalSecret_annotationsUpdateOther(context),
@@ -124,7 +124,7 @@ func TestAccSecretManagerRegionalRegionalSecret_annotationsUpdate(t *testing.T)
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-annotations",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels"},
 			},
 			{
 				Config: testAccSecretManagerRegionalSecret_withoutAnnotations(context),
@@ -133,7 +133,7 @@ func TestAccSecretManagerRegionalRegionalSecret_annotationsUpdate(t *testing.T)
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-annotations",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels"},
 			},
 		},
 	})
@@ -160,7 +160,7 @@ func TestAccSecretManagerRegionalRegionalSecret_cmekUpdate(t *testing.T) {
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-cmek-update",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels"},
 			},
 			{
 				Config: testAccSecretManagerRegionalSecret_cmekUpdate(context),
@@ -169,7 +169,7 @@ func TestAccSecretManagerRegionalRegionalSecret_cmekUpdate(t *testing.T) {
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-cmek-update",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels"},
 			},
 			{
 				Config: testAccSecretManagerRegionalSecret_cmekUpdateOther(context),
@@ -178,7 +178,7 @@ func TestAccSecretManagerRegionalRegionalSecret_cmekUpdate(t *testing.T) {
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-cmek-update",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels"},
 			},
 			{
 				Config: testAccSecretManagerRegionalSecret_withoutCmek(context),
@@ -187,7 +187,7 @@ func TestAccSecretManagerRegionalRegionalSecret_cmekUpdate(t *testing.T) {
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-cmek-update",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels"},
 			},
 		},
 	})
@@ -212,7 +212,7 @@ func TestAccSecretManagerRegionalRegionalSecret_topicsUpdate(t *testing.T) {
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-topics",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels"},
 			},
 			{
 				Config: testAccSecretManagerRegionalSecret_topicsUpdate(context),
@@ -221,7 +221,7 @@ func TestAccSecretManagerRegionalRegionalSecret_topicsUpdate(t *testing.T) {
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-topics",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels"},
 			},
 			{
 				Config: testAccSecretManagerRegionalSecret_topicsUpdateOther(context),
@@ -230,7 +230,7 @@ func TestAccSecretManagerRegionalRegionalSecret_topicsUpdate(t *testing.T) {
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-topics",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels"},
 			},
 			{
 				Config: testAccSecretManagerRegionalSecret_withoutTopics(context),
@@ -239,7 +239,7 @@ func TestAccSecretManagerRegionalRegionalSecret_topicsUpdate(t *testing.T) {
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-topics",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels"},
 			},
 		},
 	})
@@ -266,7 +266,7 @@ func TestAccSecretManagerRegionalRegionalSecret_rotationInfoUpdate(t *testing.T)
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-rotation-update",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels"},
 			},
 			{
 				Config: testAccSecretManagerRegionalSecret_rotationTimeUpdate(context),
@@ -275,7 +275,7 @@ func TestAccSecretManagerRegionalRegionalSecret_rotationInfoUpdate(t *testing.T)
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-rotation-update",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels"},
 			},
 			{
 				Config: testAccSecretManagerRegionalSecret_rotationPeriodUpdate(context),
@@ -284,7 +284,7 @@ func TestAccSecretManagerRegionalRegionalSecret_rotationInfoUpdate(t *testing.T)
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-rotation-update",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels"},
 			},
 			{
 				Config: testAccSecretManagerRegionalSecret_rotationBasic(context),
@@ -293,7 +293,7 @@ func TestAccSecretManagerRegionalRegionalSecret_rotationInfoUpdate(t *testing.T)
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-rotation-update",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels"},
 			},
 		},
 	})
@@ -320,7 +320,7 @@ func TestAccSecretManagerRegionalRegionalSecret_expireTimeUpdate(t *testing.T) {
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-expiration",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels"},
 			},
 			{
 				Config: testAccSecretManagerRegionalSecret_expireTimeBasic(context),
@@ -329,7 +329,7 @@ func TestAccSecretManagerRegionalRegionalSecret_expireTimeUpdate(t *testing.T) {
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-expiration",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels"},
 			},
 			{
 				Config: testAccSecretManagerRegionalSecret_expireTimeUpdate(context),
@@ -338,7 +338,7 @@ func TestAccSecretManagerRegionalRegionalSecret_expireTimeUpdate(t *testing.T) {
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-expiration",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels"},
 			},
 			{
 				Config: testAccSecretManagerRegionalSecret_withoutTtlAndExpireTime(context),
@@ -347,7 +347,7 @@ func TestAccSecretManagerRegionalRegionalSecret_expireTimeUpdate(t *testing.T) {
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-expiration",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels"},
 			},
 		},
 	})
@@ -372,7 +372,7 @@ func TestAccSecretManagerRegionalRegionalSecret_ttlUpdate(t *testing.T) {
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-expiration",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"ttl", "annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"ttl", "annotations", "labels", "location", "secret_id", "terraform_labels", "terraform_annotations"},
 			},
 			{
 				Config: testAccSecretManagerRegionalSecret_ttlBasic(context),
@@ -381,7 +381,7 @@ func TestAccSecretManagerRegionalRegionalSecret_ttlUpdate(t *testing.T) {
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-expiration",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"ttl", "annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"ttl", "annotations", "labels", "location", "secret_id", "terraform_labels", "terraform_annotations"},
 			},
 			{
 				Config: testAccSecretManagerRegionalSecret_ttlUpdate(context),
@@ -390,7 +390,7 @@ func TestAccSecretManagerRegionalRegionalSecret_ttlUpdate(t *testing.T) {
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-expiration",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"ttl", "annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"ttl", "annotations", "labels", "location", "secret_id", "terraform_labels", "terraform_annotations"},
 			},
 			{
 				Config: testAccSecretManagerRegionalSecret_withoutTtlAndExpireTime(context),
@@ -399,7 +399,7 @@ func TestAccSecretManagerRegionalRegionalSecret_ttlUpdate(t *testing.T) {
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-expiration",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"ttl", "annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"ttl", "annotations", "labels", "location", "secret_id", "terraform_labels", "terraform_annotations"},
 			},
 		},
 	})
@@ -425,7 +425,7 @@ func TestAccSecretManagerRegionalRegionalSecret_updateBetweenTtlAndExpireTime(t
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-expiration",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"ttl", "annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"ttl", "annotations", "labels", "location", "secret_id", "terraform_labels", "terraform_annotations"},
 			},
 			{
 				Config: testAccSecretManagerRegionalSecret_expireTimeBasic(context),
@@ -434,7 +434,7 @@ func TestAccSecretManagerRegionalRegionalSecret_updateBetweenTtlAndExpireTime(t
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-expiration",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"ttl", "annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"ttl", "annotations", "labels", "location", "secret_id", "terraform_labels", "terraform_annotations"},
 			},
 			{
 				Config: testAccSecretManagerRegionalSecret_ttlBasic(context),
@@ -443,7 +443,7 @@ func TestAccSecretManagerRegionalRegionalSecret_updateBetweenTtlAndExpireTime(t
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-expiration",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"ttl", "annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"ttl", "annotations", "labels", "location", "secret_id", "terraform_labels", "terraform_annotations"},
 			},
 		},
 	})
@@ -468,7 +468,7 @@ func TestAccSecretManagerRegionalRegionalSecret_versionDestroyTtlUpdate(t *testi
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-version-destroy-ttl",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels"},
 			},
 			{
 				Config: testAccSecretManagerRegionalSecret_versionDestroyTtlBasic(context),
@@ -477,7 +477,7 @@ func TestAccSecretManagerRegionalRegionalSecret_versionDestroyTtlUpdate(t *testi
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-version-destroy-ttl",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels"},
 			},
 			{
 				Config: testAccSecretManagerRegionalSecret_versionDestroyTtlUpdate(context),
@@ -486,7 +486,7 @@ func TestAccSecretManagerRegionalRegionalSecret_versionDestroyTtlUpdate(t *testi
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-version-destroy-ttl",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels"},
 			},
 			{
 				Config: testAccSecretManagerRegionalSecret_withoutVersionDestroyTtl(context),
@@ -495,7 +495,7 @@ func TestAccSecretManagerRegionalRegionalSecret_versionDestroyTtlUpdate(t *testi
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-version-destroy-ttl",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels"},
 			},
 		},
 	})
@@ -520,7 +520,7 @@ func TestAccSecretManagerRegionalRegionalSecret_versionAliasesUpdate(t *testing.
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-version-aliases",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels"},
 			},
 			{
 				Config: testAccSecretManagerRegionalSecret_versionAliasesBasic(context),
@@ -529,7 +529,7 @@ func TestAccSecretManagerRegionalRegionalSecret_versionAliasesUpdate(t *testing.
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-version-aliases",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels"},
 			},
 			{
 				Config: testAccSecretManagerRegionalSecret_versionAliasesUpdate(context),
@@ -538,7 +538,7 @@ func TestAccSecretManagerRegionalRegionalSecret_versionAliasesUpdate(t *testing.
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-version-aliases",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels"},
 			},
 			{
 				Config: testAccSecretManagerRegionalSecret_basicRegionalSecretWithVersions(context),
@@ -547,7 +547,7 @@ func TestAccSecretManagerRegionalRegionalSecret_versionAliasesUpdate(t *testing.
 				ResourceName:            "google_secret_manager_regional_secret.regional-secret-with-version-aliases",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_labels"},
+				ImportStateVerifyIgnore: []string{"annotations", "labels", "location", "secret_id", "terraform_annotations", "terraform_labels"},
 			},
 		},
 	})
@@ -34,12 +34,20 @@ type SecureSourceManagerOperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *SecureSourceManagerOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
+func (w *SecureSourceManagerOperationWaiter) OperationURL() string {
+	return fmt.Sprintf("%s%s", w.Config.SecureSourceManagerBasePath, w.CommonOperationWaiter.Op.Name)
+}
+
 func (w *SecureSourceManagerOperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
 	}
 	// Returns the proper get.
-	url := fmt.Sprintf("%s%s", w.Config.SecureSourceManagerBasePath, w.CommonOperationWaiter.Op.Name)
+	url := w.OperationURL()
 
 	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
 		Config:    w.Config,
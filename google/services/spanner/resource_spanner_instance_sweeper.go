@@ -68,14 +68,14 @@ func testSweepSpannerInstance(region string) error {
 		shortName := name[strings.LastIndex(name, "/")+1:]
 
 		// Increment count and skip if resource is not sweepable.
-		if !sweeper.IsSweepableTestResource(shortName) {
+		if !sweeper.IsSweepableResource(shortName, obj) {
 			nonPrefixCount++
 			continue
 		}
 
 		deleteUrl := spannerUrl + "/" + name
 		// Don't wait on operations as we may have a lot to delete
-		_, err = transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		_, err = sweeper.SendDeleteRequest(transport_tpg.SendRequestOptions{
 			Config:    config,
 			Method:    "DELETE",
 			Project:   config.Project,
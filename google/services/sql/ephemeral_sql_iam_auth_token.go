@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package sql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-google/google/fwutils"
+	"github.com/hashicorp/terraform-provider-google/google/fwvalidators"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+	"google.golang.org/api/iamcredentials/v1"
+)
+
+// sqlLoginScope is the scope required by tokens used as passwords for Cloud
+// SQL IAM database authentication.
+const sqlLoginScope = "https://www.googleapis.com/auth/sqlservice.login"
+
+var _ ephemeral.EphemeralResource = &googleEphemeralSqlIamAuthToken{}
+
+func GoogleEphemeralSqlIamAuthToken() ephemeral.EphemeralResource {
+	return &googleEphemeralSqlIamAuthToken{}
+}
+
+type googleEphemeralSqlIamAuthToken struct {
+	providerConfig *transport_tpg.Config
+}
+
+func (p *googleEphemeralSqlIamAuthToken) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sql_iam_auth_token"
+}
+
+type ephemeralSqlIamAuthTokenModel struct {
+	TargetServiceAccount types.String `tfsdk:"target_service_account"`
+	Delegates            types.Set    `tfsdk:"delegates"`
+	Token                types.String `tfsdk:"token"`
+	ExpireTime           types.String `tfsdk:"expire_time"`
+}
+
+func (p *googleEphemeralSqlIamAuthToken) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Get a short-lived token to log in to a Cloud SQL instance with IAM database authentication, to be used as the password of the IAM database user.",
+		Attributes: map[string]schema.Attribute{
+			"target_service_account": schema.StringAttribute{
+				Description: "The service account to get a token for (e.g. `service_B@your-project-id.iam.gserviceaccount.com`). If it is not set, the token is for the credentials of the provider.",
+				Optional:    true,
+				Validators: []validator.String{
+					fwvalidators.ServiceAccountEmailValidator{},
+				},
+			},
+			"delegates": schema.SetAttribute{
+				Description: "Delegate chain of approvals needed to impersonate `target_service_account`. Specify the fully qualified service account name.  (e.g. `['projects/-/serviceAccounts/delegate-svc-account@project-id.iam.gserviceaccount.com']`)",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"token": schema.StringAttribute{
+				Description: "The token, to be used as the password of the IAM database user.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"expire_time": schema.StringAttribute{
+				Description: "The time the token expires at, in RFC3339 format.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (p *googleEphemeralSqlIamAuthToken) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	pd, ok := req.ProviderData.(*transport_tpg.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *transport_tpg.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	p.providerConfig = pd
+}
+
+func (p *googleEphemeralSqlIamAuthToken) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ephemeralSqlIamAuthTokenModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.TargetServiceAccount.ValueString() != "" {
+		var delegates []string
+		if !data.Delegates.IsNull() {
+			delegates = fwutils.StringSet(data.Delegates)
+		}
+
+		service := p.providerConfig.NewIamCredentialsClient(p.providerConfig.UserAgent)
+		name := fmt.Sprintf("projects/-/serviceAccounts/%s", data.TargetServiceAccount.ValueString())
+		at, err := service.Projects.ServiceAccounts.GenerateAccessToken(name, &iamcredentials.GenerateAccessTokenRequest{
+			Delegates: delegates,
+			Scope:     []string{sqlLoginScope},
+		}).Do()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error generating Cloud SQL IAM auth token",
+				fmt.Sprintf("Error generating Cloud SQL IAM auth token for %s: %s", data.TargetServiceAccount.ValueString(), err),
+			)
+			return
+		}
+
+		data.Token = types.StringValue(at.AccessToken)
+		data.ExpireTime = types.StringValue(at.ExpireTime)
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
+	creds, err := p.providerConfig.GetCredentials([]string{sqlLoginScope}, false)
+	if err != nil {
+		resp.Diagnostics.AddError("Error loading credentials for the Cloud SQL IAM auth token", err.Error())
+		return
+	}
+	token, err := creds.TokenSource.Token()
+	if err != nil {
+		resp.Diagnostics.AddError("Error generating Cloud SQL IAM auth token", err.Error())
+		return
+	}
+
+	data.Token = types.StringValue(token.AccessToken)
+	if token.Expiry.IsZero() {
+		data.ExpireTime = types.StringNull()
+	} else {
+		data.ExpireTime = types.StringValue(token.Expiry.Format(time.RFC3339))
+	}
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
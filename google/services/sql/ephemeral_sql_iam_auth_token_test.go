@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package sql_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+	"github.com/hashicorp/terraform-provider-google/google/envvar"
+)
+
+func TestAccEphemeralSqlIamAuthToken_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemeralSqlIamAuthToken_basic(),
+			},
+		},
+	})
+}
+
+func TestAccEphemeralSqlIamAuthToken_impersonation(t *testing.T) {
+	t.Parallel()
+
+	serviceAccount := envvar.GetTestServiceAccountFromEnv(t)
+	targetServiceAccountEmail := acctest.BootstrapServiceAccount(t, "sql-token", serviceAccount)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemeralSqlIamAuthToken_impersonation(targetServiceAccountEmail),
+			},
+		},
+	})
+}
+
+func testAccEphemeralSqlIamAuthToken_basic() string {
+	return `
+ephemeral "google_sql_iam_auth_token" "token" {}
+`
+}
+
+func testAccEphemeralSqlIamAuthToken_impersonation(serviceAccount string) string {
+	return fmt.Sprintf(`
+ephemeral "google_sql_iam_auth_token" "token" {
+  target_service_account = "%s"
+}
+`, serviceAccount)
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-provider-google/google/sweeper"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
 )
 
 func init() {
@@ -39,11 +40,18 @@ func testSweepSQLDatabaseInstance(region string) error {
 	}
 
 	running := map[string]struct{}{}
+	sweepable := map[string]struct{}{}
 
 	for _, d := range found.Items {
-		if !sweeper.IsSweepableTestResource(d.Name) {
+		obj := sweeper.ResourceObject(d)
+		// Instances have their labels in their settings.
+		if d.Settings != nil {
+			obj["labels"] = d.Settings.UserLabels
+		}
+		if !sweeper.IsSweepableResource(d.Name, obj) {
 			continue
 		}
+		sweepable[d.Name] = struct{}{}
 
 		if d.State != "RUNNABLE" {
 			continue
@@ -52,7 +60,7 @@ func testSweepSQLDatabaseInstance(region string) error {
 	}
 
 	for _, d := range found.Items {
-		if !sweeper.IsSweepableTestResource(d.Name) {
+		if _, ok := sweepable[d.Name]; !ok {
 			continue
 		}
 
@@ -101,7 +109,11 @@ func testSweepSQLDatabaseInstance(region string) error {
 
 		for _, db := range ordering {
 			// destroy instances, replicas first
-			op, err := config.NewSqlAdminClient(config.UserAgent).Instances.Delete(config.Project, db).Do()
+			var op *sqladmin.Operation
+			err := sweeper.DeleteResource(db, fmt.Sprintf("projects/%s/instances/%s", config.Project, db), func() (err error) {
+				op, err = config.NewSqlAdminClient(config.UserAgent).Instances.Delete(config.Project, db).Do()
+				return err
+			})
 
 			if err != nil {
 				if strings.Contains(err.Error(), "409") {
@@ -114,6 +126,10 @@ func testSweepSQLDatabaseInstance(region string) error {
 				log.Printf("Error, failed to delete instance %s: %s", db, err)
 				continue
 			}
+			// The instance isn't deleted in a dry run.
+			if op == nil {
+				continue
+			}
 
 			err = SqlAdminOperationWaitTime(config, op, config.Project, "Delete Instance", config.UserAgent, 10*time.Minute)
 			if err != nil {
@@ -12,6 +12,7 @@ import (
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
 
 	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -63,6 +64,10 @@ func ResourceSqlUser() *schema.Resource {
 			tpgresource.DefaultProviderProject,
 		),
 
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(cty.GetAttrPath("password"), cty.GetAttrPath("password_wo")),
+		},
+
 		SchemaVersion: 1,
 		MigrateState:  resourceSqlUserMigrateState,
 
@@ -91,13 +96,33 @@ func ResourceSqlUser() *schema.Resource {
 			},
 
 			"password": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Sensitive: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"password_wo"},
 				Description: `The password for the user. Can be updated. For Postgres instances this is a Required field, unless type is set to
 				either CLOUD_IAM_USER or CLOUD_IAM_SERVICE_ACCOUNT.`,
 			},
 
+			"password_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				ConflictsWith: []string{"password"},
+				RequiredWith:  []string{"password_wo_version"},
+				Description: `The password for the user, which is not stored in the Terraform state. Changes to it are only
+				applied when password_wo_version changes. Requires Terraform 1.11 or later.`,
+			},
+
+			"password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				RequiredWith: []string{"password_wo"},
+				Description:  `Triggers an update of the password to the value of password_wo when changed.`,
+			},
+
 			"type": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -229,6 +254,15 @@ func expandPasswordPolicy(cfg interface{}) *sqladmin.UserPasswordValidationPolic
 	return upvp
 }
 
+// sqlUserPassword returns the password to send for the user, from either
+// `password` or the write-only `password_wo`.
+func sqlUserPassword(d *schema.ResourceData) (string, error) {
+	if v, ok := d.GetOk("password"); ok {
+		return v.(string), nil
+	}
+	return tpgresource.GetRawConfigAttributeAsString(d, "password_wo")
+}
+
 func resourceSqlUserCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*transport_tpg.Config)
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
@@ -243,7 +277,10 @@ func resourceSqlUserCreate(d *schema.ResourceData, meta interface{}) error {
 
 	name := d.Get("name").(string)
 	instance := d.Get("instance").(string)
-	password := d.Get("password").(string)
+	password, err := sqlUserPassword(d)
+	if err != nil {
+		return err
+	}
 	host := d.Get("host").(string)
 	typ := d.Get("type").(string)
 
@@ -452,7 +489,7 @@ func resourceSqlUserUpdate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	if d.HasChange("password") || d.HasChange("password_policy") {
+	if d.HasChange("password") || d.HasChange("password_wo_version") || d.HasChange("password_policy") {
 		project, err := tpgresource.GetProject(d, config)
 		if err != nil {
 			return err
@@ -460,7 +497,10 @@ func resourceSqlUserUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		name := d.Get("name").(string)
 		instance := d.Get("instance").(string)
-		password := d.Get("password").(string)
+		password, err := sqlUserPassword(d)
+		if err != nil {
+			return err
+		}
 		host := d.Get("host").(string)
 
 		user := &sqladmin.User{
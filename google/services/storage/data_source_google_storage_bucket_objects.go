@@ -68,50 +68,36 @@ func datasourceGoogleStorageBucketObjectsRead(d *schema.ResourceData, meta inter
 	}
 
 	params := make(map[string]string)
-	bucketObjects := make([]map[string]interface{}, 0)
-
-	for {
-		bucket := d.Get("bucket").(string)
-		url, err := tpgresource.ReplaceVars(d, config, fmt.Sprintf("{{StorageBasePath}}b/%s/o", bucket))
-		if err != nil {
-			return err
-		}
-
-		if v, ok := d.GetOk("match_glob"); ok {
-			params["matchGlob"] = v.(string)
-		}
-
-		if v, ok := d.GetOk("prefix"); ok {
-			params["prefix"] = v.(string)
-		}
+	bucket := d.Get("bucket").(string)
+	url, err := tpgresource.ReplaceVars(d, config, fmt.Sprintf("{{StorageBasePath}}b/%s/o", bucket))
+	if err != nil {
+		return err
+	}
 
-		url, err = transport_tpg.AddQueryParams(url, params)
-		if err != nil {
-			return err
-		}
+	if v, ok := d.GetOk("match_glob"); ok {
+		params["matchGlob"] = v.(string)
+	}
 
-		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
-			Config:    config,
-			Method:    "GET",
-			RawURL:    url,
-			UserAgent: userAgent,
-		})
-		if err != nil {
-			return fmt.Errorf("Error retrieving bucket objects: %s", err)
-		}
+	if v, ok := d.GetOk("prefix"); ok {
+		params["prefix"] = v.(string)
+	}
 
-		pageBucketObjects := flattenDatasourceGoogleBucketObjectsList(res["items"])
-		bucketObjects = append(bucketObjects, pageBucketObjects...)
+	url, err = transport_tpg.AddQueryParams(url, params)
+	if err != nil {
+		return err
+	}
 
-		pToken, ok := res["nextPageToken"]
-		if ok && pToken != nil && pToken.(string) != "" {
-			params["pageToken"] = pToken.(string)
-		} else {
-			break
-		}
+	items, err := transport_tpg.ListAll(transport_tpg.ListRequestOptions{
+		Config:     config,
+		RawURL:     url,
+		UserAgent:  userAgent,
+		ItemsField: "items",
+	})
+	if err != nil {
+		return fmt.Errorf("Error retrieving bucket objects: %s", err)
 	}
 
-	if err := d.Set("bucket_objects", bucketObjects); err != nil {
+	if err := d.Set("bucket_objects", flattenDatasourceGoogleBucketObjectsList(items)); err != nil {
 		return fmt.Errorf("Error retrieving bucket_objects: %s", err)
 	}
 
@@ -70,13 +70,13 @@ func testSweepStorageBucket(region string) error {
 
 		id := obj["name"].(string)
 		// Increment count and skip if resource is not sweepable.
-		if !sweeper.IsSweepableTestResource(id) {
+		if !sweeper.IsSweepableResource(id, obj) {
 			nonPrefixCount++
 			continue
 		}
 
 		deleteUrl := fmt.Sprintf("https://storage.googleapis.com/storage/v1/b/%s", id)
-		_, err = transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		_, err = sweeper.SendDeleteRequest(transport_tpg.SendRequestOptions{
 			Config:    config,
 			Method:    "DELETE",
 			Project:   config.Project,
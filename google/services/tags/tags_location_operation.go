@@ -19,6 +19,10 @@ type TagsLocationOperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *TagsLocationOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
 func (w *TagsLocationOperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
@@ -34,12 +34,20 @@ type TPUOperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *TPUOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
+func (w *TPUOperationWaiter) OperationURL() string {
+	return fmt.Sprintf("%s%s", w.Config.TPUBasePath, w.CommonOperationWaiter.Op.Name)
+}
+
 func (w *TPUOperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
 	}
 	// Returns the proper get.
-	url := fmt.Sprintf("%s%s", w.Config.TPUBasePath, w.CommonOperationWaiter.Op.Name)
+	url := w.OperationURL()
 
 	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
 		Config:    w.Config,
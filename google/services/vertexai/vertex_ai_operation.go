@@ -18,6 +18,10 @@ type VertexAIOperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *VertexAIOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
 func (w *VertexAIOperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
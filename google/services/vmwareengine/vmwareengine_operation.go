@@ -34,12 +34,20 @@ type VmwareengineOperationWaiter struct {
 	tpgresource.CommonOperationWaiter
 }
 
+func (w *VmwareengineOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
+func (w *VmwareengineOperationWaiter) OperationURL() string {
+	return fmt.Sprintf("%s%s", w.Config.VmwareengineBasePath, w.CommonOperationWaiter.Op.Name)
+}
+
 func (w *VmwareengineOperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
 	}
 	// Returns the proper get.
-	url := fmt.Sprintf("%s%s", w.Config.VmwareengineBasePath, w.CommonOperationWaiter.Op.Name)
+	url := w.OperationURL()
 
 	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
 		Config:               w.Config,
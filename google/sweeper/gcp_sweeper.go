@@ -4,11 +4,15 @@ package sweeper
 
 import (
 	"encoding/hex"
+	"encoding/json"
+	"flag"
 	"fmt"
 	"hash/crc32"
 	"log"
 	"runtime"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-provider-google/google/envvar"
 	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
@@ -32,6 +36,29 @@ var testResourcePrefixes = []string{
 	"ext-tf-test",         // Cloud Tasks Queues created automatically by tests for `google_firebase_extensions_instance`.
 }
 
+var (
+	flagSweepDryRun *bool
+	flagSweepMinAge *time.Duration
+	flagSweepLabels *string
+	flagSweepReport *string
+)
+
+func init() {
+	flagSweepDryRun = flag.Bool("sweep-dry-run", false, "List the resources the sweepers would delete without deleting them")
+	flagSweepMinAge = flag.Duration("sweep-min-age", 0, "Only sweep resources created at least this long ago, e.g. 24h. Resources whose create time is unknown are skipped")
+	flagSweepLabels = flag.String("sweep-labels", "", "Only sweep resources whose labels match this comma separated list of selectors: key=value, key!=value, key or !key")
+	flagSweepReport = flag.String("sweep-report", "", "Write a JSON report of the resources found, skipped, deleted and failed by each sweeper to this file")
+}
+
+// createTimeFields are the fields resources expose their create time in,
+// depending on the API.
+var createTimeFields = []string{
+	"createTime",
+	"creationTimestamp",
+	"timeCreated",
+	"creationTime",
+}
+
 // SharedConfigForRegion returns a common config setup needed for the sweeper
 // functions for a given region
 func SharedConfigForRegion(region string) (*transport_tpg.Config, error) {
@@ -48,6 +75,9 @@ func SharedConfigForRegion(region string) (*transport_tpg.Config, error) {
 		Credentials: envvar.GetTestCredsFromEnv(),
 		Region:      region,
 		Project:     project,
+		// Sweepers don't send requests that could modify resources in a dry
+		// run, this makes sure of it.
+		ReadOnly: options.dryRun,
 	}
 
 	transport_tpg.ConfigureBasePaths(conf)
@@ -55,10 +85,246 @@ func SharedConfigForRegion(region string) (*transport_tpg.Config, error) {
 	return conf, nil
 }
 
+// IsSweepableTestResource checks if the name of a resource has a test prefix.
+// Sweepers use IsSweepableResource, which also applies the sweeper flags.
 func IsSweepableTestResource(resourceName string) bool {
 	return hasAnyPrefix(resourceName, testResourcePrefixes)
 }
 
+// IsSweepableResource checks if a resource listed by a sweeper should be
+// swept: its name must have a test prefix, it must be older than
+// -sweep-min-age according to the create time in obj, and its labels in obj
+// must match -sweep-labels. The resource is recorded in the report of the
+// sweeper as found, and as skipped if it shouldn't be swept.
+func IsSweepableResource(name string, obj map[string]interface{}) bool {
+	return IsSweepableResourceOlderThan(name, obj, 0)
+}
+
+// IsSweepableResourceOlderThan checks if a resource listed by a sweeper
+// should be swept like IsSweepableResource, for sweepers that must not sweep
+// resources created less than minAge ago even when -sweep-min-age is shorter.
+func IsSweepableResourceOlderThan(name string, obj map[string]interface{}, minAge time.Duration) bool {
+	recordFound(name)
+	if !IsSweepableTestResource(name) {
+		recordSkipped(name, "name without test prefix")
+		return false
+	}
+	if options.minAge > minAge {
+		minAge = options.minAge
+	}
+	if minAge > 0 {
+		created, ok := resourceCreateTime(obj)
+		if !ok {
+			recordSkipped(name, "create time unknown")
+			return false
+		}
+		if age := options.now().Sub(created); age < minAge {
+			recordSkipped(name, fmt.Sprintf("created %s ago, less than -sweep-min-age %s", age.Round(time.Second), minAge))
+			return false
+		}
+	}
+	if len(options.labels) > 0 {
+		if !options.labels.matches(resourceLabels(obj)) {
+			recordSkipped(name, fmt.Sprintf("labels don't match -sweep-labels %s", options.labels))
+			return false
+		}
+	}
+	return true
+}
+
+// SendDeleteRequest sends the request deleting a resource found by a sweeper,
+// and records the resource in the report of the sweeper as deleted or failed.
+// The request isn't sent in a dry run.
+func SendDeleteRequest(opts transport_tpg.SendRequestOptions) (map[string]interface{}, error) {
+	name := opts.RawURL
+	if i := strings.Index(name, "?"); i >= 0 {
+		name = name[:i]
+	}
+	name = name[strings.LastIndex(name, "/")+1:]
+	// Drop the custom method of requests such as POST .../apps/{app}:remove.
+	if i := strings.Index(name, ":"); i >= 0 {
+		name = name[:i]
+	}
+
+	if options.dryRun {
+		log.Printf("[INFO][SWEEPER_LOG] Dry run, not sending delete request for url %s", opts.RawURL)
+		recordDeleted(name, opts.RawURL)
+		return nil, nil
+	}
+	res, err := transport_tpg.SendRequest(opts)
+	if err != nil {
+		recordFailed(name, opts.RawURL, err)
+		return nil, err
+	}
+	recordDeleted(name, opts.RawURL)
+	return res, nil
+}
+
+// IsSweepableDCLResource checks if a resource listed by a DCL DeleteAll call
+// should be swept, like IsSweepableResource. A sweepable resource is recorded
+// in the report of the sweeper as deleted, and isn't deleted in a dry run.
+// Errors of the DeleteAll call are recorded as errors of the sweeper.
+func IsSweepableDCLResource(name string, obj map[string]interface{}) bool {
+	if !IsSweepableResource(name, obj) {
+		return false
+	}
+	recordDeleted(name, "")
+	if options.dryRun {
+		log.Printf("[INFO][SWEEPER_LOG] Dry run, not deleting %s", name)
+		return false
+	}
+	return true
+}
+
+// DeleteResource deletes a resource found by a sweeper with deleteF, for
+// sweepers deleting resources through a client library rather than
+// SendDeleteRequest. The resource is recorded in the report of the sweeper as
+// deleted or failed, and deleteF isn't called in a dry run.
+func DeleteResource(name, url string, deleteF func() error) error {
+	if options.dryRun {
+		log.Printf("[INFO][SWEEPER_LOG] Dry run, not deleting %s", name)
+		recordDeleted(name, url)
+		return nil
+	}
+	if err := deleteF(); err != nil {
+		recordFailed(name, url, err)
+		return err
+	}
+	recordDeleted(name, url)
+	return nil
+}
+
+// ResourceObject returns the fields of a resource returned by a client
+// library, such as its create time and labels, as IsSweepableResource reads
+// them from a resource decoded from an API response.
+func ResourceObject(v interface{}) map[string]interface{} {
+	obj := make(map[string]interface{})
+	b, err := json.Marshal(v)
+	if err != nil {
+		log.Printf("[INFO][SWEEPER_LOG] Unable to read the fields of %T: %s", v, err)
+		return obj
+	}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		log.Printf("[INFO][SWEEPER_LOG] Unable to read the fields of %T: %s", v, err)
+	}
+	return obj
+}
+
+// resourceLabels returns the labels of a resource.
+func resourceLabels(obj map[string]interface{}) map[string]interface{} {
+	switch labels := obj["labels"].(type) {
+	case map[string]interface{}:
+		return labels
+	case map[string]string:
+		converted := make(map[string]interface{}, len(labels))
+		for k, v := range labels {
+			converted[k] = v
+		}
+		return converted
+	}
+	return nil
+}
+
+// resourceCreateTime returns the create time of a resource, from the first of
+// createTimeFields it has.
+func resourceCreateTime(obj map[string]interface{}) (time.Time, bool) {
+	for _, f := range createTimeFields {
+		v, ok := obj[f].(string)
+		if !ok || v == "" {
+			continue
+		}
+		if t, err := time.Parse(time.RFC3339Nano, v); err == nil {
+			return t, true
+		}
+		// Some APIs, such as BigQuery, use milliseconds since the epoch.
+		if ms, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return time.UnixMilli(ms), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// labelSelector is a list of requirements on the labels of a resource.
+type labelSelector []labelRequirement
+
+type labelRequirement struct {
+	key   string
+	value string
+	// exists is whether the label must be set, with value if it isn't empty.
+	exists bool
+	// equals is whether the label must be equal to value, or different.
+	equals bool
+}
+
+// parseLabelSelector parses a comma separated list of key=value, key!=value,
+// key and !key requirements.
+func parseLabelSelector(s string) (labelSelector, error) {
+	var selector labelSelector
+	if strings.TrimSpace(s) == "" {
+		return selector, nil
+	}
+	for _, r := range strings.Split(s, ",") {
+		r = strings.TrimSpace(r)
+		var req labelRequirement
+		switch {
+		case strings.Contains(r, "!="):
+			parts := strings.SplitN(r, "!=", 2)
+			req = labelRequirement{key: parts[0], value: parts[1]}
+		case strings.Contains(r, "="):
+			parts := strings.SplitN(r, "=", 2)
+			req = labelRequirement{key: parts[0], value: parts[1], exists: true, equals: true}
+		case strings.HasPrefix(r, "!"):
+			req = labelRequirement{key: r[1:]}
+		default:
+			req = labelRequirement{key: r, exists: true}
+		}
+		req.key = strings.TrimSpace(req.key)
+		req.value = strings.TrimSpace(req.value)
+		if req.key == "" {
+			return nil, fmt.Errorf("invalid label selector %q: empty label key in %q", s, r)
+		}
+		selector = append(selector, req)
+	}
+	return selector, nil
+}
+
+func (s labelSelector) matches(labels map[string]interface{}) bool {
+	for _, r := range s {
+		v, ok := labels[r.key]
+		value := fmt.Sprint(v)
+		switch {
+		case r.equals:
+			if !ok || value != r.value {
+				return false
+			}
+		case r.value != "":
+			if ok && value == r.value {
+				return false
+			}
+		case r.exists != ok:
+			return false
+		}
+	}
+	return true
+}
+
+func (s labelSelector) String() string {
+	var reqs []string
+	for _, r := range s {
+		switch {
+		case r.equals:
+			reqs = append(reqs, r.key+"="+r.value)
+		case r.value != "":
+			reqs = append(reqs, r.key+"!="+r.value)
+		case r.exists:
+			reqs = append(reqs, r.key)
+		default:
+			reqs = append(reqs, "!"+r.key)
+		}
+	}
+	return strings.Join(reqs, ",")
+}
+
 // hasAnyPrefix checks if the input string begins with any prefix from the given slice.
 // Returns true if a match is found, false otherwise.
 func hasAnyPrefix(input string, prefixes []string) bool {
@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package sweeper
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+)
+
+// sweepOptions are the settings of a sweeper run, from the sweeper flags.
+type sweepOptions struct {
+	dryRun bool
+	minAge time.Duration
+	labels labelSelector
+	now    func() time.Time
+}
+
+var options = sweepOptions{now: time.Now}
+
+// parseSweepOptions returns the settings of the sweeper flags.
+func parseSweepOptions() (sweepOptions, error) {
+	labels, err := parseLabelSelector(*flagSweepLabels)
+	if err != nil {
+		return sweepOptions{}, err
+	}
+	return sweepOptions{
+		dryRun: *flagSweepDryRun,
+		minAge: *flagSweepMinAge,
+		labels: labels,
+		now:    time.Now,
+	}, nil
+}
+
+// Report is the machine-readable summary of a sweeper run, written to the
+// file of the -sweep-report flag.
+type Report struct {
+	DryRun   bool             `json:"dryRun"`
+	MinAge   string           `json:"minAge,omitempty"`
+	Labels   string           `json:"labels,omitempty"`
+	Sweepers []*SweeperReport `json:"sweepers"`
+}
+
+// SweeperReport lists the resources found, skipped, deleted and failed by a
+// sweeper, in every region it ran in. Resources are recorded by
+// IsSweepableResource, IsSweepableDCLResource, SendDeleteRequest and
+// DeleteResource.
+type SweeperReport struct {
+	Name    string           `json:"name"`
+	Regions []string         `json:"regions"`
+	Found   []ReportResource `json:"found"`
+	Skipped []ReportResource `json:"skipped"`
+	Deleted []ReportResource `json:"deleted"`
+	Failed  []ReportResource `json:"failed"`
+	Errors  []string         `json:"errors,omitempty"`
+}
+
+// ReportResource is a resource in a SweeperReport. Reason is set for skipped
+// resources, and Error for failed ones.
+type ReportResource struct {
+	Name   string `json:"name"`
+	Region string `json:"region"`
+	Url    string `json:"url,omitempty"`
+	Reason string `json:"reason,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+var reportLock = sync.Mutex{}
+
+var report *Report
+
+// The sweeper and region running, the resources are recorded for.
+var (
+	currentSweeper *SweeperReport
+	currentRegion  string
+)
+
+func newReport(o sweepOptions) *Report {
+	r := &Report{
+		DryRun:   o.dryRun,
+		Labels:   o.labels.String(),
+		Sweepers: []*SweeperReport{},
+	}
+	if o.minAge > 0 {
+		r.MinAge = o.minAge.String()
+	}
+	return r
+}
+
+// startSweeper records the resources of the following calls in the report of
+// the sweeper for the region.
+func startSweeper(name, region string) {
+	reportLock.Lock()
+	defer reportLock.Unlock()
+	if report == nil {
+		return
+	}
+	currentRegion = region
+	if currentSweeper == nil || currentSweeper.Name != name {
+		currentSweeper = &SweeperReport{
+			Name:    name,
+			Found:   []ReportResource{},
+			Skipped: []ReportResource{},
+			Deleted: []ReportResource{},
+			Failed:  []ReportResource{},
+		}
+		report.Sweepers = append(report.Sweepers, currentSweeper)
+	}
+	currentSweeper.Regions = append(currentSweeper.Regions, region)
+}
+
+func record(f func(s *SweeperReport)) {
+	reportLock.Lock()
+	defer reportLock.Unlock()
+	if currentSweeper != nil {
+		f(currentSweeper)
+	}
+}
+
+func recordFound(name string) {
+	record(func(s *SweeperReport) {
+		s.Found = append(s.Found, ReportResource{Name: name, Region: currentRegion})
+	})
+}
+
+func recordSkipped(name, reason string) {
+	record(func(s *SweeperReport) {
+		s.Skipped = append(s.Skipped, ReportResource{Name: name, Region: currentRegion, Reason: reason})
+	})
+}
+
+func recordDeleted(name, url string) {
+	record(func(s *SweeperReport) {
+		s.Deleted = append(s.Deleted, ReportResource{Name: name, Region: currentRegion, Url: url})
+	})
+}
+
+func recordFailed(name, url string, err error) {
+	record(func(s *SweeperReport) {
+		s.Failed = append(s.Failed, ReportResource{Name: name, Region: currentRegion, Url: url, Error: err.Error()})
+	})
+}
+
+func recordSweeperError(err error) {
+	record(func(s *SweeperReport) {
+		s.Errors = append(s.Errors, currentRegion+": "+err.Error())
+	})
+}
+
+func writeReport(path string) error {
+	reportLock.Lock()
+	defer reportLock.Unlock()
+	b, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(b, '\n'), 0644)
+}
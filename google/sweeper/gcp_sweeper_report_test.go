@@ -0,0 +1,353 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package sweeper
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+func TestParseLabelSelector(t *testing.T) {
+	testCases := []struct {
+		name     string
+		selector string
+		labels   map[string]interface{}
+		matches  bool
+	}{
+		{
+			name:     "empty",
+			selector: "",
+			labels:   nil,
+			matches:  true,
+		},
+		{
+			name:     "equals",
+			selector: "env=sandbox",
+			labels:   map[string]interface{}{"env": "sandbox"},
+			matches:  true,
+		},
+		{
+			name:     "equals_other_value",
+			selector: "env=sandbox",
+			labels:   map[string]interface{}{"env": "prod"},
+			matches:  false,
+		},
+		{
+			name:     "equals_without_labels",
+			selector: "env=sandbox",
+			labels:   nil,
+			matches:  false,
+		},
+		{
+			name:     "not_equals",
+			selector: "env!=prod",
+			labels:   nil,
+			matches:  true,
+		},
+		{
+			name:     "not_equals_same_value",
+			selector: "env!=prod",
+			labels:   map[string]interface{}{"env": "prod"},
+			matches:  false,
+		},
+		{
+			name:     "exists",
+			selector: "owner",
+			labels:   map[string]interface{}{"owner": ""},
+			matches:  true,
+		},
+		{
+			name:     "not_exists",
+			selector: "!keep",
+			labels:   map[string]interface{}{"keep": "true"},
+			matches:  false,
+		},
+		{
+			name:     "all_requirements",
+			selector: "env=sandbox, !keep",
+			labels:   map[string]interface{}{"env": "sandbox", "keep": "true"},
+			matches:  false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			selector, err := parseLabelSelector(tc.selector)
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if got := selector.matches(tc.labels); got != tc.matches {
+				t.Errorf("Expected %q matching %v to be %t, got %t", tc.selector, tc.labels, tc.matches, got)
+			}
+		})
+	}
+
+	if _, err := parseLabelSelector("env=sandbox,=prod"); err == nil {
+		t.Errorf("Expected an error for an empty label key")
+	}
+}
+
+func TestRunSweepers_Report(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	defer func() {
+		options = sweepOptions{now: time.Now}
+		report = nil
+		currentSweeper = nil
+	}()
+	labels, _ := parseLabelSelector("!keep")
+	options = sweepOptions{
+		dryRun: true,
+		minAge: 24 * time.Hour,
+		labels: labels,
+		now:    func() time.Time { return now },
+	}
+	report = newReport(options)
+	currentSweeper = nil
+
+	resources := []map[string]interface{}{
+		{"name": "tf-test-old", "createTime": "2024-05-01T00:00:00Z"},
+		{"name": "tf-test-new", "createTime": "2024-06-01T11:00:00Z"},
+		{"name": "tf-test-kept", "creationTimestamp": "2024-05-01T00:00:00.000-07:00", "labels": map[string]interface{}{"keep": "true"}},
+		{"name": "tf-test-unknown-age"},
+		{"name": "production"},
+	}
+	sweepers := map[string]*Sweeper{
+		"Test": {
+			Name: "Test",
+			F: func(region string) error {
+				for _, obj := range resources {
+					name := obj["name"].(string)
+					if !IsSweepableResource(name, obj) {
+						continue
+					}
+					if _, err := SendDeleteRequest(transport_tpg.SendRequestOptions{
+						Method: "DELETE",
+						RawURL: "https://example.googleapis.com/v1/projects/p/locations/" + region + "/things/" + name + "?force=true",
+					}); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		},
+	}
+
+	if err := runSweepers(t, []string{"us-central1"}, sweepers, true); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "report.json")
+	if err := writeReport(path); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got Report
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := Report{
+		DryRun: true,
+		MinAge: "24h0m0s",
+		Labels: "!keep",
+		Sweepers: []*SweeperReport{
+			{
+				Name:    "Test",
+				Regions: []string{"us-central1"},
+				Found: []ReportResource{
+					{Name: "tf-test-old", Region: "us-central1"},
+					{Name: "tf-test-new", Region: "us-central1"},
+					{Name: "tf-test-kept", Region: "us-central1"},
+					{Name: "tf-test-unknown-age", Region: "us-central1"},
+					{Name: "production", Region: "us-central1"},
+				},
+				Skipped: []ReportResource{
+					{Name: "tf-test-new", Region: "us-central1", Reason: "created 1h0m0s ago, less than -sweep-min-age 24h0m0s"},
+					{Name: "tf-test-kept", Region: "us-central1", Reason: "labels don't match -sweep-labels !keep"},
+					{Name: "tf-test-unknown-age", Region: "us-central1", Reason: "create time unknown"},
+					{Name: "production", Region: "us-central1", Reason: "name without test prefix"},
+				},
+				Deleted: []ReportResource{
+					{Name: "tf-test-old", Region: "us-central1", Url: "https://example.googleapis.com/v1/projects/p/locations/us-central1/things/tf-test-old?force=true"},
+				},
+				Failed: []ReportResource{},
+			},
+		},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected report:\n%+v\ngot:\n%s", expected, b)
+	}
+}
+
+func TestRunSweepers_ClientLibraryReport(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	defer func() {
+		options = sweepOptions{now: time.Now}
+		report = nil
+		currentSweeper = nil
+	}()
+	labels, _ := parseLabelSelector("!keep")
+	options = sweepOptions{
+		minAge: 24 * time.Hour,
+		labels: labels,
+		now:    func() time.Time { return now },
+	}
+	report = newReport(options)
+	currentSweeper = nil
+
+	// instance is shaped like the resources returned by client libraries.
+	type instance struct {
+		Name              string            `json:"name,omitempty"`
+		CreationTimestamp string            `json:"creationTimestamp,omitempty"`
+		Labels            map[string]string `json:"labels,omitempty"`
+	}
+	instances := []*instance{
+		{Name: "tf-test-old", CreationTimestamp: "2024-05-01T00:00:00Z"},
+		{Name: "tf-test-new", CreationTimestamp: "2024-06-01T11:00:00Z"},
+		{Name: "tf-test-kept", CreationTimestamp: "2024-05-01T00:00:00Z", Labels: map[string]string{"keep": "true"}},
+		{Name: "tf-test-failed", CreationTimestamp: "2024-05-01T00:00:00Z"},
+	}
+	var deleted []string
+	sweepers := map[string]*Sweeper{
+		"ClientLibrary": {
+			Name: "ClientLibrary",
+			F: func(region string) error {
+				for _, i := range instances {
+					if !IsSweepableResource(i.Name, ResourceObject(i)) {
+						continue
+					}
+					name := i.Name
+					_ = DeleteResource(name, "projects/p/instances/"+name, func() error {
+						if name == "tf-test-failed" {
+							return errors.New("permission denied")
+						}
+						deleted = append(deleted, name)
+						return nil
+					})
+				}
+				return nil
+			},
+		},
+		"DCL": {
+			Name: "DCL",
+			F: func(region string) error {
+				// DeleteAll calls delete the resources their filter returns
+				// true for.
+				for _, i := range instances[:2] {
+					if IsSweepableDCLResource(i.Name, ResourceObject(i)) {
+						deleted = append(deleted, "dcl/"+i.Name)
+					}
+				}
+				return nil
+			},
+		},
+	}
+
+	if err := runSweepers(t, []string{"us-central1"}, sweepers, true); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if expected := []string{"tf-test-old", "dcl/tf-test-old"}; !reflect.DeepEqual(deleted, expected) {
+		t.Errorf("Expected %v to be deleted, got %v", expected, deleted)
+	}
+	reports := make(map[string]*SweeperReport)
+	for _, s := range report.Sweepers {
+		reports[s.Name] = s
+	}
+	clientLibrary := reports["ClientLibrary"]
+	if len(clientLibrary.Found) != 4 || len(clientLibrary.Skipped) != 2 {
+		t.Errorf("Expected 4 resources found and 2 skipped, got %+v", clientLibrary)
+	}
+	if expected := []ReportResource{{Name: "tf-test-old", Region: "us-central1", Url: "projects/p/instances/tf-test-old"}}; !reflect.DeepEqual(clientLibrary.Deleted, expected) {
+		t.Errorf("Expected deleted %+v, got %+v", expected, clientLibrary.Deleted)
+	}
+	if expected := []ReportResource{{Name: "tf-test-failed", Region: "us-central1", Url: "projects/p/instances/tf-test-failed", Error: "permission denied"}}; !reflect.DeepEqual(clientLibrary.Failed, expected) {
+		t.Errorf("Expected failed %+v, got %+v", expected, clientLibrary.Failed)
+	}
+	if expected := []ReportResource{{Name: "tf-test-old", Region: "us-central1"}}; !reflect.DeepEqual(reports["DCL"].Deleted, expected) {
+		t.Errorf("Expected deleted %+v, got %+v", expected, reports["DCL"].Deleted)
+	}
+}
+
+func TestDeleteResource_DryRun(t *testing.T) {
+	defer func() {
+		options = sweepOptions{now: time.Now}
+	}()
+	options = sweepOptions{dryRun: true, now: time.Now}
+
+	err := DeleteResource("tf-test-instance", "projects/p/instances/tf-test-instance", func() error {
+		t.Fatal("Expected no delete in a dry run")
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if IsSweepableDCLResource("tf-test-instance", map[string]interface{}{}) {
+		t.Errorf("Expected DCL resources not to be deleted in a dry run")
+	}
+}
+
+func TestIsSweepableResourceOlderThan(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	defer func() {
+		options = sweepOptions{now: time.Now}
+	}()
+
+	cases := map[string]struct {
+		flagMinAge time.Duration
+		minAge     time.Duration
+		obj        map[string]interface{}
+		expected   bool
+	}{
+		"no min age, unknown create time": {
+			obj:      map[string]interface{}{},
+			expected: true,
+		},
+		"flag min age, unknown create time": {
+			flagMinAge: time.Hour,
+			obj:        map[string]interface{}{},
+			expected:   false,
+		},
+		"sweeper min age, unknown create time": {
+			minAge:   time.Hour,
+			obj:      map[string]interface{}{"createTime": "not a time"},
+			expected: false,
+		},
+		"sweeper min age longer than flag": {
+			flagMinAge: time.Hour,
+			minAge:     24 * time.Hour,
+			obj:        map[string]interface{}{"createTime": "2024-06-01T00:00:00Z"},
+			expected:   false,
+		},
+		"flag min age longer than sweeper": {
+			flagMinAge: 24 * time.Hour,
+			minAge:     time.Hour,
+			obj:        map[string]interface{}{"createTime": "2024-06-01T00:00:00Z"},
+			expected:   false,
+		},
+		"older than both": {
+			flagMinAge: time.Hour,
+			minAge:     2 * time.Hour,
+			obj:        map[string]interface{}{"createTime": "2024-06-01T00:00:00Z"},
+			expected:   true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			options = sweepOptions{minAge: tc.flagMinAge, now: func() time.Time { return now }}
+			if got := IsSweepableResourceOlderThan("tf-test-thing", tc.obj, tc.minAge); got != tc.expected {
+				t.Errorf("Expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
@@ -124,6 +124,19 @@ func addTestSweepers(name string, s *Sweeper) {
 //	-sweep-allow-failues: Enable to allow other sweepers to run after failures.
 //	-sweep-run: Comma-separated list of resource type sweepers to run. Defaults
 //	        to all sweepers.
+//	-sweep-dry-run: Enable to list the resources the sweepers would delete
+//	        without deleting them.
+//	-sweep-min-age: Only sweep resources created at least this long ago, e.g.
+//	        24h. Resources whose create time isn't known are skipped.
+//	-sweep-labels: Comma-separated list of label selectors (key=value,
+//	        key!=value, key or !key) the resources to sweep must match.
+//	-sweep-report: Path of a JSON file to write the resources found, skipped,
+//	        deleted and failed by each sweeper to.
+//
+// Sweepers apply the filters with IsSweepableResource, or
+// IsSweepableDCLResource for DCL DeleteAll calls, and delete resources with
+// SendDeleteRequest, or DeleteResource for client library calls, so that the
+// filters, the dry run and the report apply to every sweeper.
 //
 // Refer to the Env prefixed constants for environment variables that further
 // control testing functionality.
@@ -137,9 +150,23 @@ func ExecuteSweepers(t *testing.T) {
 		// get filtered list of sweepers to run based on sweep-run flag
 		sweepers := filterSweepers(*flagSweepRun, sweeperFuncs)
 
+		o, err := parseSweepOptions()
+		if err != nil {
+			t.Fatal(err)
+		}
+		options = o
+		report = newReport(options)
+		currentSweeper = nil
+
 		if err := runSweepers(t, regions, sweepers, *flagSweepAllowFailures); err != nil {
 			os.Exit(1)
 		}
+
+		if *flagSweepReport != "" {
+			if err := writeReport(*flagSweepReport); err != nil {
+				t.Fatalf("failed to write sweeper report: %s", err)
+			}
+		}
 	} else {
 		t.Skip("skipping sweeper run. No region supplied")
 	}
@@ -160,6 +187,7 @@ func runSweepers(t *testing.T, regions []string, sweepers map[string]*Sweeper, a
 				region := strings.TrimSpace(region)
 				log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", sweeper.Name, region)
 
+				startSweeper(sweeper.Name, region)
 				start := time.Now()
 				err := sweeper.F(region)
 				elapsed := time.Since(start)
@@ -167,6 +195,7 @@ func runSweepers(t *testing.T, regions []string, sweepers map[string]*Sweeper, a
 				log.Printf("[DEBUG] Completed Sweeper (%s) in region (%s) in %s", sweeper.Name, region, elapsed)
 
 				if err != nil {
+					recordSweeperError(err)
 					log.Printf("[ERROR] Error running Sweeper (%s) in region (%s): %s", sweeper.Name, region, err)
 					if allowFailures {
 						t.Errorf("failed in region %s: %s", region, err)
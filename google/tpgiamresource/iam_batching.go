@@ -4,6 +4,9 @@ package tpgiamresource
 
 import (
 	"fmt"
+	"log"
+	"regexp"
+	"strings"
 	"time"
 
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
@@ -14,48 +17,213 @@ const (
 	batchKeyTmplModifyIamPolicy = "%s modifyIamPolicy"
 )
 
-func BatchRequestModifyIamPolicy(updater ResourceIamUpdater, modify iamPolicyModifyFunc, config *transport_tpg.Config, reqDesc string) error {
+// iamResourceTypeSuffixes are the suffixes of the types of Terraform resources
+// changing part of the IAM policy of their parent resource.
+var iamResourceTypeSuffixes = []string{
+	"_iam_member",
+	"_iam_binding",
+	"_iam_audit_config",
+}
+
+// IamParentType returns the type of the parent resource whose IAM policy is
+// changed by resources of the given Terraform resource type, e.g.
+// "google_storage_bucket" for "google_storage_bucket_iam_member". It returns
+// "" for resource types that don't change part of an IAM policy.
+func IamParentType(resourceType string) string {
+	for _, suffix := range iamResourceTypeSuffixes {
+		if strings.HasSuffix(resourceType, suffix) {
+			return strings.TrimSuffix(resourceType, suffix)
+		}
+	}
+	return ""
+}
+
+// useIamBatching reports whether changes to the IAM policy of a parent are
+// batched, enableBatching being whether they are for the resource type
+// unless configured otherwise in the provider's `batching` block.
+func useIamBatching(config *transport_tpg.Config, enableBatching bool) bool {
+	if config.RequestBatcherIam == nil {
+		return false
+	}
+	return config.BatchingConfig.BatchesIamPolicyChanges(IamParentType(config.ResourceType()), enableBatching)
+}
+
+// batchedIamPolicyModifier is the change to an IAM policy requested by a
+// single resource as part of a batch.
+type batchedIamPolicyModifier struct {
+	modify iamPolicyModifyFunc
+	// members are the members added by modify, used to find which changes
+	// were rejected by errors naming a member.
+	members []string
+	debugId string
+}
+
+// batchedIamPolicyErrors are the errors of the changes of a batch that were
+// rejected while the rest of the batch was applied.
+type batchedIamPolicyErrors map[*batchedIamPolicyModifier]error
+
+// BatchRequestModifyIamPolicy applies modify to the IAM policy of the parent
+// of updater, along with the changes of other resources of the same parent
+// sent at the same time. members are the members added by modify, if any,
+// so that an error naming one of them is only reported for this change.
+func BatchRequestModifyIamPolicy(updater ResourceIamUpdater, modify iamPolicyModifyFunc, config *transport_tpg.Config, reqDesc string, members ...string) error {
 	batchKey := fmt.Sprintf(batchKeyTmplModifyIamPolicy, updater.GetMutexKey())
 
+	modifier := &batchedIamPolicyModifier{
+		modify:  modify,
+		members: members,
+		debugId: reqDesc,
+	}
 	request := &transport_tpg.BatchRequest{
 		ResourceName: updater.GetResourceId(),
-		Body:         []iamPolicyModifyFunc{modify},
+		Body:         []*batchedIamPolicyModifier{modifier},
 		CombineF:     combineBatchIamPolicyModifiers,
 		SendF:        sendBatchModifyIamPolicy(updater),
 		DebugId:      reqDesc,
 	}
 
-	_, err := config.RequestBatcherIam.SendRequestWithTimeout(batchKey, request, time.Minute*30)
-	return err
+	resp, err := config.RequestBatcherIam.SendRequestWithTimeout(batchKey, request, time.Minute*30)
+	if err != nil {
+		return err
+	}
+	if errs, ok := resp.(batchedIamPolicyErrors); ok {
+		return errs[modifier]
+	}
+	return nil
 }
 
 func combineBatchIamPolicyModifiers(currV interface{}, toAddV interface{}) (interface{}, error) {
-	currModifiers, ok := currV.([]iamPolicyModifyFunc)
+	currModifiers, ok := currV.([]*batchedIamPolicyModifier)
 	if !ok {
-		return nil, fmt.Errorf("provider error in batch combiner: expected data to be type []iamPolicyModifyFunc, got %v with type %T", currV, currV)
+		return nil, fmt.Errorf("provider error in batch combiner: expected data to be type []*batchedIamPolicyModifier, got %v with type %T", currV, currV)
 	}
 
-	newModifiers, ok := toAddV.([]iamPolicyModifyFunc)
+	newModifiers, ok := toAddV.([]*batchedIamPolicyModifier)
 	if !ok {
-		return nil, fmt.Errorf("provider error in batch combiner: expected data to be type []iamPolicyModifyFunc, got %v with type %T", currV, currV)
+		return nil, fmt.Errorf("provider error in batch combiner: expected data to be type []*batchedIamPolicyModifier, got %v with type %T", toAddV, toAddV)
 	}
 
 	return append(currModifiers, newModifiers...), nil
 }
 
+// sendBatchModifyIamPolicy applies the changes of a batch in a single
+// read-modify-write cycle. If the policy is rejected because of members added
+// by some of the changes, these changes fail with the error and the cycle is
+// run again with the rest of the batch. Errors that can't be attributed to
+// specific changes fail the whole batch.
 func sendBatchModifyIamPolicy(updater ResourceIamUpdater) transport_tpg.BatcherSendFunc {
 	return func(resourceName string, body interface{}) (interface{}, error) {
-		modifiers, ok := body.([]iamPolicyModifyFunc)
+		modifiers, ok := body.([]*batchedIamPolicyModifier)
 		if !ok {
-			return nil, fmt.Errorf("provider error: expected data to be type []iamPolicyModifyFunc, got %v with type %T", body, body)
+			return nil, fmt.Errorf("provider error: expected data to be type []*batchedIamPolicyModifier, got %v with type %T", body, body)
 		}
-		return nil, iamPolicyReadModifyWrite(updater, func(policy *cloudresourcemanager.Policy) error {
-			for _, modifyF := range modifiers {
-				if err := modifyF(policy); err != nil {
-					return err
+
+		errs := make(batchedIamPolicyErrors)
+		for len(modifiers) > 0 {
+			err := iamPolicyReadModifyWrite(updater, func(policy *cloudresourcemanager.Policy) error {
+				for _, m := range modifiers {
+					if err := m.modify(policy); err != nil {
+						return err
+					}
 				}
+				return nil
+			})
+			if err == nil {
+				break
 			}
-			return nil
-		})
+
+			rejected, remaining := partitionRejectedIamPolicyModifiers(err, modifiers)
+			if len(rejected) == 0 {
+				return nil, err
+			}
+			for _, m := range rejected {
+				log.Printf("[DEBUG] IAM policy change %q was rejected: %s", m.debugId, err)
+				errs[m] = err
+			}
+			if len(remaining) > 0 {
+				log.Printf("[DEBUG] Applying the %d remaining IAM policy changes for %s again", len(remaining), updater.DescribeResource())
+			}
+			modifiers = remaining
+		}
+		return errs, nil
+	}
+}
+
+// partitionRejectedIamPolicyModifiers splits modifiers into the ones adding
+// a member named by a 400 error rejecting a policy, and the others. No
+// modifier is rejected if the error names an identity alone, e.g.
+// "jane@example.com", that more than one member of the batch has, e.g.
+// "user:jane@example.com" and "group:jane@example.com", so that the whole
+// batch fails rather than the error being attributed to the wrong change.
+func partitionRejectedIamPolicyModifiers(err error, modifiers []*batchedIamPolicyModifier) (rejected, remaining []*batchedIamPolicyModifier) {
+	if !transport_tpg.IsGoogleApiErrorWithCode(err, 400) {
+		return nil, modifiers
+	}
+	tokens := iamMemberTokens(err.Error())
+	identityMembers := make(map[string]map[string]bool)
+	for _, m := range modifiers {
+		for _, member := range m.members {
+			member = strings.ToLower(member)
+			identity := iamMemberIdentity(member)
+			if tokens[member] || !tokens[identity] {
+				continue
+			}
+			if identityMembers[identity] == nil {
+				identityMembers[identity] = make(map[string]bool)
+			}
+			identityMembers[identity][member] = true
+			if len(identityMembers[identity]) > 1 {
+				log.Printf("[DEBUG] IAM policy error names %q, which more than one member of the batch has: %s", identity, err)
+				return nil, modifiers
+			}
+		}
+	}
+	for _, m := range modifiers {
+		if namesIamMember(tokens, m.members) {
+			rejected = append(rejected, m)
+		} else {
+			remaining = append(remaining, m)
+		}
+	}
+	return rejected, remaining
+}
+
+// iamMemberTokenRe matches the words of an error message that may be members
+// or identities, e.g. "user:jane@example.com" or "example.com".
+var iamMemberTokenRe = regexp.MustCompile(`[a-z0-9@._:/+~%=-]+`)
+
+// iamMemberTokens returns the words of an error message, lowercase, that may
+// be members or identities.
+func iamMemberTokens(msg string) map[string]bool {
+	tokens := make(map[string]bool)
+	for _, t := range iamMemberTokenRe.FindAllString(strings.ToLower(msg), -1) {
+		// Words may end a sentence, e.g. "User jane@example.com.".
+		if t = strings.TrimRight(t, ".:"); t != "" {
+			tokens[t] = true
+		}
+	}
+	return tokens
+}
+
+// iamMemberIdentity returns the identity of a member without its type, e.g.
+// "jane@example.com" for "user:jane@example.com".
+func iamMemberIdentity(member string) string {
+	return member[strings.Index(member, ":")+1:]
+}
+
+// namesIamMember reports whether the words of an error message tokens name one
+// of members as a whole word, either in full (e.g. "user:jane@example.com") or
+// by its identity alone (e.g. "jane@example.com").
+func namesIamMember(tokens map[string]bool, members []string) bool {
+	for _, member := range members {
+		member = strings.ToLower(member)
+		identity := iamMemberIdentity(member)
+		if identity == "" {
+			continue
+		}
+		if tokens[member] || tokens[identity] {
+			return true
+		}
 	}
+	return false
 }
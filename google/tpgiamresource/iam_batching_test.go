@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package tpgiamresource
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/googleapi"
+)
+
+// testIamUpdater keeps a policy in memory, rejecting policies granting a role
+// to a principal that doesn't exist.
+type testIamUpdater struct {
+	policy  *cloudresourcemanager.Policy
+	missing string
+	sets    int
+}
+
+func (u *testIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
+	p := &cloudresourcemanager.Policy{Etag: u.policy.Etag}
+	for _, b := range u.policy.Bindings {
+		p.Bindings = append(p.Bindings, &cloudresourcemanager.Binding{
+			Role:    b.Role,
+			Members: append([]string{}, b.Members...),
+		})
+	}
+	return p, nil
+}
+
+func (u *testIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) error {
+	u.sets++
+	for _, b := range policy.Bindings {
+		for _, m := range b.Members {
+			if m == u.missing {
+				return &googleapi.Error{
+					Code:    400,
+					Message: fmt.Sprintf("Invalid argument: 'User %s does not exist.'", m[strings.Index(m, ":")+1:]),
+				}
+			}
+		}
+	}
+	u.policy = policy
+	return nil
+}
+
+func (u *testIamUpdater) GetMutexKey() string {
+	return "iam-test-resource"
+}
+
+func (u *testIamUpdater) GetResourceId() string {
+	return "test-resource"
+}
+
+func (u *testIamUpdater) DescribeResource() string {
+	return `test resource "test-resource"`
+}
+
+func addMemberModifier(role, member string) *batchedIamPolicyModifier {
+	binding := &cloudresourcemanager.Binding{Role: role, Members: []string{member}}
+	return &batchedIamPolicyModifier{
+		modify: func(p *cloudresourcemanager.Policy) error {
+			p.Bindings = MergeBindings(append(p.Bindings, binding))
+			return nil
+		},
+		members: binding.Members,
+		debugId: "add " + member,
+	}
+}
+
+func TestSendBatchModifyIamPolicy_rejectedMember(t *testing.T) {
+	updater := &testIamUpdater{
+		policy:  &cloudresourcemanager.Policy{},
+		missing: "user:ghost@example.com",
+	}
+	alice := addMemberModifier("roles/viewer", "user:alice@example.com")
+	ghost := addMemberModifier("roles/viewer", "user:ghost@example.com")
+	bob := addMemberModifier("roles/editor", "user:bob@example.com")
+
+	resp, err := sendBatchModifyIamPolicy(updater)("test-resource", []*batchedIamPolicyModifier{alice, ghost, bob})
+	if err != nil {
+		t.Fatalf("expected the batch to be applied without the rejected change, got %v", err)
+	}
+	errs := resp.(batchedIamPolicyErrors)
+	if errs[ghost] == nil {
+		t.Errorf("expected the change adding the missing member to fail")
+	}
+	if errs[alice] != nil || errs[bob] != nil {
+		t.Errorf("expected the other changes to succeed, got %v and %v", errs[alice], errs[bob])
+	}
+	if updater.sets != 2 {
+		t.Errorf("expected the policy to be set twice, got %d", updater.sets)
+	}
+
+	expected := []*cloudresourcemanager.Binding{
+		{Role: "roles/editor", Members: []string{"user:bob@example.com"}},
+		{Role: "roles/viewer", Members: []string{"user:alice@example.com"}},
+	}
+	if !CompareBindings(updater.policy.Bindings, expected) {
+		t.Errorf("expected bindings %s, got %s", DebugPrintBindings(expected), DebugPrintBindings(updater.policy.Bindings))
+	}
+}
+
+func TestSendBatchModifyIamPolicy_unattributedError(t *testing.T) {
+	updater := &testIamUpdater{
+		policy:  &cloudresourcemanager.Policy{},
+		missing: "user:ghost@example.com",
+	}
+	// The error names a member not added by the batch, so it fails the whole
+	// batch and each change is sent again on its own by the batcher.
+	updater.policy.Bindings = []*cloudresourcemanager.Binding{
+		{Role: "roles/owner", Members: []string{"user:ghost@example.com"}},
+	}
+	alice := addMemberModifier("roles/viewer", "user:alice@example.com")
+
+	if _, err := sendBatchModifyIamPolicy(updater)("test-resource", []*batchedIamPolicyModifier{alice}); err == nil {
+		t.Fatalf("expected the batch to fail")
+	}
+}
+
+func TestSendBatchModifyIamPolicy_overlappingIdentities(t *testing.T) {
+	cases := map[string]struct {
+		missing     string
+		other       string
+		expectBatch bool
+	}{
+		"identity ending with another": {
+			missing: "user:ba@example.com",
+			other:   "user:a@example.com",
+		},
+		"user of a domain": {
+			missing: "user:jane@example.com",
+			other:   "domain:example.com",
+		},
+		"domain of a user": {
+			missing: "domain:example.com",
+			other:   "user:jane@example.com",
+		},
+		"same identity, other type": {
+			missing:     "user:jane@example.com",
+			other:       "group:jane@example.com",
+			expectBatch: true,
+		},
+	}
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			updater := &testIamUpdater{
+				policy:  &cloudresourcemanager.Policy{},
+				missing: tc.missing,
+			}
+			other := addMemberModifier("roles/viewer", tc.other)
+			missing := addMemberModifier("roles/viewer", tc.missing)
+
+			resp, err := sendBatchModifyIamPolicy(updater)("test-resource", []*batchedIamPolicyModifier{other, missing})
+			if tc.expectBatch {
+				// The error can't be attributed, so the batch fails and each
+				// change is sent again on its own by the batcher.
+				if err == nil {
+					t.Fatalf("expected the batch to fail")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected the batch to be applied without the rejected change, got %v", err)
+			}
+			errs := resp.(batchedIamPolicyErrors)
+			if errs[missing] == nil {
+				t.Errorf("expected the change adding %s to fail", tc.missing)
+			}
+			if errs[other] != nil {
+				t.Errorf("expected the change adding %s to succeed, got %v", tc.other, errs[other])
+			}
+		})
+	}
+}
+
+func TestIamParentType(t *testing.T) {
+	cases := map[string]string{
+		"google_storage_bucket_iam_member":        "google_storage_bucket",
+		"google_pubsub_topic_iam_binding":         "google_pubsub_topic",
+		"google_project_iam_audit_config":         "google_project",
+		"google_storage_bucket_iam_policy":        "",
+		"google_storage_bucket":                   "",
+		"google_organization_iam_custom_role":     "",
+		"google_secret_manager_secret_iam_member": "google_secret_manager_secret",
+	}
+	for resourceType, expected := range cases {
+		if got := IamParentType(resourceType); got != expected {
+			t.Errorf("expected parent type of %q to be %q, got %q", resourceType, expected, got)
+		}
+	}
+}
@@ -1106,3 +1106,44 @@ func TestIamListFromIamAuditConfigsMap(t *testing.T) {
 		}
 	}
 }
+
+func TestIamFlattenPolicyPlannedChanges(t *testing.T) {
+	condition := &cloudresourcemanager.Expr{Title: "expires", Expression: "request.time < timestamp(\"2030-01-01T00:00:00Z\")"}
+	old := []*cloudresourcemanager.Binding{
+		{Role: "roles/viewer", Members: []string{"user:jane@example.com", "user:bob@example.com"}},
+		{Role: "roles/editor", Members: []string{"user:Admin@example.com"}},
+		{Role: "roles/owner", Members: []string{"user:owner@example.com"}},
+	}
+	new := []*cloudresourcemanager.Binding{
+		{Role: "roles/viewer", Members: []string{"user:jane@example.com", "user:alice@example.com"}},
+		{Role: "roles/editor", Members: []string{"user:admin@example.com"}},
+		{Role: "roles/editor", Members: []string{"group:ops@example.com"}, Condition: condition},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"role":                 "roles/editor",
+			"condition_title":      "expires",
+			"condition_expression": condition.Expression,
+			"members_added":        []string{"group:ops@example.com"},
+			"members_removed":      []string{},
+		},
+		{
+			"role":                 "roles/owner",
+			"condition_title":      "",
+			"condition_expression": "",
+			"members_added":        []string{},
+			"members_removed":      []string{"user:owner@example.com"},
+		},
+		{
+			"role":                 "roles/viewer",
+			"condition_title":      "",
+			"condition_expression": "",
+			"members_added":        []string{"user:alice@example.com"},
+			"members_removed":      []string{"user:bob@example.com"},
+		},
+	}
+	if got := flattenIamPolicyPlannedChanges(old, new); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected planned changes %v, got %v", expected, got)
+	}
+}
@@ -152,7 +152,7 @@ func resourceIamAuditConfigCreateUpdate(newUpdaterFunc NewResourceIamUpdaterFunc
 			ep.AuditConfigs = append(cleaned, ac)
 			return nil
 		}
-		if enableBatching {
+		if useIamBatching(config, enableBatching) {
 			err = BatchRequestModifyIamPolicy(updater, modifyF, config, fmt.Sprintf(
 				"Overwrite audit config for service %s on resource %q", ac.Service, updater.DescribeResource()))
 		} else {
@@ -180,7 +180,7 @@ func resourceIamAuditConfigDelete(newUpdaterFunc NewResourceIamUpdaterFunc, enab
 			ep.AuditConfigs = removeAllAuditConfigsWithService(ep.AuditConfigs, ac.Service)
 			return nil
 		}
-		if enableBatching {
+		if useIamBatching(config, enableBatching) {
 			err = BatchRequestModifyIamPolicy(updater, modifyF, config, fmt.Sprintf(
 				"Delete audit config for service %s on resource %q", ac.Service, updater.DescribeResource()))
 		} else {
@@ -0,0 +1,330 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package tpgiamresource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+var IamAuthoritativeBaseSchema = map[string]*schema.Schema{
+	"binding": {
+		Type:     schema.TypeSet,
+		Optional: true,
+		Set:      iamAuthoritativeBindingHash,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"role": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"members": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validateIAMMember,
+					},
+					Set: func(v interface{}) int {
+						return schema.HashString(strings.ToLower(v.(string)))
+					},
+				},
+				"condition": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"expression": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"title": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"description": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	"exclude_members": {
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	},
+	"exclude_roles": {
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	},
+	"etag": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+// ResourceIamAuthoritative returns a resource authoritative for the IAM policy
+// of its parent, except for the bindings of the roles matching
+// `exclude_roles` and of the members matching `exclude_members`. Bindings of
+// the policy that aren't excluded and aren't in `binding` are read into
+// `binding`, so plans show which of them will be removed. Without `binding`,
+// the policy is reduced to the excluded bindings.
+func ResourceIamAuthoritative(parentSpecificSchema map[string]*schema.Schema, newUpdaterFunc NewResourceIamUpdaterFunc, resourceIdParser ResourceIdParserFunc, options ...func(*IamSettings)) *schema.Resource {
+	settings := NewIamSettings(options...)
+
+	return &schema.Resource{
+		Create: resourceIamAuthoritativeCreateUpdate(newUpdaterFunc),
+		Read:   resourceIamAuthoritativeRead(newUpdaterFunc),
+		Update: resourceIamAuthoritativeCreateUpdate(newUpdaterFunc),
+		Delete: resourceIamAuthoritativeDelete(newUpdaterFunc),
+
+		// if non-empty, this will be used to send a deprecation message when the
+		// resource is used.
+		DeprecationMessage: settings.DeprecationMessage,
+
+		Schema:        tpgresource.MergeSchemas(IamAuthoritativeBaseSchema, parentSpecificSchema),
+		CustomizeDiff: resourceIamAuthoritativeCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: iamAuthoritativeImport(resourceIdParser),
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func iamAuthoritativeImport(resourceIdParser ResourceIdParserFunc) schema.StateFunc {
+	return func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		if resourceIdParser == nil {
+			return nil, errors.New("Import not supported for this IAM resource.")
+		}
+		config := m.(*transport_tpg.Config)
+		if err := resourceIdParser(d, config); err != nil {
+			return nil, err
+		}
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// iamExclusions are the roles and members whose bindings aren't managed by an
+// authoritative IAM resource.
+type iamExclusions struct {
+	roles   []*regexp.Regexp
+	members []*regexp.Regexp
+}
+
+// iamPatternRegexp compiles an `exclude_roles` or `exclude_members` value, in
+// which `*` matches any sequence of characters.
+func iamPatternRegexp(pattern string) *regexp.Regexp {
+	parts := strings.Split(pattern, "*")
+	for i, part := range parts {
+		parts[i] = regexp.QuoteMeta(part)
+	}
+	return regexp.MustCompile("^" + strings.Join(parts, ".*") + "$")
+}
+
+// expandIamExclusions reads the exclusions of a resource from its
+// ResourceData or ResourceDiff.
+func expandIamExclusions(d interface{ Get(string) interface{} }) *iamExclusions {
+	ex := &iamExclusions{}
+	for _, v := range d.Get("exclude_roles").(*schema.Set).List() {
+		ex.roles = append(ex.roles, iamPatternRegexp(v.(string)))
+	}
+	for _, v := range d.Get("exclude_members").(*schema.Set).List() {
+		ex.members = append(ex.members, iamPatternRegexp(normalizeIamMemberCasing(v.(string))))
+	}
+	return ex
+}
+
+func (ex *iamExclusions) excludesRole(role string) bool {
+	for _, re := range ex.roles {
+		if re.MatchString(role) {
+			return true
+		}
+	}
+	return false
+}
+
+func (ex *iamExclusions) excludesMember(member string) bool {
+	member = normalizeIamMemberCasing(member)
+	for _, re := range ex.members {
+		if re.MatchString(member) {
+			return true
+		}
+	}
+	return false
+}
+
+// split splits bindings into the bindings managed by the resource and the
+// excluded ones.
+func (ex *iamExclusions) split(bindings []*cloudresourcemanager.Binding) (managed, excluded []*cloudresourcemanager.Binding) {
+	for _, b := range bindings {
+		if ex.excludesRole(b.Role) {
+			excluded = append(excluded, b)
+			continue
+		}
+		var managedMembers, excludedMembers []string
+		for _, m := range b.Members {
+			if ex.excludesMember(m) {
+				excludedMembers = append(excludedMembers, m)
+			} else {
+				managedMembers = append(managedMembers, m)
+			}
+		}
+		if len(managedMembers) > 0 {
+			managed = append(managed, &cloudresourcemanager.Binding{Role: b.Role, Condition: b.Condition, Members: managedMembers})
+		}
+		if len(excludedMembers) > 0 {
+			excluded = append(excluded, &cloudresourcemanager.Binding{Role: b.Role, Condition: b.Condition, Members: excludedMembers})
+		}
+	}
+	return MergeBindings(managed), MergeBindings(excluded)
+}
+
+// iamAuthoritativeBindingHash hashes bindings ignoring the case of members,
+// which IAM ignores for most member types.
+func iamAuthoritativeBindingHash(v interface{}) int {
+	b := v.(map[string]interface{})
+	var members []string
+	for _, m := range b["members"].(*schema.Set).List() {
+		members = append(members, normalizeIamMemberCasing(m.(string)))
+	}
+	sort.Strings(members)
+	condition := conditionKeyFromCondition(ExpandIamCondition(b["condition"]))
+	return schema.HashString(fmt.Sprintf("%s-%s-%s", b["role"], strings.Join(members, ","), condition.String()))
+}
+
+func expandIamAuthoritativeBindings(v interface{}) []*cloudresourcemanager.Binding {
+	var bindings []*cloudresourcemanager.Binding
+	for _, raw := range v.(*schema.Set).List() {
+		b := raw.(map[string]interface{})
+		binding := &cloudresourcemanager.Binding{
+			Role:      b["role"].(string),
+			Members:   tpgresource.ConvertStringArr(b["members"].(*schema.Set).List()),
+			Condition: ExpandIamCondition(b["condition"]),
+		}
+		bindings = append(bindings, binding)
+	}
+	return MergeBindings(bindings)
+}
+
+func flattenIamAuthoritativeBindings(bindings []*cloudresourcemanager.Binding) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(bindings))
+	for _, b := range bindings {
+		result = append(result, map[string]interface{}{
+			"role":      b.Role,
+			"members":   b.Members,
+			"condition": FlattenIamCondition(b.Condition),
+		})
+	}
+	return result
+}
+
+// resourceIamAuthoritativeCustomizeDiff rejects bindings that would be
+// excluded, as they would never be read back into `binding`.
+func resourceIamAuthoritativeCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("binding") || !diff.NewValueKnown("exclude_roles") || !diff.NewValueKnown("exclude_members") {
+		return nil
+	}
+	ex := expandIamExclusions(diff)
+	for _, b := range expandIamAuthoritativeBindings(diff.Get("binding")) {
+		if ex.excludesRole(b.Role) {
+			return fmt.Errorf("`binding` for role %q can't be managed, as the role matches `exclude_roles`", b.Role)
+		}
+		for _, m := range b.Members {
+			if ex.excludesMember(m) {
+				return fmt.Errorf("`binding` for role %q can't grant the role to %q, as the member matches `exclude_members`", b.Role, m)
+			}
+		}
+	}
+	return nil
+}
+
+func resourceIamAuthoritativeCreateUpdate(newUpdaterFunc NewResourceIamUpdaterFunc) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		config := meta.(*transport_tpg.Config)
+		updater, err := newUpdaterFunc(d, config)
+		if err != nil {
+			return err
+		}
+
+		ex := expandIamExclusions(d)
+		bindings := expandIamAuthoritativeBindings(d.Get("binding"))
+		modifyF := func(ep *cloudresourcemanager.Policy) error {
+			managed, excluded := ex.split(ep.Bindings)
+			if removed := subtractFromBindings(managed, bindings...); len(removed) > 0 {
+				log.Printf("[DEBUG]: Removing bindings not in configuration from the policy of %s: %s", updater.DescribeResource(), DebugPrintBindings(removed))
+			}
+			ep.Bindings = MergeBindings(append(excluded, bindings...))
+			ep.Version = IamPolicyVersion
+			return nil
+		}
+		if err := iamPolicyReadModifyWrite(updater, modifyF); err != nil {
+			return err
+		}
+
+		d.SetId(updater.GetResourceId())
+		return resourceIamAuthoritativeRead(newUpdaterFunc)(d, meta)
+	}
+}
+
+func resourceIamAuthoritativeRead(newUpdaterFunc NewResourceIamUpdaterFunc) schema.ReadFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		config := meta.(*transport_tpg.Config)
+		updater, err := newUpdaterFunc(d, config)
+		if err != nil {
+			return err
+		}
+
+		policy, err := iamPolicyReadWithRetry(updater)
+		if err != nil {
+			return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Resource %q with authoritative IAM bindings", updater.DescribeResource()))
+		}
+
+		managed, _ := expandIamExclusions(d).split(policy.Bindings)
+		if err := d.Set("binding", flattenIamAuthoritativeBindings(managed)); err != nil {
+			return fmt.Errorf("Error setting binding: %s", err)
+		}
+		if err := d.Set("etag", policy.Etag); err != nil {
+			return fmt.Errorf("Error setting etag: %s", err)
+		}
+		return nil
+	}
+}
+
+func resourceIamAuthoritativeDelete(newUpdaterFunc NewResourceIamUpdaterFunc) schema.DeleteFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		config := meta.(*transport_tpg.Config)
+		updater, err := newUpdaterFunc(d, config)
+		if err != nil {
+			return err
+		}
+
+		// Only remove the bindings set by the resource, rather than everything
+		// but the exclusions, to not lock anyone out of the parent.
+		bindings := expandIamAuthoritativeBindings(d.Get("binding"))
+		modifyF := func(ep *cloudresourcemanager.Policy) error {
+			ep.Bindings = subtractFromBindings(ep.Bindings, bindings...)
+			return nil
+		}
+		if err := iamPolicyReadModifyWrite(updater, modifyF); err != nil {
+			return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Resource %q with authoritative IAM bindings", updater.DescribeResource()))
+		}
+		return nil
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package tpgiamresource
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+func TestIamExclusionsSplit(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, IamAuthoritativeBaseSchema, map[string]interface{}{
+		"exclude_roles":   []interface{}{"roles/*.serviceAgent"},
+		"exclude_members": []interface{}{"serviceAccount:service-*@gcp-sa-*.iam.gserviceaccount.com"},
+	})
+	ex := expandIamExclusions(d)
+
+	managed, excluded := ex.split([]*cloudresourcemanager.Binding{
+		{Role: "roles/compute.serviceAgent", Members: []string{"serviceAccount:service-123@compute-system.iam.gserviceaccount.com"}},
+		{Role: "roles/viewer", Members: []string{"user:jane@example.com", "serviceAccount:service-123@gcp-sa-pubsub.iam.gserviceaccount.com"}},
+		{Role: "roles/editor", Members: []string{"user:out-of-band@example.com"}},
+	})
+
+	expectedManaged := []*cloudresourcemanager.Binding{
+		{Role: "roles/editor", Members: []string{"user:out-of-band@example.com"}},
+		{Role: "roles/viewer", Members: []string{"user:jane@example.com"}},
+	}
+	if !CompareBindings(managed, expectedManaged) {
+		t.Errorf("expected managed bindings %s, got %s", DebugPrintBindings(expectedManaged), DebugPrintBindings(managed))
+	}
+	expectedExcluded := []*cloudresourcemanager.Binding{
+		{Role: "roles/compute.serviceAgent", Members: []string{"serviceAccount:service-123@compute-system.iam.gserviceaccount.com"}},
+		{Role: "roles/viewer", Members: []string{"serviceAccount:service-123@gcp-sa-pubsub.iam.gserviceaccount.com"}},
+	}
+	if !CompareBindings(excluded, expectedExcluded) {
+		t.Errorf("expected excluded bindings %s, got %s", DebugPrintBindings(expectedExcluded), DebugPrintBindings(excluded))
+	}
+}
+
+func TestResourceIamAuthoritativeCreate(t *testing.T) {
+	updater := &testIamUpdater{
+		policy: &cloudresourcemanager.Policy{
+			Bindings: []*cloudresourcemanager.Binding{
+				{Role: "roles/pubsub.serviceAgent", Members: []string{"serviceAccount:service-123@gcp-sa-pubsub.iam.gserviceaccount.com"}},
+				{Role: "roles/editor", Members: []string{"user:out-of-band@example.com", "user:admin@example.com"}},
+			},
+		},
+	}
+	newUpdater := func(d tpgresource.TerraformResourceData, config *transport_tpg.Config) (ResourceIamUpdater, error) {
+		return updater, nil
+	}
+
+	r := ResourceIamAuthoritative(map[string]*schema.Schema{}, newUpdater, nil)
+	d := r.TestResourceData()
+	if err := d.Set("exclude_roles", []interface{}{"roles/*.serviceAgent"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := d.Set("exclude_members", []interface{}{"user:admin@example.com"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := d.Set("binding", []interface{}{
+		map[string]interface{}{
+			"role":    "roles/viewer",
+			"members": []interface{}{"user:Jane@example.com"},
+		},
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := r.Create(d, &transport_tpg.Config{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The out-of-band editor is removed, the excluded bindings are kept.
+	expected := []*cloudresourcemanager.Binding{
+		{Role: "roles/pubsub.serviceAgent", Members: []string{"serviceAccount:service-123@gcp-sa-pubsub.iam.gserviceaccount.com"}},
+		{Role: "roles/editor", Members: []string{"user:admin@example.com"}},
+		{Role: "roles/viewer", Members: []string{"user:jane@example.com"}},
+	}
+	if !CompareBindings(updater.policy.Bindings, expected) {
+		t.Errorf("expected bindings %s, got %s", DebugPrintBindings(expected), DebugPrintBindings(updater.policy.Bindings))
+	}
+	if got := d.Get("binding").(*schema.Set).Len(); got != 1 {
+		t.Errorf("expected 1 managed binding to be read, got %d", got)
+	}
+
+	if err := r.Delete(d, &transport_tpg.Config{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected = expected[:2]
+	if !CompareBindings(updater.policy.Bindings, expected) {
+		t.Errorf("expected delete to only remove the managed bindings, got %s", DebugPrintBindings(updater.policy.Bindings))
+	}
+}
+
+func TestResourceIamAuthoritativeCreate_exclusionsOnly(t *testing.T) {
+	updater := &testIamUpdater{
+		policy: &cloudresourcemanager.Policy{
+			Bindings: []*cloudresourcemanager.Binding{
+				{Role: "roles/pubsub.serviceAgent", Members: []string{"serviceAccount:service-123@gcp-sa-pubsub.iam.gserviceaccount.com"}},
+				{Role: "roles/editor", Members: []string{"user:out-of-band@example.com"}},
+			},
+		},
+	}
+	newUpdater := func(d tpgresource.TerraformResourceData, config *transport_tpg.Config) (ResourceIamUpdater, error) {
+		return updater, nil
+	}
+
+	r := ResourceIamAuthoritative(map[string]*schema.Schema{}, newUpdater, nil)
+	if err := r.InternalValidate(nil, true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	d := r.TestResourceData()
+	if err := d.Set("exclude_roles", []interface{}{"roles/*.serviceAgent"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := r.Create(d, &transport_tpg.Config{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Without bindings, every binding but the excluded ones is removed.
+	expected := []*cloudresourcemanager.Binding{
+		{Role: "roles/pubsub.serviceAgent", Members: []string{"serviceAccount:service-123@gcp-sa-pubsub.iam.gserviceaccount.com"}},
+	}
+	if !CompareBindings(updater.policy.Bindings, expected) {
+		t.Errorf("expected bindings %s, got %s", DebugPrintBindings(expected), DebugPrintBindings(updater.policy.Bindings))
+	}
+	if got := d.Get("binding").(*schema.Set).Len(); got != 0 {
+		t.Errorf("expected no managed binding to be read, got %d", got)
+	}
+}
@@ -102,9 +102,9 @@ func resourceIamBindingCreateUpdate(newUpdaterFunc NewResourceIamUpdaterFunc, en
 			return nil
 		}
 
-		if enableBatching {
+		if useIamBatching(config, enableBatching) {
 			err = BatchRequestModifyIamPolicy(updater, modifyF, config, fmt.Sprintf(
-				"Set IAM Binding for role %q on %q", binding.Role, updater.DescribeResource()))
+				"Set IAM Binding for role %q on %q", binding.Role, updater.DescribeResource()), binding.Members...)
 		} else {
 			err = iamPolicyReadModifyWrite(updater, modifyF)
 		}
@@ -269,7 +269,7 @@ func resourceIamBindingDelete(newUpdaterFunc NewResourceIamUpdaterFunc, enableBa
 			return nil
 		}
 
-		if enableBatching {
+		if useIamBatching(config, enableBatching) {
 			err = BatchRequestModifyIamPolicy(updater, modifyF, config, fmt.Sprintf(
 				"Delete IAM Binding for role %q on %q", binding.Role, updater.DescribeResource()))
 		} else {
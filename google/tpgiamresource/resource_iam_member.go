@@ -218,9 +218,9 @@ func resourceIamMemberCreate(newUpdaterFunc NewResourceIamUpdaterFunc, enableBat
 			ep.Version = IamPolicyVersion
 			return nil
 		}
-		if enableBatching {
+		if useIamBatching(config, enableBatching) {
 			err = BatchRequestModifyIamPolicy(updater, modifyF, config,
-				fmt.Sprintf("Create IAM Members %s %+v for %s", memberBind.Role, memberBind.Members[0], updater.DescribeResource()))
+				fmt.Sprintf("Create IAM Members %s %+v for %s", memberBind.Role, memberBind.Members[0], updater.DescribeResource()), memberBind.Members...)
 		} else {
 			err = iamPolicyReadModifyWrite(updater, modifyF)
 		}
@@ -312,7 +312,7 @@ func resourceIamMemberDelete(newUpdaterFunc NewResourceIamUpdaterFunc, enableBat
 			ep.Bindings = subtractFromBindings(ep.Bindings, memberBind)
 			return nil
 		}
-		if enableBatching {
+		if useIamBatching(config, enableBatching) {
 			err = BatchRequestModifyIamPolicy(updater, modifyF, config,
 				fmt.Sprintf("Delete IAM Members %s %s for %q", memberBind.Role, memberBind.Members[0], updater.DescribeResource()))
 		} else {
@@ -5,9 +5,11 @@ package tpgiamresource
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
@@ -26,6 +28,36 @@ var IamPolicyBaseSchema = map[string]*schema.Schema{
 		Type:     schema.TypeString,
 		Computed: true,
 	},
+	"planned_changes": {
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"role": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"condition_title": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"condition_expression": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"members_added": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"members_removed": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	},
 }
 
 func iamPolicyImport(resourceIdParser ResourceIdParserFunc) schema.StateFunc {
@@ -55,7 +87,8 @@ func ResourceIamPolicy(parentSpecificSchema map[string]*schema.Schema, newUpdate
 		// resource is used.
 		DeprecationMessage: settings.DeprecationMessage,
 
-		Schema: tpgresource.MergeSchemas(IamPolicyBaseSchema, parentSpecificSchema),
+		Schema:        tpgresource.MergeSchemas(IamPolicyBaseSchema, parentSpecificSchema),
+		CustomizeDiff: iamPolicyPlannedChangesCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			State: iamPolicyImport(resourceIdParser),
 		},
@@ -101,6 +134,10 @@ func ResourceIamPolicyRead(newUpdaterFunc NewResourceIamUpdaterFunc) schema.Read
 		if err := d.Set("policy_data", marshalIamPolicy(policy)); err != nil {
 			return fmt.Errorf("Error setting policy_data: %s", err)
 		}
+		// planned_changes only describes changes while they're being planned.
+		if err := d.Set("planned_changes", nil); err != nil {
+			return fmt.Errorf("Error setting planned_changes: %s", err)
+		}
 
 		return nil
 	}
@@ -149,6 +186,75 @@ func ResourceIamPolicyDelete(newUpdaterFunc NewResourceIamUpdaterFunc) schema.De
 	}
 }
 
+// iamPolicyPlannedChangesCustomizeDiff sets `planned_changes` to the members
+// added to and removed from each role and condition by a change of
+// `policy_data`, relative to the policy last read.
+func iamPolicyPlannedChangesCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.HasChange("policy_data") {
+		return nil
+	}
+	if !diff.NewValueKnown("policy_data") {
+		return diff.SetNewComputed("planned_changes")
+	}
+
+	o, n := diff.GetChange("policy_data")
+	oldPolicy := &cloudresourcemanager.Policy{}
+	if o.(string) != "" {
+		p, err := unmarshalIamPolicy(o.(string))
+		if err != nil {
+			return err
+		}
+		oldPolicy = p
+	}
+	newPolicy, err := unmarshalIamPolicy(n.(string))
+	if err != nil {
+		// validateIamPolicy reports invalid policies.
+		return nil
+	}
+	return diff.SetNew("planned_changes", flattenIamPolicyPlannedChanges(oldPolicy.Bindings, newPolicy.Bindings))
+}
+
+// flattenIamPolicyPlannedChanges lists the members added and removed for each
+// role and condition whose members differ between two sets of bindings.
+func flattenIamPolicyPlannedChanges(old, new []*cloudresourcemanager.Binding) []map[string]interface{} {
+	oldMap := createIamBindingsMap(old)
+	newMap := createIamBindingsMap(new)
+
+	var keys []iamBindingKey
+	for key := range missingBindingsMap(oldMap, newMap) {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Role != keys[j].Role {
+			return keys[i].Role < keys[j].Role
+		}
+		return keys[i].Condition.String() < keys[j].Condition.String()
+	})
+
+	changes := make([]map[string]interface{}, 0, len(keys))
+	for _, key := range keys {
+		changes = append(changes, map[string]interface{}{
+			"role":                 key.Role,
+			"condition_title":      key.Condition.Title,
+			"condition_expression": key.Condition.Expression,
+			"members_added":        sortedMembersNotIn(newMap[key], oldMap[key]),
+			"members_removed":      sortedMembersNotIn(oldMap[key], newMap[key]),
+		})
+	}
+	return changes
+}
+
+func sortedMembersNotIn(members, other map[string]struct{}) []string {
+	result := make([]string, 0)
+	for m := range members {
+		if _, ok := other[m]; !ok {
+			result = append(result, m)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
 func setIamPolicyData(d *schema.ResourceData, updater ResourceIamUpdater) error {
 	policy, err := unmarshalIamPolicy(d.Get("policy_data").(string))
 	if err != nil {
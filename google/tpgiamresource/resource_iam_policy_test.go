@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package tpgiamresource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceIamPolicy_plannedChanges(t *testing.T) {
+	r := ResourceIamPolicy(map[string]*schema.Schema{}, nil, nil)
+	state := &terraform.InstanceState{
+		ID: "my-project",
+		Attributes: map[string]string{
+			"id":          "my-project",
+			"policy_data": `{"bindings":[{"role":"roles/viewer","members":["user:bob@example.com"]}]}`,
+		},
+	}
+
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"policy_data": `{"bindings":[{"role":"roles/viewer","members":["user:alice@example.com"]}]}`,
+	}), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string]string{
+		"planned_changes.#":                   "1",
+		"planned_changes.0.role":              "roles/viewer",
+		"planned_changes.0.members_added.0":   "user:alice@example.com",
+		"planned_changes.0.members_removed.0": "user:bob@example.com",
+	}
+	for k, v := range expected {
+		attr, ok := diff.Attributes[k]
+		if !ok || attr.New != v {
+			t.Errorf("expected %s to be planned as %q, got %+v", k, v, attr)
+		}
+	}
+
+	// An equivalent policy plans no changes.
+	diff, err = r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"policy_data": `{"bindings":[{"members":["user:Bob@example.com"],"role":"roles/viewer"}]}`,
+	}), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff != nil && !diff.Empty() {
+		t.Errorf("expected no diff for an equivalent policy, got %+v", diff.Attributes)
+	}
+}
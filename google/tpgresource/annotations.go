@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
 )
 
 func SetAnnotationsDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
@@ -15,30 +16,32 @@ func SetAnnotationsDiff(_ context.Context, d *schema.ResourceDiff, meta interfac
 		return nil
 	}
 
+	if d.Get("terraform_annotations") == nil {
+		return fmt.Errorf("`terraform_annotations` field is not present in the resource schema.")
+	}
+
 	if d.Get("effective_annotations") == nil {
 		return fmt.Errorf("`effective_annotations` field is not present in the resource schema.")
 	}
 
-	// If "annotations" field is computed, set "effective_annotations" to computed.
+	// If "annotations" field is computed, set "terraform_annotations" and "effective_annotations" to computed.
 	// https://github.com/hashicorp/terraform-provider-google/issues/16217
 	if !d.GetRawPlan().GetAttr("annotations").IsWhollyKnown() {
+		if err := d.SetNewComputed("terraform_annotations"); err != nil {
+			return fmt.Errorf("error setting terraform_annotations to computed: %w", err)
+		}
 		if err := d.SetNewComputed("effective_annotations"); err != nil {
 			return fmt.Errorf("error setting effective_annotations to computed: %w", err)
 		}
 		return nil
 	}
 
+	oldTerraformAnnotations := d.Get("terraform_annotations")
 	o, n := d.GetChange("annotations")
-	effectiveAnnotations := d.Get("effective_annotations").(map[string]interface{})
-
-	for k, v := range n.(map[string]interface{}) {
-		effectiveAnnotations[k] = v.(string)
-	}
+	terraformAnnotations, effectiveAnnotations := mergeEffectiveAnnotations(meta, d.Get("effective_annotations"), oldTerraformAnnotations, o, n)
 
-	for k := range o.(map[string]interface{}) {
-		if _, ok := n.(map[string]interface{})[k]; !ok {
-			delete(effectiveAnnotations, k)
-		}
+	if err := d.SetNew("terraform_annotations", terraformAnnotations); err != nil {
+		return fmt.Errorf("error setting new terraform_annotations diff: %w", err)
 	}
 
 	if err := d.SetNew("effective_annotations", effectiveAnnotations); err != nil {
@@ -70,24 +73,20 @@ func SetMetadataAnnotationsDiff(_ context.Context, d *schema.ResourceDiff, meta
 		return nil
 	}
 
+	if d.Get("metadata.0.terraform_annotations") == nil {
+		return fmt.Errorf("`metadata.0.terraform_annotations` field is not present in the resource schema.")
+	}
+
 	if d.Get("metadata.0.effective_annotations") == nil {
 		return fmt.Errorf("`metadata.0.effective_annotations` field is not present in the resource schema.")
 	}
 
+	oldTerraformAnnotations := d.Get("metadata.0.terraform_annotations")
 	o, n := d.GetChange("metadata.0.annotations")
-	effectiveAnnotations := d.Get("metadata.0.effective_annotations").(map[string]interface{})
-
-	for k, v := range n.(map[string]interface{}) {
-		effectiveAnnotations[k] = v.(string)
-	}
-
-	for k := range o.(map[string]interface{}) {
-		if _, ok := n.(map[string]interface{})[k]; !ok {
-			delete(effectiveAnnotations, k)
-		}
-	}
+	terraformAnnotations, effectiveAnnotations := mergeEffectiveAnnotations(meta, d.Get("metadata.0.effective_annotations"), oldTerraformAnnotations, o, n)
 
 	original := l[0].(map[string]interface{})
+	original["terraform_annotations"] = terraformAnnotations
 	original["effective_annotations"] = effectiveAnnotations
 
 	if err := d.SetNew("metadata", []interface{}{original}); err != nil {
@@ -97,6 +96,42 @@ func SetMetadataAnnotationsDiff(_ context.Context, d *schema.ResourceDiff, meta
 	return nil
 }
 
+// mergeEffectiveAnnotations returns the Terraform-managed and effective
+// annotations planned from the old and new annotations of a resource. The
+// Terraform-managed annotations are the provider's `default_annotations` and
+// the annotations set on the resource, which take precedence. Annotations
+// previously managed by Terraform, recorded in the old `annotations` and
+// `terraform_annotations`, are removed if they no longer are. Annotations not
+// set by Terraform, e.g. added by the service, are kept.
+func mergeEffectiveAnnotations(meta interface{}, effective, oldTerraform, o, n interface{}) (map[string]interface{}, map[string]interface{}) {
+	effectiveAnnotations := effective.(map[string]interface{})
+
+	terraformAnnotations := make(map[string]interface{})
+	if config, ok := meta.(*transport_tpg.Config); ok {
+		for k, v := range config.DefaultAnnotations {
+			terraformAnnotations[k] = v
+		}
+	}
+	for k, v := range n.(map[string]interface{}) {
+		terraformAnnotations[k] = v
+	}
+
+	for _, old := range []interface{}{o, oldTerraform} {
+		old, _ := old.(map[string]interface{})
+		for k := range old {
+			if _, ok := terraformAnnotations[k]; !ok {
+				delete(effectiveAnnotations, k)
+			}
+		}
+	}
+
+	for k, v := range terraformAnnotations {
+		effectiveAnnotations[k] = v.(string)
+	}
+
+	return terraformAnnotations, effectiveAnnotations
+}
+
 // Sets the "annotations" field with the value of the field "effective_annotations" for data sources.
 // When reading data source, as the annotations field is unavailable in the configuration of the data source,
 // the "annotations" field will be empty. With this function, the labels "annotations" will have all of annotations in the resource.
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package tpgresource
+
+import (
+	"reflect"
+	"testing"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+func TestMergeEffectiveAnnotations(t *testing.T) {
+	cases := map[string]struct {
+		defaults          map[string]string
+		effective         map[string]interface{}
+		oldTerraform      map[string]interface{}
+		o, n              map[string]interface{}
+		expectedTerraform map[string]interface{}
+		expectedEffective map[string]interface{}
+	}{
+		"defaults and resource annotations": {
+			defaults:          map[string]string{"owner": "platform", "tier": "default"},
+			effective:         map[string]interface{}{"service-managed": "x", "removed": "y", "tier": "old"},
+			oldTerraform:      map[string]interface{}{"removed": "y", "tier": "old"},
+			o:                 map[string]interface{}{"removed": "y", "tier": "old"},
+			n:                 map[string]interface{}{"tier": "gold"},
+			expectedTerraform: map[string]interface{}{"owner": "platform", "tier": "gold"},
+			expectedEffective: map[string]interface{}{"service-managed": "x", "owner": "platform", "tier": "gold"},
+		},
+		"default annotation removed": {
+			defaults:          map[string]string{"owner": "platform"},
+			effective:         map[string]interface{}{"service-managed": "x", "owner": "platform", "team": "infra"},
+			oldTerraform:      map[string]interface{}{"owner": "platform", "team": "infra"},
+			o:                 map[string]interface{}{},
+			n:                 map[string]interface{}{},
+			expectedTerraform: map[string]interface{}{"owner": "platform"},
+			expectedEffective: map[string]interface{}{"service-managed": "x", "owner": "platform"},
+		},
+		"all default annotations removed": {
+			effective:         map[string]interface{}{"service-managed": "x", "owner": "platform"},
+			oldTerraform:      map[string]interface{}{"owner": "platform"},
+			o:                 map[string]interface{}{},
+			n:                 map[string]interface{}{},
+			expectedTerraform: map[string]interface{}{},
+			expectedEffective: map[string]interface{}{"service-managed": "x"},
+		},
+		"default annotation moved to the resource": {
+			defaults:          map[string]string{},
+			effective:         map[string]interface{}{"owner": "platform"},
+			oldTerraform:      map[string]interface{}{"owner": "platform"},
+			o:                 map[string]interface{}{},
+			n:                 map[string]interface{}{"owner": "infra"},
+			expectedTerraform: map[string]interface{}{"owner": "infra"},
+			expectedEffective: map[string]interface{}{"owner": "infra"},
+		},
+		"state without terraform_annotations": {
+			defaults:          map[string]string{"owner": "platform"},
+			effective:         map[string]interface{}{"service-managed": "x", "removed": "y"},
+			o:                 map[string]interface{}{"removed": "y"},
+			n:                 map[string]interface{}{},
+			expectedTerraform: map[string]interface{}{"owner": "platform"},
+			expectedEffective: map[string]interface{}{"service-managed": "x", "owner": "platform"},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			config := &transport_tpg.Config{DefaultAnnotations: tc.defaults}
+			var oldTerraform interface{}
+			if tc.oldTerraform != nil {
+				oldTerraform = tc.oldTerraform
+			}
+
+			terraform, effective := mergeEffectiveAnnotations(config, tc.effective, oldTerraform, tc.o, tc.n)
+			if !reflect.DeepEqual(terraform, tc.expectedTerraform) {
+				t.Errorf("got terraform annotations %v, want %v", terraform, tc.expectedTerraform)
+			}
+			if !reflect.DeepEqual(effective, tc.expectedEffective) {
+				t.Errorf("got effective annotations %v, want %v", effective, tc.expectedEffective)
+			}
+		})
+	}
+}
@@ -3,12 +3,14 @@
 package tpgresource
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+	"go.opentelemetry.io/otel/attribute"
 	cloudresourcemanager "google.golang.org/api/cloudresourcemanager/v1"
 )
 
@@ -54,6 +56,24 @@ type Waiter interface {
 	TargetStates() []string
 }
 
+// ConfigWaiter is implemented by waiters that query operations using a
+// provider configuration. When tracing is configured, OperationWait records
+// each poll of a ConfigWaiter as a span of the resource operation the
+// configuration was created for.
+type ConfigWaiter interface {
+	TransportConfig() *transport_tpg.Config
+}
+
+// ResumableWaiter is implemented by waiters whose operation can be polled
+// again from its URL alone, e.g. by a later run of Terraform. When Terraform
+// is stopped while OperationWait polls a ResumableWaiter whose configuration
+// tracks interrupted operations, polling stops and the operation is recorded
+// on the configuration instead of being abandoned.
+type ResumableWaiter interface {
+	ConfigWaiter
+	OperationURL() string
+}
+
 type CommonOperationWaiter struct {
 	Op CommonOperation
 }
@@ -138,21 +158,58 @@ func CommonRefreshFunc(w Waiter) retry.StateRefreshFunc {
 	}
 }
 
-func OperationWait(w Waiter, activity string, timeout time.Duration, pollInterval time.Duration) error {
+// tracedRefreshFunc records each call of refresh as a span.
+func tracedRefreshFunc(config *transport_tpg.Config, w Waiter, refresh retry.StateRefreshFunc) retry.StateRefreshFunc {
+	polls := 0
+	return func() (interface{}, string, error) {
+		polls++
+		_, span := config.StartSpan(fmt.Sprintf("poll %d", polls), attribute.String("gcp.operation", w.OpName()))
+		op, state, err := refresh()
+		span.SetAttributes(attribute.String("gcp.operation.state", state))
+		transport_tpg.EndSpan(span, err)
+		return op, state, err
+	}
+}
+
+func OperationWait(w Waiter, activity string, timeout time.Duration, pollInterval time.Duration) (err error) {
 	if OperationDone(w) {
 		return w.Error()
 	}
 
+	refresh := CommonRefreshFunc(w)
+	if cw, ok := w.(ConfigWaiter); ok && cw.TransportConfig() != nil {
+		config, span := cw.TransportConfig().StartSpan(activity, attribute.String("gcp.operation", w.OpName()))
+		defer func() { transport_tpg.EndSpan(span, err) }()
+		refresh = tracedRefreshFunc(config, w, refresh)
+	}
+
+	// Only stop polling early if the operation can be resumed later, as the
+	// resource would otherwise be lost.
+	ctx := context.Background()
+	var resumeURL string
+	if rw, ok := w.(ResumableWaiter); ok && rw.TransportConfig().TracksInterruptedOperations() && rw.TransportConfig().Context != nil {
+		if resumeURL = rw.OperationURL(); resumeURL != "" {
+			ctx = rw.TransportConfig().Context
+		}
+	}
+
 	c := &retry.StateChangeConf{
 		Pending:      w.PendingStates(),
 		Target:       w.TargetStates(),
-		Refresh:      CommonRefreshFunc(w),
+		Refresh:      refresh,
 		Timeout:      timeout,
 		MinTimeout:   2 * time.Second,
 		PollInterval: pollInterval,
 	}
-	opRaw, err := c.WaitForState()
+	opRaw, err := c.WaitForStateContext(ctx)
 	if err != nil {
+		if ctx.Err() != nil {
+			w.(ResumableWaiter).TransportConfig().RecordInterruptedOperation(transport_tpg.InterruptedOperation{
+				URL:      resumeURL,
+				Activity: activity,
+			})
+			return fmt.Errorf("Terraform was stopped while waiting for %s, operation %s is still running", activity, w.OpName())
+		}
 		return fmt.Errorf("Error waiting for %s: %w", activity, err)
 	}
 
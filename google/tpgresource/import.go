@@ -176,3 +176,103 @@ func getDefaultValues(idRegex string, d TerraformResourceData, config *transport
 	}
 	return result, nil
 }
+
+// ImportIdFields returns the names of the fields captured by an import id
+// regex, in the order they appear in it.
+func ImportIdFields(idRegex string) ([]string, error) {
+	re, err := regexp.Compile(idRegex)
+	if err != nil {
+		return nil, err
+	}
+	var fields []string
+	for _, name := range re.SubexpNames() {
+		if name != "" {
+			fields = append(fields, name)
+		}
+	}
+	return fields, nil
+}
+
+// Build an import id matching the given regex from the values of the fields it
+// captures. It is the reverse of ParseImportId for a single regex.
+//
+// e.g. projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/subnetworks/(?P<name>[^/]+)
+// with project = my-project, region = my-region and name = my-subnetwork
+// gives projects/my-project/regions/my-region/subnetworks/my-subnetwork
+func ImportIdFromFields(idRegex string, fields map[string]string) (string, error) {
+	re, err := regexp.Compile(idRegex)
+	if err != nil {
+		return "", err
+	}
+
+	template := strings.TrimSuffix(strings.TrimPrefix(idRegex, "^"), "$")
+	var id strings.Builder
+	for i := 0; i < len(template); i++ {
+		switch c := template[i]; c {
+		case '\\':
+			if i+1 == len(template) {
+				return "", fmt.Errorf("Import id regex %q ends with an escape", idRegex)
+			}
+			i++
+			id.WriteByte(template[i])
+		case '(':
+			if !strings.HasPrefix(template[i:], "(?P<") {
+				return "", fmt.Errorf("Import id regex %q has a group that isn't a named field", idRegex)
+			}
+			end := strings.IndexByte(template[i:], '>')
+			name := template[i+len("(?P<") : i+end]
+			value, ok := fields[name]
+			if !ok || value == "" {
+				return "", fmt.Errorf("No value was given for %s", name)
+			}
+			id.WriteString(value)
+			i = closingParen(template, i)
+		case '.', '*', '+', '?', '[', ']', '{', '}', '|', ')', '^', '$':
+			return "", fmt.Errorf("Import id regex %q can't be built from its fields", idRegex)
+		default:
+			id.WriteByte(c)
+		}
+	}
+
+	// Values containing the separators of the regex could be matched as
+	// different fields, so the result is checked to parse back to the same.
+	matches := re.FindStringSubmatch(id.String())
+	if matches == nil {
+		return "", fmt.Errorf("Import id %q doesn't match the format %s", id.String(), idRegex)
+	}
+	for i, name := range re.SubexpNames() {
+		if name != "" && matches[i] != fields[name] {
+			return "", fmt.Errorf("Import id %q doesn't give %s = %q", id.String(), name, fields[name])
+		}
+	}
+	return id.String(), nil
+}
+
+// closingParen returns the index of the parenthesis closing the group opened
+// at index start of the regex.
+func closingParen(regex string, start int) int {
+	depth := 0
+	inClass := false
+	for i := start; i < len(regex); i++ {
+		switch regex[i] {
+		case '\\':
+			i++
+		case '[':
+			inClass = true
+		case ']':
+			inClass = false
+		case '(':
+			if !inClass {
+				depth++
+			}
+		case ')':
+			if !inClass {
+				depth--
+				if depth == 0 {
+					return i
+				}
+			}
+		}
+	}
+	return len(regex)
+}
@@ -3,6 +3,7 @@
 package tpgresource
 
 import (
+	"strings"
 	"testing"
 
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
@@ -149,3 +150,96 @@ func TestParseImportId(t *testing.T) {
 		}
 	}
 }
+
+func TestImportIdFromFields(t *testing.T) {
+	cases := map[string]struct {
+		IdRegex     string
+		Fields      map[string]string
+		ExpectedId  string
+		ExpectError bool
+	}{
+		"self_link": {
+			IdRegex: "projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/subnetworks/(?P<name>[^/]+)",
+			Fields: map[string]string{
+				"project": "my-project",
+				"region":  "my-region",
+				"name":    "my-subnetwork",
+			},
+			ExpectedId: "projects/my-project/regions/my-region/subnetworks/my-subnetwork",
+		},
+		"anchored": {
+			IdRegex: "^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/backups/(?P<backup_id>[^/]+)$",
+			Fields: map[string]string{
+				"project":   "my-project",
+				"location":  "us-central1",
+				"backup_id": "my-backup",
+			},
+			ExpectedId: "projects/my-project/locations/us-central1/backups/my-backup",
+		},
+		"nested groups": {
+			IdRegex: "(?P<project>[^ ]+) (?P<name>(projects/[^/]+/)?[^ ]+)",
+			Fields: map[string]string{
+				"project": "my-project",
+				"name":    "my-domain",
+			},
+			ExpectedId: "my-project my-domain",
+		},
+		"escaped separator": {
+			IdRegex: "(?P<project>[^/]+)\\.(?P<name>[^.]+)",
+			Fields: map[string]string{
+				"project": "my-project",
+				"name":    "my-dataset",
+			},
+			ExpectedId: "my-project.my-dataset",
+		},
+		"missing field": {
+			IdRegex: "projects/(?P<project>[^/]+)/global/networks/(?P<name>[^/]+)",
+			Fields: map[string]string{
+				"name": "my-network",
+			},
+			ExpectError: true,
+		},
+		"value with separator": {
+			IdRegex: "projects/(?P<project>[^/]+)/global/networks/(?P<name>[^/]+)",
+			Fields: map[string]string{
+				"project": "my-project",
+				"name":    "my/network",
+			},
+			ExpectError: true,
+		},
+		"unnamed group": {
+			IdRegex: "(projects/)?(?P<project>[^/]+)",
+			Fields: map[string]string{
+				"project": "my-project",
+			},
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		id, err := ImportIdFromFields(tc.IdRegex, tc.Fields)
+		if err != nil {
+			if !tc.ExpectError {
+				t.Errorf("%s failed; unexpected error: %s", tn, err)
+			}
+			continue
+		}
+		if tc.ExpectError {
+			t.Errorf("%s failed; expected an error, got id %q", tn, id)
+			continue
+		}
+		if id != tc.ExpectedId {
+			t.Errorf("%s failed; expected id %q, got %q", tn, tc.ExpectedId, id)
+		}
+	}
+}
+
+func TestImportIdFields(t *testing.T) {
+	fields, err := ImportIdFields("projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/instances/(?P<name>(projects/)?[^/]+)")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := strings.Join(fields, ","), "project,zone,name"; got != want {
+		t.Errorf("expected fields %q, got %q", want, got)
+	}
+}
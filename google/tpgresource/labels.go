@@ -4,6 +4,7 @@ package tpgresource
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -58,7 +59,7 @@ func SetDataSourceLabels(d *schema.ResourceData) error {
 }
 
 // Sets the values of terraform_labels and effective_labels fields when labels field is in root level
-func setLabelsFields(labelsField string, d *schema.ResourceDiff, meta interface{}, skipAttribution bool) error {
+func setLabelsFields(ctx context.Context, labelsField string, d *schema.ResourceDiff, meta interface{}, skipAttribution bool) error {
 	raw := d.Get(labelsField)
 	if raw == nil {
 		return nil
@@ -86,28 +87,21 @@ func setLabelsFields(labelsField string, d *schema.ResourceDiff, meta interface{
 	}
 
 	config := meta.(*transport_tpg.Config)
-
-	// Merge provider default labels with the user defined labels in the resource to get terraform managed labels
-	terraformLabels := make(map[string]string)
-	for k, v := range config.DefaultLabels {
-		terraformLabels[k] = v
+	terraformLabels, known, err := mergeTerraformLabels(ctx, d, config, labelsField, "effective_labels", skipAttribution)
+	if err != nil {
+		return err
 	}
 
-	// Append optional label indicating the resource was provisioned using Terraform
-	if !skipAttribution && config.AddTerraformAttributionLabel {
-		if el, ok := d.Get("effective_labels").(map[string]any); ok {
-			_, hasExistingLabel := el[transport_tpg.AttributionKey]
-			if hasExistingLabel ||
-				config.TerraformAttributionLabelAdditionStrategy == transport_tpg.ProactiveAttributionStrategy ||
-				(config.TerraformAttributionLabelAdditionStrategy == transport_tpg.CreateOnlyAttributionStrategy && d.Id() == "") {
-				terraformLabels[transport_tpg.AttributionKey] = transport_tpg.AttributionValue
-			}
+	// If a default label's value is computed, set "terraform_labels" and "effective_labels" to computed.
+	if !known {
+		if err := d.SetNewComputed("terraform_labels"); err != nil {
+			return fmt.Errorf("error setting terraform_labels to computed: %w", err)
 		}
-	}
 
-	labels := raw.(map[string]interface{})
-	for k, v := range labels {
-		terraformLabels[k] = v.(string)
+		if err := d.SetNewComputed("effective_labels"); err != nil {
+			return fmt.Errorf("error setting effective_labels to computed: %w", err)
+		}
+		return nil
 	}
 
 	if err := d.SetNew("terraform_labels", terraformLabels); err != nil {
@@ -134,25 +128,25 @@ func setLabelsFields(labelsField string, d *schema.ResourceDiff, meta interface{
 	return nil
 }
 
-func SetLabelsDiffWithoutAttributionLabel(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
-	return setLabelsFields("labels", d, meta, true)
+func SetLabelsDiffWithoutAttributionLabel(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return setLabelsFields(ctx, "labels", d, meta, true)
 }
 
 // The CustomizeDiff func to set the values of terraform_labels and effective_labels fields
 // when labels field is at the root level and named "labels".
-func SetLabelsDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
-	return setLabelsFields("labels", d, meta, false)
+func SetLabelsDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return setLabelsFields(ctx, "labels", d, meta, false)
 }
 
 // The CustomizeDiff func to set the values of terraform_labels and effective_labels fields
 // when labels field is at the root level and has a diffent name (e.g. resource_labels) than "labels"
 func SetDiffForLabelsWithCustomizedName(labelsField string) func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
-	return func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
-		return setLabelsFields(labelsField, d, meta, false)
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		return setLabelsFields(ctx, labelsField, d, meta, false)
 	}
 }
 
-func SetMetadataLabelsDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+func SetMetadataLabelsDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	l := d.Get("metadata").([]interface{})
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -183,28 +177,14 @@ func SetMetadataLabelsDiff(_ context.Context, d *schema.ResourceDiff, meta inter
 	}
 
 	config := meta.(*transport_tpg.Config)
-
-	// Merge provider default labels with the user defined labels in the resource to get terraform managed labels
-	terraformLabels := make(map[string]string)
-	for k, v := range config.DefaultLabels {
-		terraformLabels[k] = v
-	}
-
-	// Append optional label indicating the resource was provisioned using Terraform
-	if config.AddTerraformAttributionLabel {
-		if el, ok := d.Get("metadata.0.effective_labels").(map[string]any); ok {
-			_, hasExistingLabel := el[transport_tpg.AttributionKey]
-			if hasExistingLabel ||
-				config.TerraformAttributionLabelAdditionStrategy == transport_tpg.ProactiveAttributionStrategy ||
-				(config.TerraformAttributionLabelAdditionStrategy == transport_tpg.CreateOnlyAttributionStrategy && d.Id() == "") {
-				terraformLabels[transport_tpg.AttributionKey] = transport_tpg.AttributionValue
-			}
-		}
+	terraformLabels, known, err := mergeTerraformLabels(ctx, d, config, "metadata.0.labels", "metadata.0.effective_labels", false)
+	if err != nil {
+		return err
 	}
 
-	labels := raw.(map[string]interface{})
-	for k, v := range labels {
-		terraformLabels[k] = v.(string)
+	// As for computed "labels" above, nested fields can't be set to computed.
+	if !known {
+		return nil
 	}
 
 	original := l[0].(map[string]interface{})
@@ -235,6 +215,132 @@ func SetMetadataLabelsDiff(_ context.Context, d *schema.ResourceDiff, meta inter
 	return nil
 }
 
+// mergeTerraformLabels merges the provider default labels applying to the
+// resource, the attribution label and the labels set in labelsField into the
+// labels managed by Terraform. It returns false if the value of a default
+// label isn't known until apply, in which case the labels are computed.
+func mergeTerraformLabels(ctx context.Context, d *schema.ResourceDiff, config *transport_tpg.Config, labelsField, effectiveLabelsField string, skipAttribution bool) (map[string]string, bool, error) {
+	terraformLabels := make(map[string]string)
+	for k, v := range config.DefaultLabels {
+		terraformLabels[k] = v
+	}
+
+	// `default_label` blocks take precedence over `default_labels`, and the
+	// last block applying to the resource sets the value of its key.
+	enforced := make(map[string]string)
+	unknown := make(map[string]bool)
+	for _, label := range config.ScopedDefaultLabels {
+		if !label.AppliesTo(config.ResourceType()) {
+			continue
+		}
+		value, err := renderDefaultLabel(ctx, d, config, label)
+		if errors.Is(err, errDefaultLabelUnknown) {
+			unknown[label.Key] = label.Enforced
+			delete(enforced, label.Key)
+			continue
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		terraformLabels[label.Key] = value
+		delete(unknown, label.Key)
+		if label.Enforced {
+			enforced[label.Key] = value
+		} else {
+			delete(enforced, label.Key)
+		}
+	}
+
+	// Append optional label indicating the resource was provisioned using Terraform
+	if !skipAttribution && config.AddTerraformAttributionLabel {
+		if el, ok := d.Get(effectiveLabelsField).(map[string]any); ok {
+			_, hasExistingLabel := el[transport_tpg.AttributionKey]
+			if hasExistingLabel ||
+				config.TerraformAttributionLabelAdditionStrategy == transport_tpg.ProactiveAttributionStrategy ||
+				(config.TerraformAttributionLabelAdditionStrategy == transport_tpg.CreateOnlyAttributionStrategy && d.Id() == "") {
+				terraformLabels[transport_tpg.AttributionKey] = transport_tpg.AttributionValue
+			}
+		}
+	}
+
+	labels := d.Get(labelsField).(map[string]interface{})
+	for k, v := range labels {
+		if value, ok := enforced[k]; ok && value != v.(string) {
+			return nil, false, fmt.Errorf("`%s` can't set label %q to %q, as the provider enforces the value %q", labelsField, k, v, value)
+		}
+		// The value of an enforced label must be known to check it against
+		// the resource's. Other labels are overridden by the resource's.
+		if isEnforced, ok := unknown[k]; ok {
+			if isEnforced {
+				return nil, false, fmt.Errorf("`%s` can't set label %q, as the provider enforces a value that isn't known until apply", labelsField, k)
+			}
+			delete(unknown, k)
+		}
+		terraformLabels[k] = v.(string)
+	}
+
+	return terraformLabels, len(unknown) == 0, nil
+}
+
+// errDefaultLabelUnknown is returned by renderDefaultLabel if the value of a
+// `default_label` block references attributes that aren't known yet.
+var errDefaultLabelUnknown = errors.New("isn't known until apply")
+
+// renderDefaultLabel returns the value of a `default_label` block for the
+// resource.
+func renderDefaultLabel(ctx context.Context, d *schema.ResourceDiff, config *transport_tpg.Config, label *transport_tpg.DefaultLabel) (string, error) {
+	vars := make(map[string]string)
+	for _, name := range label.Variables() {
+		v, err := defaultLabelTemplateVariable(ctx, d, config, name)
+		if err != nil {
+			return "", fmt.Errorf("unable to render the value of default label %q: %w", label.Key, err)
+		}
+		vars[name] = v
+	}
+	return label.RenderValue(vars)
+}
+
+func defaultLabelTemplateVariable(ctx context.Context, d *schema.ResourceDiff, config *transport_tpg.Config, name string) (string, error) {
+	switch name {
+	case "module_name":
+		return transport_tpg.ModuleNameFromContext(ctx), nil
+	case "resource_type":
+		return config.ResourceType(), nil
+	}
+
+	// The other variables are the value of the first of their fields set in
+	// the resource, or else of the provider's defaults. The location is found
+	// as in GetLocation.
+	var fields []string
+	var defaults []string
+	switch name {
+	case "project":
+		fields, defaults = []string{"project"}, []string{config.Project}
+	case "region":
+		fields, defaults = []string{"region"}, []string{config.Region}
+	case "zone":
+		fields, defaults = []string{"zone"}, []string{config.Zone}
+	case "location":
+		fields, defaults = []string{"location", "region", "zone"}, []string{config.Region, config.Zone}
+	default:
+		return "", fmt.Errorf("unknown variable {{%s}}", name)
+	}
+	for _, field := range fields {
+		if !d.NewValueKnown(field) {
+			return "", fmt.Errorf("{{%s}} %w", name, errDefaultLabelUnknown)
+		}
+		if v, ok := d.Get(field).(string); ok && v != "" {
+			return GetResourceNameFromSelfLink(v), nil
+		}
+	}
+	for _, v := range defaults {
+		if v != "" {
+			return GetResourceNameFromSelfLink(v), nil
+		}
+	}
+	return "", fmt.Errorf("unable to determine {{%s}}: not set in the resource or provider config", name)
+}
+
 // Upgrade the field "labels" in the state to exclude the labels with the labels prefix
 // and the field "effective_labels" to have all of labels, including the labels with the labels prefix
 func LabelsStateUpgrade(rawState map[string]interface{}, labesPrefix string) (map[string]interface{}, error) {
@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package tpgresource
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+// testUnknownVariableValue is how raw resource configs represent unknown
+// values.
+const testUnknownVariableValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+// testLabelsResource plans the labels merged by mergeTerraformLabels into
+// `terraform_labels`.
+func testLabelsResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"project":          {Type: schema.TypeString, Optional: true},
+			"location":         {Type: schema.TypeString, Optional: true},
+			"labels":           {Type: schema.TypeMap, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"terraform_labels": {Type: schema.TypeMap, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"effective_labels": {Type: schema.TypeMap, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			labels, known, err := mergeTerraformLabels(ctx, d, meta.(*transport_tpg.Config), "labels", "effective_labels", true)
+			if err != nil {
+				return err
+			}
+			if !known {
+				return d.SetNewComputed("terraform_labels")
+			}
+			return d.SetNew("terraform_labels", labels)
+		},
+	}
+}
+
+func testDefaultLabels(t *testing.T, blocks ...map[string]interface{}) []*transport_tpg.DefaultLabel {
+	var raw []interface{}
+	for _, b := range blocks {
+		raw = append(raw, b)
+	}
+	labels, err := transport_tpg.ExpandProviderDefaultLabelConfig(raw)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return labels
+}
+
+func TestMergeTerraformLabels_scopedDefaultLabels(t *testing.T) {
+	config := &transport_tpg.Config{
+		Project:       "provider-project",
+		Region:        "us-central1",
+		DefaultLabels: map[string]string{"env": "dev", "team": "default"},
+		ScopedDefaultLabels: testDefaultLabels(t,
+			map[string]interface{}{"key": "cost-center", "value": "{{project}}-{{location}}"},
+			map[string]interface{}{"key": "team", "value": "{{module_name}}", "services": []interface{}{"compute"}},
+			map[string]interface{}{"key": "kind", "value": "{{resource_type}}", "resource_types": []interface{}{"google_storage_*"}},
+		),
+	}
+	ctx := transport_tpg.ContextWithModuleName(context.Background(), "my-module")
+
+	cases := map[string]struct {
+		ResourceType string
+		Config       map[string]interface{}
+		Expected     map[string]string
+	}{
+		"compute resource": {
+			ResourceType: "google_compute_address",
+			Config:       map[string]interface{}{"location": "europe-west1"},
+			Expected: map[string]string{
+				"env":         "dev",
+				"team":        "my-module",
+				"cost-center": "provider-project-europe-west1",
+			},
+		},
+		"storage resource": {
+			ResourceType: "google_storage_bucket",
+			Config:       map[string]interface{}{"project": "my-project", "labels": map[string]interface{}{"env": "prod"}},
+			Expected: map[string]string{
+				"env":         "prod",
+				"team":        "default",
+				"cost-center": "my-project-us-central1",
+				"kind":        "google_storage_bucket",
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			diff, err := testLabelsResource().Diff(ctx, nil, terraform.NewResourceConfigRaw(tc.Config), config.ForResourceType(tc.ResourceType))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := diff.Attributes["terraform_labels.%"].New; got != strconv.Itoa(len(tc.Expected)) {
+				t.Errorf("expected %d labels, got %s", len(tc.Expected), got)
+			}
+			for k, v := range tc.Expected {
+				if attr, ok := diff.Attributes["terraform_labels."+k]; !ok || attr.New != v {
+					t.Errorf("expected label %q to be %q, got %+v", k, v, attr)
+				}
+			}
+		})
+	}
+}
+
+func TestMergeTerraformLabels_enforcedDefaultLabels(t *testing.T) {
+	config := &transport_tpg.Config{
+		Project: "provider-project",
+		ScopedDefaultLabels: testDefaultLabels(t,
+			map[string]interface{}{"key": "cost-center", "value": "{{project}}", "enforced": true},
+		),
+	}
+
+	// Setting the enforced value is allowed.
+	_, err := testLabelsResource().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"labels": map[string]interface{}{"cost-center": "provider-project"},
+	}), config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = testLabelsResource().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"labels": map[string]interface{}{"cost-center": "other"},
+	}), config)
+	if err == nil || !strings.Contains(err.Error(), "enforces") {
+		t.Fatalf("expected overriding an enforced label to fail, got %v", err)
+	}
+}
+
+func TestMergeTerraformLabels_unknownTemplateVariable(t *testing.T) {
+	cases := map[string]struct {
+		Enforced        bool
+		Labels          map[string]interface{}
+		ExpectComputed  bool
+		ExpectError     string
+		ExpectCostLabel string
+	}{
+		"default label": {
+			ExpectComputed: true,
+		},
+		"enforced label": {
+			Enforced:       true,
+			ExpectComputed: true,
+		},
+		"default label overridden by the resource": {
+			Labels:          map[string]interface{}{"cost-center": "mine"},
+			ExpectCostLabel: "mine",
+		},
+		"enforced label set by the resource": {
+			Enforced:    true,
+			Labels:      map[string]interface{}{"cost-center": "mine"},
+			ExpectError: "isn't known until apply",
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			config := &transport_tpg.Config{
+				ScopedDefaultLabels: testDefaultLabels(t,
+					map[string]interface{}{"key": "cost-center", "value": "{{project}}", "enforced": tc.Enforced},
+				),
+			}
+
+			// The project is only known after apply.
+			raw := map[string]interface{}{"project": testUnknownVariableValue}
+			if tc.Labels != nil {
+				raw["labels"] = tc.Labels
+			}
+			diff, err := testLabelsResource().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), config)
+			if tc.ExpectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.ExpectError) {
+					t.Fatalf("expected error containing %q, got %v", tc.ExpectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := diff.Attributes["terraform_labels.%"]; got == nil || got.NewComputed != tc.ExpectComputed {
+				t.Errorf("expected terraform_labels computed to be %t, got %+v", tc.ExpectComputed, got)
+			}
+			if tc.ExpectCostLabel != "" {
+				if attr, ok := diff.Attributes["terraform_labels.cost-center"]; !ok || attr.New != tc.ExpectCostLabel {
+					t.Errorf("expected label cost-center to be %q, got %+v", tc.ExpectCostLabel, attr)
+				}
+			}
+		})
+	}
+
+	// The project isn't set in the resource or the provider.
+	config := &transport_tpg.Config{
+		ScopedDefaultLabels: testDefaultLabels(t,
+			map[string]interface{}{"key": "cost-center", "value": "{{project}}"},
+		),
+	}
+	_, err := testLabelsResource().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{}), config)
+	if err == nil {
+		t.Fatal("expected a missing project to fail")
+	}
+}
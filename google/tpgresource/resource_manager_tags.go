@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package tpgresource
+
+import (
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+// MergeDefaultResourceTags returns the resource manager tags to bind to a new
+// resource: the provider's `default_resource_tags` and the given tags, which
+// take precedence. Resource manager tags can only be set at creation, so this
+// is called by Create functions only. It returns nil if there are no tags, so
+// the field is left out of the request.
+func MergeDefaultResourceTags(config *transport_tpg.Config, tags map[string]string) map[string]string {
+	if len(config.DefaultResourceTags) == 0 {
+		return tags
+	}
+	merged := make(map[string]string, len(config.DefaultResourceTags)+len(tags))
+	for k, v := range config.DefaultResourceTags {
+		merged[k] = v
+	}
+	for k, v := range tags {
+		merged[k] = v
+	}
+	return merged
+}
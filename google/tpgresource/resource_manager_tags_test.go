@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package tpgresource
+
+import (
+	"reflect"
+	"testing"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+func TestMergeDefaultResourceTags(t *testing.T) {
+	cases := map[string]struct {
+		defaults map[string]string
+		tags     map[string]string
+		want     map[string]string
+	}{
+		"no defaults": {
+			tags: map[string]string{"tagKeys/1": "tagValues/1"},
+			want: map[string]string{"tagKeys/1": "tagValues/1"},
+		},
+		"no tags or defaults": {},
+		"defaults only": {
+			defaults: map[string]string{"tagKeys/1": "tagValues/1"},
+			want:     map[string]string{"tagKeys/1": "tagValues/1"},
+		},
+		"resource overrides defaults": {
+			defaults: map[string]string{"tagKeys/1": "tagValues/1", "tagKeys/2": "tagValues/2"},
+			tags:     map[string]string{"tagKeys/2": "tagValues/3"},
+			want:     map[string]string{"tagKeys/1": "tagValues/1", "tagKeys/2": "tagValues/3"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			config := &transport_tpg.Config{DefaultResourceTags: tc.defaults}
+			if got := MergeDefaultResourceTags(config, tc.tags); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package tpgresource
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+// resumedOperationWaiter polls an operation recorded by a ResumableWaiter.
+// It only relies on the fields shared by the operation types of the APIs we
+// use: `done` for google.longrunning operations and `status` for Compute,
+// Container and SQL style operations.
+type resumedOperationWaiter struct {
+	Config    *transport_tpg.Config
+	UserAgent string
+	URL       string
+	Op        map[string]interface{}
+}
+
+func (w *resumedOperationWaiter) TransportConfig() *transport_tpg.Config {
+	return w.Config
+}
+
+func (w *resumedOperationWaiter) OperationURL() string {
+	return w.URL
+}
+
+func (w *resumedOperationWaiter) State() string {
+	if w.Op == nil {
+		return "done: false"
+	}
+	if done, ok := w.Op["done"].(bool); ok {
+		return fmt.Sprintf("done: %v", done)
+	}
+	if status, ok := w.Op["status"].(string); ok {
+		return fmt.Sprintf("done: %v", status == "DONE")
+	}
+	return "done: false"
+}
+
+func (w *resumedOperationWaiter) Error() error {
+	opErr, ok := w.Op["error"].(map[string]interface{})
+	if !ok || len(opErr) == 0 {
+		return nil
+	}
+	// Compute operations report a list of errors
+	if errs, ok := opErr["errors"].([]interface{}); ok {
+		var msgs []string
+		for _, e := range errs {
+			if m, ok := e.(map[string]interface{}); ok {
+				msgs = append(msgs, fmt.Sprintf("%v: %v", m["code"], m["message"]))
+			}
+		}
+		return fmt.Errorf("%s", strings.Join(msgs, "\n"))
+	}
+	return fmt.Errorf("Error code %v, message: %v", opErr["code"], opErr["message"])
+}
+
+func (w *resumedOperationWaiter) IsRetryable(error) bool {
+	return false
+}
+
+func (w *resumedOperationWaiter) SetOp(op interface{}) error {
+	m, ok := op.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected operation type %T", op)
+	}
+	w.Op = m
+	return nil
+}
+
+func (w *resumedOperationWaiter) QueryOp() (interface{}, error) {
+	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    w.Config,
+		Method:    "GET",
+		RawURL:    w.URL,
+		UserAgent: w.UserAgent,
+	})
+}
+
+func (w *resumedOperationWaiter) OpName() string {
+	if name, ok := w.Op["name"].(string); ok {
+		return name
+	}
+	return w.URL
+}
+
+func (w *resumedOperationWaiter) PendingStates() []string {
+	return []string{"done: false"}
+}
+
+func (w *resumedOperationWaiter) TargetStates() []string {
+	return []string{"done: true"}
+}
+
+// ResumeOperationWait resumes polling an operation recorded when Terraform
+// was stopped during an earlier OperationWait, and waits for it to finish.
+func ResumeOperationWait(config *transport_tpg.Config, url, activity, userAgent string, timeout time.Duration) error {
+	w := &resumedOperationWaiter{
+		Config:    config,
+		UserAgent: userAgent,
+		URL:       url,
+	}
+	return OperationWait(w, activity, timeout, config.PollInterval)
+}
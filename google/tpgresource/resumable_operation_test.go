@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package tpgresource
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+func TestOperationWait_InterruptedResumableOperation(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"name": "operations/op", "done": false}`))
+	}))
+	defer ts.Close()
+
+	ctx, stop := context.WithCancel(context.Background())
+	var interrupted []transport_tpg.InterruptedOperation
+	config := (&transport_tpg.Config{Client: ts.Client(), Context: ctx}).TrackInterruptedOperations(func(op transport_tpg.InterruptedOperation) {
+		interrupted = append(interrupted, op)
+	})
+	w := &resumedOperationWaiter{Config: config, URL: ts.URL + "/v1/operations/op"}
+
+	time.AfterFunc(100*time.Millisecond, stop)
+	if err := OperationWait(w, "Creating Thing", time.Minute, time.Second); err == nil {
+		t.Fatal("expected an error when Terraform is stopped")
+	}
+	if len(interrupted) != 1 {
+		t.Fatalf("expected 1 interrupted operation, got %d", len(interrupted))
+	}
+	if interrupted[0].URL != w.URL || interrupted[0].Activity != "Creating Thing" {
+		t.Errorf("unexpected interrupted operation %#v", interrupted[0])
+	}
+}
+
+func TestResumeOperationWait(t *testing.T) {
+	cases := map[string]struct {
+		Responses   []string
+		ExpectError bool
+	}{
+		"longrunning": {
+			Responses: []string{`{"name": "op", "done": false}`, `{"name": "op", "done": true, "response": {}}`},
+		},
+		"longrunning error": {
+			Responses:   []string{`{"name": "op", "done": true, "error": {"code": 3, "message": "bad"}}`},
+			ExpectError: true,
+		},
+		"compute": {
+			Responses: []string{`{"name": "op", "status": "RUNNING"}`, `{"name": "op", "status": "DONE"}`},
+		},
+		"compute error": {
+			Responses:   []string{`{"name": "op", "status": "DONE", "error": {"errors": [{"code": "QUOTA_EXCEEDED", "message": "quota"}]}}`},
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			polls := 0
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(tc.Responses[polls]))
+				if polls < len(tc.Responses)-1 {
+					polls++
+				}
+			}))
+			defer ts.Close()
+
+			config := &transport_tpg.Config{Client: ts.Client(), PollInterval: 10 * time.Millisecond}
+			err := ResumeOperationWait(config, ts.URL+"/v1/operations/op", "resumed creation", "", time.Minute)
+			if tc.ExpectError && err == nil {
+				t.Fatal("expected an error but got none")
+			}
+			if !tc.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -485,37 +485,23 @@ func ServiceAccountFQN(serviceAccount string, d TerraformResourceData, config *t
 	return fmt.Sprintf("projects/-/serviceAccounts/%s@%s.iam.gserviceaccount.com", serviceAccount, project), nil
 }
 
+// PaginatedListRequest lists every page of baseUrl, and returns the results
+// of flattener for each page. Prefer transport_tpg.ListAll when the items can
+// be read from a single response field.
 func PaginatedListRequest(project, baseUrl, userAgent string, config *transport_tpg.Config, flattener func(map[string]interface{}) []interface{}) ([]interface{}, error) {
-	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+	ls := make([]interface{}, 0)
+	it := transport_tpg.NewListIterator(transport_tpg.ListRequestOptions{
 		Config:    config,
-		Method:    "GET",
 		Project:   project,
 		RawURL:    baseUrl,
 		UserAgent: userAgent,
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	ls := flattener(res)
-	pageToken, ok := res["pageToken"]
-	for ok {
-		if pageToken.(string) == "" {
-			break
-		}
-		url := fmt.Sprintf("%s?pageToken=%s", baseUrl, pageToken.(string))
-		res, err = transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
-			Config:    config,
-			Method:    "GET",
-			Project:   project,
-			RawURL:    url,
-			UserAgent: userAgent,
-		})
+	for !it.Done() {
+		page, err := it.Next()
 		if err != nil {
 			return nil, err
 		}
-		ls = append(ls, flattener(res))
-		pageToken, ok = res["pageToken"]
+		ls = append(ls, flattener(page.Response)...)
 	}
 
 	return ls, nil
@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package tpgresource
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// GetRawConfigAttributeAsString returns the value of the top level string
+// attribute key in the resource's configuration, or "" if it's not set.
+// Write-only attributes are never stored in the plan or state, so `d.Get`
+// always returns their zero value; this is the only way to read them, and
+// only from Create and Update functions.
+func GetRawConfigAttributeAsString(d *schema.ResourceData, key string) (string, error) {
+	v, diags := d.GetRawConfigAt(cty.GetAttrPath(key))
+	if diags.HasError() {
+		return "", fmt.Errorf("error reading %s from the configuration: %v", key, diags)
+	}
+	if v.IsNull() || !v.IsKnown() || !v.Type().Equals(cty.String) {
+		return "", nil
+	}
+	return v.AsString(), nil
+}
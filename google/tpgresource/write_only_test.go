@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package tpgresource
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestGetRawConfigAttributeAsString(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"password":    {Type: schema.TypeString, Optional: true},
+			"password_wo": {Type: schema.TypeString, Optional: true, WriteOnly: true},
+		},
+	}
+
+	cases := map[string]struct {
+		config cty.Value
+		want   string
+	}{
+		"set": {
+			config: cty.ObjectVal(map[string]cty.Value{
+				"password":    cty.NullVal(cty.String),
+				"password_wo": cty.StringVal("secret"),
+			}),
+			want: "secret",
+		},
+		"null": {
+			config: cty.ObjectVal(map[string]cty.Value{
+				"password":    cty.StringVal("other"),
+				"password_wo": cty.NullVal(cty.String),
+			}),
+			want: "",
+		},
+		"unknown": {
+			config: cty.ObjectVal(map[string]cty.Value{
+				"password":    cty.NullVal(cty.String),
+				"password_wo": cty.UnknownVal(cty.String),
+			}),
+			want: "",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d := r.Data(&terraform.InstanceState{RawConfig: tc.config})
+			got, err := GetRawConfigAttributeAsString(d, "password_wo")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,338 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+const auditLogRedacted = "REDACTED"
+
+// Request headers that are never written to the audit log.
+var auditLogRedactedHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"Cookie",
+	"X-Goog-Api-Key",
+}
+
+// Query parameters that are never written to the audit log.
+var auditLogRedactedQueryParams = []string{
+	"access_token",
+	"key",
+}
+
+var (
+	auditLogRegionalServiceRegex = regexp.MustCompile(`^[a-z]+-[a-z]+[0-9]+-[a-z]+$`)
+	auditLogVersionRegex         = regexp.MustCompile(`^v[0-9]+([a-z]+[0-9]*)?$`)
+)
+
+// AuditLogConfig contains user configuration for the request audit log.
+type AuditLogConfig struct {
+	// Path is the file that audit log entries are appended to.
+	Path string
+
+	// IncludeBodies adds the JSON request and response bodies to each entry.
+	IncludeBodies bool
+
+	// SensitiveFields are the names of fields that are redacted from request
+	// and response bodies. Names are compared ignoring case and underscores,
+	// so that schema field names (e.g. secret_data) match API field names
+	// (e.g. secretData).
+	SensitiveFields []string
+}
+
+// AuditLogEntry is a single line in the audit log, describing one HTTP
+// request sent to a Google API.
+type AuditLogEntry struct {
+	Time           string            `json:"time"`
+	Method         string            `json:"method"`
+	URL            string            `json:"url"`
+	Service        string            `json:"service"`
+	Resource       string            `json:"resource,omitempty"`
+	Status         int               `json:"status,omitempty"`
+	LatencyMs      int64             `json:"latency_ms"`
+	Attempt        int               `json:"attempt"`
+	Operation      string            `json:"operation,omitempty"`
+	Identity       string            `json:"identity,omitempty"`
+	Error          string            `json:"error,omitempty"`
+	RequestHeaders map[string]string `json:"request_headers,omitempty"`
+	RequestBody    interface{}       `json:"request_body,omitempty"`
+	ResponseBody   interface{}       `json:"response_body,omitempty"`
+}
+
+type retryAttemptKey struct{}
+
+// contextWithRetryAttempt records the retry attempt number of a request for
+// the transports wrapped by the retry transport.
+func contextWithRetryAttempt(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, retryAttemptKey{}, attempt)
+}
+
+// RetryAttemptFromContext returns the retry attempt number recorded by the
+// retry transport, starting at 0 for the first attempt.
+func RetryAttemptFromContext(ctx context.Context) int {
+	if attempt, ok := ctx.Value(retryAttemptKey{}).(int); ok {
+		return attempt
+	}
+	return 0
+}
+
+// auditLogSink serializes writes to a single audit log file. Sinks are shared
+// between provider configurations writing to the same path.
+type auditLogSink struct {
+	sync.Mutex
+	file *os.File
+}
+
+var auditLogSinks = struct {
+	sync.Mutex
+	sinks map[string]*auditLogSink
+}{
+	sinks: make(map[string]*auditLogSink),
+}
+
+func openAuditLogSink(path string) (*auditLogSink, error) {
+	auditLogSinks.Lock()
+	defer auditLogSinks.Unlock()
+
+	if sink, ok := auditLogSinks.sinks[path]; ok {
+		return sink, nil
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open audit log %q: %s", path, err)
+	}
+	sink := &auditLogSink{file: f}
+	auditLogSinks.sinks[path] = sink
+	return sink, nil
+}
+
+func (s *auditLogSink) write(entry *AuditLogEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	s.Lock()
+	defer s.Unlock()
+	_, err = s.file.Write(line)
+	return err
+}
+
+// NewTransportWithAuditLog constructs an auditLogTransport writing an entry
+// for every request to the file configured in config.
+func NewTransportWithAuditLog(t http.RoundTripper, config *AuditLogConfig, identity string) (*auditLogTransport, error) {
+	sink, err := openAuditLogSink(config.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	sensitiveFields := make(map[string]bool)
+	for _, f := range config.SensitiveFields {
+		sensitiveFields[normalizeAuditLogFieldName(f)] = true
+	}
+
+	return &auditLogTransport{
+		internal:        t,
+		sink:            sink,
+		identity:        identity,
+		includeBodies:   config.IncludeBodies,
+		sensitiveFields: sensitiveFields,
+	}, nil
+}
+
+// auditLogTransport is a http.RoundTripper writing one structured JSON line
+// per request to an audit log file.
+type auditLogTransport struct {
+	internal        http.RoundTripper
+	sink            *auditLogSink
+	identity        string
+	includeBodies   bool
+	sensitiveFields map[string]bool
+}
+
+// RoundTrip implements the RoundTripper interface method.
+func (t *auditLogTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	entry := &AuditLogEntry{
+		Method:         req.Method,
+		URL:            redactAuditLogURL(req.URL),
+		Service:        auditLogService(req.URL),
+		Resource:       auditLogResource(req.URL),
+		Attempt:        RetryAttemptFromContext(req.Context()),
+		Identity:       t.identity,
+		RequestHeaders: redactAuditLogHeaders(req.Header),
+	}
+	if t.includeBodies && req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		if err == nil {
+			entry.RequestBody = t.redactBody(body)
+		}
+	}
+
+	start := time.Now()
+	resp, err := t.internal.RoundTrip(req)
+	entry.Time = start.UTC().Format(time.RFC3339Nano)
+	entry.LatencyMs = time.Since(start).Milliseconds()
+
+	if err != nil {
+		entry.Error = err.Error()
+	} else {
+		entry.Status = resp.StatusCode
+		if isJSONResponse(resp) && resp.Body != nil && resp.Body != http.NoBody {
+			body, readErr := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+			if readErr == nil {
+				entry.Operation = auditLogOperationName(body)
+				if t.includeBodies {
+					entry.ResponseBody = t.redactBody(body)
+				}
+			}
+		}
+	}
+
+	if writeErr := t.sink.write(entry); writeErr != nil {
+		log.Printf("[WARN] Audit Log Transport: unable to write entry for %s %s: %s", entry.Method, entry.URL, writeErr)
+	}
+	return resp, err
+}
+
+func isJSONResponse(resp *http.Response) bool {
+	return strings.Contains(resp.Header.Get("Content-Type"), "json")
+}
+
+// redactBody decodes a JSON body and replaces the values of sensitive fields.
+// Bodies that aren't JSON are replaced by a placeholder, since they can't be
+// redacted.
+func (t *auditLogTransport) redactBody(body []byte) interface{} {
+	if len(body) == 0 {
+		return nil
+	}
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return auditLogRedacted
+	}
+	return t.redactValue(v)
+}
+
+func (t *auditLogTransport) redactValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, fieldV := range val {
+			if t.sensitiveFields[normalizeAuditLogFieldName(k)] {
+				val[k] = auditLogRedacted
+				continue
+			}
+			val[k] = t.redactValue(fieldV)
+		}
+		return val
+	case []interface{}:
+		for i, item := range val {
+			val[i] = t.redactValue(item)
+		}
+		return val
+	default:
+		return v
+	}
+}
+
+func normalizeAuditLogFieldName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+func redactAuditLogHeaders(h http.Header) map[string]string {
+	if len(h) == 0 {
+		return nil
+	}
+	headers := make(map[string]string, len(h))
+	for k, v := range h {
+		headers[k] = strings.Join(v, ", ")
+	}
+	for _, k := range auditLogRedactedHeaders {
+		if _, ok := headers[http.CanonicalHeaderKey(k)]; ok {
+			headers[http.CanonicalHeaderKey(k)] = auditLogRedacted
+		}
+	}
+	return headers
+}
+
+func redactAuditLogURL(u *url.URL) string {
+	redacted := *u
+	q := redacted.Query()
+	changed := false
+	for _, p := range auditLogRedactedQueryParams {
+		if q.Has(p) {
+			q.Set(p, auditLogRedacted)
+			changed = true
+		}
+	}
+	if changed {
+		redacted.RawQuery = q.Encode()
+	}
+	redacted.User = nil
+	return redacted.String()
+}
+
+// auditLogService returns the name of the API a request is sent to, e.g.
+// "compute" for compute.googleapis.com. APIs served from www.googleapis.com
+// are identified by the first segment of their path instead.
+func auditLogService(u *url.URL) string {
+	host := u.Hostname()
+	if host == "www.googleapis.com" {
+		if segments := strings.Split(strings.TrimPrefix(u.Path, "/"), "/"); segments[0] != "" {
+			return segments[0]
+		}
+	}
+	service := strings.SplitN(host, ".", 2)[0]
+	// Regional endpoints are prefixed with their location, e.g.
+	// us-central1-aiplatform.googleapis.com.
+	if auditLogRegionalServiceRegex.MatchString(service) {
+		service = service[strings.LastIndex(service, "-")+1:]
+	}
+	return service
+}
+
+// auditLogResource returns the resource path of a request, i.e. the part of
+// the path after the API version, e.g. "projects/p/zones/z/instances/i".
+func auditLogResource(u *url.URL) string {
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	for i, s := range segments {
+		if auditLogVersionRegex.MatchString(s) {
+			return strings.Join(segments[i+1:], "/")
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// auditLogOperationName returns the name of the long-running operation in a
+// response body, if the response is an operation.
+func auditLogOperationName(body []byte) string {
+	var op struct {
+		Kind string `json:"kind"`
+		Name string `json:"name"`
+		Done *bool  `json:"done"`
+	}
+	if err := json.Unmarshal(body, &op); err != nil || op.Name == "" {
+		return ""
+	}
+	if strings.HasSuffix(op.Kind, "#operation") || op.Done != nil || strings.Contains(op.Name, "/operations/") {
+		return op.Name
+	}
+	return ""
+}
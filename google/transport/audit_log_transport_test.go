@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAuditLogTransport_WritesEntries(t *testing.T) {
+	attempts := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"kind": "compute#operation", "name": "operation-123", "status": "RUNNING"}`))
+	}))
+	defer ts.Close()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	auditTransport, err := NewTransportWithAuditLog(http.DefaultTransport, &AuditLogConfig{Path: path}, "user@example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client := ts.Client()
+	client.Transport = NewTransportWithDefaultRetries(auditTransport)
+
+	ctx, cc := context.WithTimeout(context.Background(), time.Second*20)
+	defer cc()
+	req, err := http.NewRequestWithContext(ctx, "POST", ts.URL+"/compute/v1/projects/p/zones/z/instances?access_token=secret", strings.NewReader(`{"name": "i"}`))
+	if err != nil {
+		t.Fatalf("unable to construct request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer secret")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	entries := readAuditLogEntries(t, path)
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 audit log entries, got %d", len(entries))
+	}
+	for i, entry := range entries {
+		if entry.Attempt != i {
+			t.Errorf("expected entry %d to be attempt %d, got %d", i, i, entry.Attempt)
+		}
+		if entry.Method != "POST" {
+			t.Errorf("expected method POST, got %q", entry.Method)
+		}
+		if entry.Resource != "projects/p/zones/z/instances" {
+			t.Errorf("expected resource projects/p/zones/z/instances, got %q", entry.Resource)
+		}
+		if entry.Identity != "user@example.com" {
+			t.Errorf("expected identity user@example.com, got %q", entry.Identity)
+		}
+		if strings.Contains(entry.URL, "secret") {
+			t.Errorf("expected access_token to be redacted from URL, got %q", entry.URL)
+		}
+		if entry.RequestHeaders["Authorization"] != auditLogRedacted {
+			t.Errorf("expected Authorization header to be redacted, got %q", entry.RequestHeaders["Authorization"])
+		}
+		if entry.RequestBody != nil {
+			t.Errorf("expected request body to be omitted, got %v", entry.RequestBody)
+		}
+	}
+	if entries[0].Status != http.StatusServiceUnavailable || entries[1].Status != http.StatusOK {
+		t.Errorf("expected statuses 503 and 200, got %d and %d", entries[0].Status, entries[1].Status)
+	}
+	if entries[1].Operation != "operation-123" {
+		t.Errorf("expected operation operation-123, got %q", entries[1].Operation)
+	}
+}
+
+func TestAuditLogTransport_RedactsSensitiveFields(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"name": "projects/p/secrets/s/versions/1", "payload": {"data": "c2VjcmV0"}}`))
+	}))
+	defer ts.Close()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	auditTransport, err := NewTransportWithAuditLog(http.DefaultTransport, &AuditLogConfig{
+		Path:            path,
+		IncludeBodies:   true,
+		SensitiveFields: []string{"data", "password"},
+	}, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client := ts.Client()
+	client.Transport = auditTransport
+
+	resp, err := client.Post(ts.URL+"/v1/projects/p/users", "application/json", strings.NewReader(`{"name": "u", "password": "hunter2"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unable to read audit log: %v", err)
+	}
+	if strings.Contains(string(raw), "hunter2") || strings.Contains(string(raw), "c2VjcmV0") {
+		t.Fatalf("expected sensitive fields to be redacted, got %s", raw)
+	}
+
+	entries := readAuditLogEntries(t, path)
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 audit log entry, got %d", len(entries))
+	}
+	if got := entries[0].RequestBody.(map[string]interface{})["name"]; got != "u" {
+		t.Errorf("expected non-sensitive request fields to be kept, got %v", got)
+	}
+}
+
+func TestAuditLogService(t *testing.T) {
+	cases := map[string]string{
+		"https://compute.googleapis.com/compute/v1/projects/p":            "compute",
+		"https://www.googleapis.com/storage/v1/b/bucket":                  "storage",
+		"https://us-central1-aiplatform.googleapis.com/v1/projects/p":     "aiplatform",
+		"https://cloudresourcemanager.googleapis.com/v3/projects/p":       "cloudresourcemanager",
+		"https://secretmanager.us-central1.rep.googleapis.com/v1/project": "secretmanager",
+	}
+	for rawURL, expected := range cases {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			t.Fatalf("unable to parse %q: %v", rawURL, err)
+		}
+		if got := auditLogService(u); got != expected {
+			t.Errorf("expected service %q for %q, got %q", expected, rawURL, got)
+		}
+	}
+}
+
+func readAuditLogEntries(t *testing.T, path string) []AuditLogEntry {
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("unable to open audit log: %v", err)
+	}
+	defer f.Close()
+
+	var entries []AuditLogEntry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry AuditLogEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("unable to parse audit log line %q: %v", scanner.Text(), err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
@@ -102,6 +102,31 @@ type batchSubscriber struct {
 type BatchingConfig struct {
 	SendAfter      time.Duration
 	EnableBatching bool
+
+	// IamParentTypes overrides whether the IAM resources of parents of a
+	// resource type, such as "google_storage_bucket", batch changes to their
+	// parent's IAM policy. The "*" entry applies to every parent type without
+	// an entry of its own.
+	IamParentTypes map[string]bool
+}
+
+// BatchesIamPolicyChanges reports whether the IAM resources of parents of
+// the given resource type batch changes to their parent's IAM policy.
+// byDefault is used for parent types not configured in IamParentTypes.
+func (c *BatchingConfig) BatchesIamPolicyChanges(parentType string, byDefault bool) bool {
+	if c == nil {
+		return byDefault
+	}
+	if !c.EnableBatching {
+		return false
+	}
+	if enabled, ok := c.IamParentTypes[parentType]; ok && parentType != "" {
+		return enabled
+	}
+	if enabled, ok := c.IamParentTypes["*"]; ok {
+		return enabled
+	}
+	return byDefault
 }
 
 // Initializes a new batcher.
@@ -3,12 +3,14 @@
 package transport
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type (
@@ -36,18 +38,41 @@ func SuccessPollResult() PollResult {
 
 func PollingWaitTime(pollF PollReadFunc, checkResponse PollCheckResponseFunc, activity string,
 	timeout time.Duration, targetOccurrences int) error {
+	return PollingWaitTimeContext(context.Background(), pollF, checkResponse, activity, timeout, targetOccurrences)
+}
+
+// PollingWaitTimeContext is PollingWaitTime, recording the wait and each poll
+// as spans of the span in ctx if tracing is configured.
+func PollingWaitTimeContext(ctx context.Context, pollF PollReadFunc, checkResponse PollCheckResponseFunc, activity string,
+	timeout time.Duration, targetOccurrences int) (err error) {
 	log.Printf("[DEBUG] %s: Polling until expected state is read", activity)
 	log.Printf("[DEBUG] Target occurrences: %d", targetOccurrences)
+
+	ctx, span := StartSpanContext(ctx, activity, attribute.Int("poll.target_occurrences", targetOccurrences))
+	defer func() { EndSpan(span, err) }()
+	polls := 0
+	poll := func() *retry.RetryError {
+		polls++
+		_, pollSpan := StartSpanContext(ctx, fmt.Sprintf("poll %d", polls))
+		readResp, readErr := pollF()
+		rerr := checkResponse(readResp, readErr)
+		switch {
+		case rerr == nil:
+			EndSpan(pollSpan, nil)
+		case rerr.Retryable:
+			// The expected state wasn't read yet; this isn't a failure.
+			pollSpan.SetAttributes(attribute.String("poll.pending", rerr.Err.Error()))
+			EndSpan(pollSpan, nil)
+		default:
+			EndSpan(pollSpan, rerr.Err)
+		}
+		return rerr
+	}
+
 	if targetOccurrences == 1 {
-		return retry.Retry(timeout, func() *retry.RetryError {
-			readResp, readErr := pollF()
-			return checkResponse(readResp, readErr)
-		})
+		return retry.Retry(timeout, poll)
 	}
-	return RetryWithTargetOccurrences(timeout, targetOccurrences, func() *retry.RetryError {
-		readResp, readErr := pollF()
-		return checkResponse(readResp, readErr)
-	})
+	return RetryWithTargetOccurrences(timeout, targetOccurrences, poll)
 }
 
 // RetryWithTargetOccurrences is a basic wrapper around StateChangeConf that will retry
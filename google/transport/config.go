@@ -24,6 +24,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/api/option"
 	"google.golang.org/api/option/internaloption"
 
@@ -162,6 +165,8 @@ type Config struct {
 	DCLConfig
 	AccessToken                               string
 	Credentials                               string
+	CredentialsCommand                        *CredentialsCommand
+	AccessTokenRefreshCommand                 *CredentialsCommand
 	ImpersonateServiceAccount                 string
 	ImpersonateServiceAccountDelegates        []string
 	Project                                   string
@@ -171,10 +176,18 @@ type Config struct {
 	UniverseDomain                            string
 	Scopes                                    []string
 	BatchingConfig                            *BatchingConfig
+	RateLimits                                []*RateLimitConfig
+	RetryConfig                               *RetryConfig
+	AuditLogConfig                            *AuditLogConfig
+	TracingConfig                             *TracingConfig
 	UserProjectOverride                       bool
 	RequestReason                             string
+	ReadOnly                                  bool
 	RequestTimeout                            time.Duration
 	DefaultLabels                             map[string]string
+	ScopedDefaultLabels                       []*DefaultLabel
+	DefaultAnnotations                        map[string]string
+	DefaultResourceTags                       map[string]string
 	AddTerraformAttributionLabel              bool
 	TerraformAttributionLabelAdditionStrategy string
 	// PollInterval is passed to retry.StateChangeConf in common_operation.go
@@ -185,6 +198,21 @@ type Config struct {
 	Context            context.Context
 	UserAgent          string
 	gRPCLoggingOptions []option.ClientOption
+	// identity is the email of the identity making API calls, as resolved by
+	// logGoogleIdentities.
+	identity string
+	// tracerProvider and tracer are set if tracing is configured.
+	tracerProvider *sdktrace.TracerProvider
+	tracer         trace.Tracer
+	// traceContext carries the span of the operation a copy of the
+	// configuration was created for. See StartSpan.
+	traceContext context.Context
+	// recordInterruptedOperation is set on copies of the configuration
+	// created by TrackInterruptedOperations.
+	recordInterruptedOperation func(InterruptedOperation)
+	// resourceType is set on copies of the configuration created by
+	// ForResourceType.
+	resourceType string
 
 	TokenSource oauth2.TokenSource
 
@@ -1445,6 +1473,15 @@ func (c *Config) LoadAndValidate(ctx context.Context) error {
 
 	c.Context = ctx
 
+	if c.TracingConfig != nil {
+		tp, err := tracerProviderFor(ctx, c.TracingConfig)
+		if err != nil {
+			return err
+		}
+		c.tracerProvider = tp
+		c.tracer = tp.Tracer(tracerName)
+	}
+
 	tokenSource, err := c.getTokenSource(c.Scopes, false)
 	if err != nil {
 		return err
@@ -1478,13 +1515,36 @@ func (c *Config) LoadAndValidate(ctx context.Context) error {
 	// 2. Logging Transport - ensure we log HTTP requests to GCP APIs.
 	loggingTransport := logging.NewTransport("Google", client.Transport)
 
-	// 3. Retry Transport - retries common temporary errors
+	// 3. Audit Log Transport - optionally writes a structured entry for each HTTP request
+	// Keep order for wrapping retries so each retried request is audited as well.
+	var auditTransport http.RoundTripper = loggingTransport
+	if c.AuditLogConfig != nil {
+		auditTransport, err = NewTransportWithAuditLog(loggingTransport, c.AuditLogConfig, c.identity)
+		if err != nil {
+			return err
+		}
+	}
+
+	// 4. Rate Limit Transport - throttles requests to services with configured rate limits
+	// Keep order for wrapping retries so each retried request waits on the limiter as well.
+	rateLimitTransport := NewTransportWithRateLimits(auditTransport, c.RateLimits)
+
+	// 5. Retry Transport - retries common temporary errors
 	// Keep order for wrapping logging so we log each retried request as well.
 	// This value should be used if needed to create shallow copies with additional retry predicates.
 	// See ClientWithAdditionalRetries
-	retryTransport := NewTransportWithDefaultRetries(loggingTransport)
+	// Each request and retry attempt is recorded as a span if tracing is configured.
+	// Requests whose access token is rejected are sent again once if the token can be refreshed.
+	// The retryable errors of the provider configuration apply to this transport and to Retry.
+	if c.RetryConfig != nil {
+		c.RetryConfig.compileRetryableErrors()
+	}
+	retryTransport := NewTransportWithDefaultRetries(rateLimitTransport).WithRetryConfig(c.RetryConfig).WithTracer(c.tracer)
+	if refresher, ok := tokenSource.(TokenRefresher); ok {
+		retryTransport = retryTransport.WithTokenRefresher(refresher)
+	}
 
-	// 4. Header Transport - outer wrapper to inject additional headers we want to apply
+	// 6. Header Transport - outer wrapper to inject additional headers we want to apply
 	// before making requests
 	headerTransport := NewTransportWithHeaders(retryTransport)
 	if c.RequestReason != "" {
@@ -1501,8 +1561,15 @@ func (c *Config) LoadAndValidate(ctx context.Context) error {
 		headerTransport.Set("X-Goog-User-Project", c.BillingProject)
 	}
 
+	// 7. Read-only Transport - optionally rejects any request that could modify resources
+	// before it's sent, retried, audited or logged.
+	var finalTransport http.RoundTripper = headerTransport
+	if c.ReadOnly {
+		finalTransport = NewTransportWithReadOnlyGuard(headerTransport)
+	}
+
 	// Set final transport value.
-	client.Transport = headerTransport
+	client.Transport = finalTransport
 
 	// This timeout is a timeout per HTTP request, not per logical operation.
 	client.Timeout = c.synchronousTimeout()
@@ -1532,6 +1599,18 @@ func (c *Config) LoadAndValidate(ctx context.Context) error {
 		option.WithGRPCDialOption(grpc.WithStreamInterceptor(
 			grpc_logrus.PayloadStreamClientInterceptor(logrus.NewEntry(logger), alwaysLoggingDeciderClient))),
 	)
+	if c.ReadOnly {
+		c.gRPCLoggingOptions = append(
+			c.gRPCLoggingOptions, option.WithGRPCDialOption(grpc.WithChainUnaryInterceptor(ReadOnlyGRPCUnaryInterceptor)),
+			option.WithGRPCDialOption(grpc.WithChainStreamInterceptor(ReadOnlyGRPCStreamInterceptor)),
+		)
+	}
+	if c.tracerProvider != nil {
+		c.gRPCLoggingOptions = append(
+			c.gRPCLoggingOptions, option.WithGRPCDialOption(grpc.WithStatsHandler(
+				otelgrpc.NewClientHandler(otelgrpc.WithTracerProvider(c.tracerProvider)))),
+		)
+	}
 
 	return nil
 }
@@ -1563,6 +1642,91 @@ func ExpandProviderBatchingConfig(v interface{}) (*BatchingConfig, error) {
 		config.EnableBatching = enable.(bool)
 	}
 
+	if iamV, ok := cfgV["iam"]; ok && iamV != nil {
+		for _, raw := range iamV.([]interface{}) {
+			if raw == nil {
+				continue
+			}
+			iamCfgV := raw.(map[string]interface{})
+			parentType, _ := iamCfgV["parent_type"].(string)
+			if parentType == "" {
+				return nil, fmt.Errorf("'parent_type' must be set for each 'iam' block in the 'batching' block")
+			}
+			if _, ok := config.IamParentTypes[parentType]; ok {
+				return nil, fmt.Errorf("'iam' block for parent type %q is set more than once in the 'batching' block", parentType)
+			}
+			if config.IamParentTypes == nil {
+				config.IamParentTypes = make(map[string]bool)
+			}
+			enable, _ := iamCfgV["enable_batching"].(bool)
+			config.IamParentTypes[parentType] = enable
+		}
+	}
+
+	return config, nil
+}
+
+func ExpandProviderRetryConfig(v interface{}) (*RetryConfig, error) {
+	config := &RetryConfig{
+		MaxElapsedTime: time.Second * defaultRetryTransportTimeoutSec,
+	}
+
+	if v == nil {
+		return config, nil
+	}
+	ls := v.([]interface{})
+	if len(ls) == 0 || ls[0] == nil {
+		return config, nil
+	}
+
+	cfgV := ls[0].(map[string]interface{})
+	if maxAttemptsV, ok := cfgV["max_attempts"]; ok {
+		config.MaxAttempts = maxAttemptsV.(int)
+		if config.MaxAttempts < 0 {
+			return nil, fmt.Errorf("'max_attempts' must not be negative, got %d", config.MaxAttempts)
+		}
+	}
+
+	if maxElapsedTimeV, ok := cfgV["max_elapsed_time"]; ok && maxElapsedTimeV != "" {
+		maxElapsedTime, err := time.ParseDuration(maxElapsedTimeV.(string))
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse duration from 'max_elapsed_time' value %q", maxElapsedTimeV)
+		}
+		config.MaxElapsedTime = maxElapsedTime
+		config.maxElapsedTimeSet = true
+	}
+
+	retryableErrors, err := expandProviderRetryableErrors(cfgV["retryable_error"])
+	if err != nil {
+		return nil, err
+	}
+	config.RetryableErrors = retryableErrors
+
+	return config, nil
+}
+
+func ExpandProviderAuditLogConfig(v interface{}) (*AuditLogConfig, error) {
+	if v == nil {
+		return nil, nil
+	}
+	ls := v.([]interface{})
+	if len(ls) == 0 || ls[0] == nil {
+		return nil, nil
+	}
+
+	cfgV := ls[0].(map[string]interface{})
+	config := &AuditLogConfig{}
+	if pathV, ok := cfgV["path"]; ok {
+		config.Path = pathV.(string)
+	}
+	if config.Path == "" {
+		return nil, fmt.Errorf("'path' must be set in the 'audit_log' block")
+	}
+
+	if includeBodies, ok := cfgV["include_bodies"]; ok {
+		config.IncludeBodies = includeBodies.(bool)
+	}
+
 	return config, nil
 }
 
@@ -1589,6 +1753,7 @@ func (c *Config) logGoogleIdentities() error {
 		}
 
 		log.Printf("[INFO] Terraform is using this identity: %s", email)
+		c.identity = email
 
 		return nil
 
@@ -1608,6 +1773,7 @@ func (c *Config) logGoogleIdentities() error {
 	}
 
 	log.Printf("[INFO] Terraform is configured with service account impersonation, original identity: %s, impersonated identity: %s", email, c.ImpersonateServiceAccount)
+	c.identity = c.ImpersonateServiceAccount
 
 	// Add the Impersonated ClientOption back in to the OAuth2 TokenSource
 
@@ -2158,7 +2324,7 @@ type StaticTokenSource struct {
 func (c *Config) GetCredentials(clientScopes []string, initialCredentialsOnly bool) (googleoauth.Credentials, error) {
 	// UniverseDomain is assumed to be the previously set provider-configured value for access tokens
 	if c.AccessToken != "" {
-		contents, _, err := verify.PathOrContents(c.AccessToken)
+		contents, wasPath, err := verify.PathOrContents(c.AccessToken)
 		if err != nil {
 			return googleoauth.Credentials{}, fmt.Errorf("Error loading access token: %s", err)
 		}
@@ -2175,11 +2341,52 @@ func (c *Config) GetCredentials(clientScopes []string, initialCredentialsOnly bo
 
 		log.Printf("[INFO] Authenticating using configured Google JSON 'access_token'...")
 		log.Printf("[INFO]   -- Scopes: %s", clientScopes)
+
+		// Tokens read from a file or paired with a refresh command are
+		// refreshed when the API rejects them. See TokenRefresher.
+		if c.AccessTokenRefreshCommand != nil {
+			log.Printf("[INFO]   -- Refreshing rejected tokens with 'access_token_refresh_command'")
+			refresh := &credentialsCommandTokenSource{ctx: c.Context, cmd: c.AccessTokenRefreshCommand}
+			return googleoauth.Credentials{
+				TokenSource: NewRefreshableTokenSource(token, refresh.Token),
+			}, nil
+		}
+		if wasPath {
+			log.Printf("[INFO]   -- Refreshing rejected tokens by reading 'access_token' again")
+			return googleoauth.Credentials{
+				TokenSource: NewRefreshableTokenSource(token, func() (*oauth2.Token, error) {
+					contents, _, err := verify.PathOrContents(c.AccessToken)
+					if err != nil {
+						return nil, fmt.Errorf("Error loading access token: %s", err)
+					}
+					return &oauth2.Token{AccessToken: contents}, nil
+				}),
+			}, nil
+		}
 		return googleoauth.Credentials{
 			TokenSource: StaticTokenSource{oauth2.StaticTokenSource(token)},
 		}, nil
 	}
 
+	// UniverseDomain is assumed to be the previously set provider-configured value for credentials commands
+	if c.CredentialsCommand != nil {
+		tokenSource := c.CredentialsCommand.TokenSource(c.Context)
+		if c.ImpersonateServiceAccount != "" && !initialCredentialsOnly {
+			opts := []option.ClientOption{option.WithTokenSource(tokenSource), option.ImpersonateCredentials(c.ImpersonateServiceAccount, c.ImpersonateServiceAccountDelegates...), option.WithScopes(clientScopes...)}
+			creds, err := transport.Creds(context.TODO(), opts...)
+			if err != nil {
+				return googleoauth.Credentials{}, err
+			}
+			return *creds, nil
+		}
+
+		log.Printf("[INFO] Authenticating using configured 'credentials_command'...")
+		log.Printf("[INFO]   -- Scopes: %s", clientScopes)
+		return googleoauth.Credentials{
+			TokenSource: tokenSource,
+		}, nil
+	}
+
 	// UniverseDomain is set by the credential file's "universe_domain" field
 	if c.Credentials != "" {
 		contents, _, err := verify.PathOrContents(c.Credentials)
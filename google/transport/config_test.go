@@ -430,6 +430,68 @@ func TestConfigLoadAndValidate_defaultBatchingConfig(t *testing.T) {
 	}
 }
 
+func TestExpandProviderBatchingConfig_iamParentTypes(t *testing.T) {
+	batchCfg, err := transport_tpg.ExpandProviderBatchingConfig([]interface{}{
+		map[string]interface{}{
+			"enable_batching": true,
+			"iam": []interface{}{
+				map[string]interface{}{
+					"parent_type":     "google_storage_bucket",
+					"enable_batching": true,
+				},
+				map[string]interface{}{
+					"parent_type":     "google_project",
+					"enable_batching": false,
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cases := []struct {
+		parentType string
+		byDefault  bool
+		expected   bool
+	}{
+		{"google_storage_bucket", false, true},
+		{"google_project", true, false},
+		{"google_pubsub_topic", false, false},
+		{"google_healthcare_dataset", true, true},
+	}
+	for _, tc := range cases {
+		if got := batchCfg.BatchesIamPolicyChanges(tc.parentType, tc.byDefault); got != tc.expected {
+			t.Errorf("expected batching of %s IAM changes to be %t, got %t", tc.parentType, tc.expected, got)
+		}
+	}
+
+	batchCfg.IamParentTypes["*"] = true
+	if !batchCfg.BatchesIamPolicyChanges("google_pubsub_topic", false) {
+		t.Errorf("expected \"*\" to enable batching for parent types without their own entry")
+	}
+	if batchCfg.BatchesIamPolicyChanges("google_project", true) {
+		t.Errorf("expected the entry of a parent type to take precedence over \"*\"")
+	}
+
+	batchCfg.EnableBatching = false
+	if batchCfg.BatchesIamPolicyChanges("google_storage_bucket", true) {
+		t.Errorf("expected `enable_batching = false` to disable IAM batching")
+	}
+
+	_, err = transport_tpg.ExpandProviderBatchingConfig([]interface{}{
+		map[string]interface{}{
+			"iam": []interface{}{
+				map[string]interface{}{"parent_type": "google_storage_bucket", "enable_batching": true},
+				map[string]interface{}{"parent_type": "google_storage_bucket", "enable_batching": false},
+			},
+		},
+	})
+	if err == nil {
+		t.Fatalf("expected an error for a parent type set twice")
+	}
+}
+
 func TestConfigLoadAndValidate_customBatchingConfig(t *testing.T) {
 	batchCfg, err := transport_tpg.ExpandProviderBatchingConfig([]interface{}{
 		map[string]interface{}{
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os/exec"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// credentialsCommandTimeout bounds how long a single run of a credentials
+// command may take.
+const credentialsCommandTimeout = time.Minute
+
+// CredentialsCommand is an executable run to obtain access tokens, configured
+// through the provider's `credentials_command` or `access_token_refresh_command`
+// blocks.
+//
+// The command must print a JSON object to stdout containing `access_token`
+// and optionally either `expiry` (an RFC 3339 timestamp) or `expires_in` (a
+// number of seconds). Tokens without an expiry are reused for as long as the
+// provider runs. The command is run again once the token expires.
+type CredentialsCommand struct {
+	Command string
+	Args    []string
+}
+
+// ExpandProviderCredentialsCommand parses a `credentials_command` or
+// `access_token_refresh_command` provider block. It returns nil if the block
+// isn't set.
+func ExpandProviderCredentialsCommand(v interface{}) (*CredentialsCommand, error) {
+	ls := v.([]interface{})
+	if len(ls) == 0 || ls[0] == nil {
+		return nil, nil
+	}
+	cfg := ls[0].(map[string]interface{})
+
+	cmd := &CredentialsCommand{}
+	if command, ok := cfg["command"].(string); ok {
+		cmd.Command = command
+	}
+	if cmd.Command == "" {
+		return nil, fmt.Errorf("`command` must be set to run a credentials command")
+	}
+	if args, ok := cfg["args"].([]interface{}); ok {
+		for _, arg := range args {
+			cmd.Args = append(cmd.Args, arg.(string))
+		}
+	}
+	return cmd, nil
+}
+
+// TokenSource returns a token source running the command whenever the last
+// token it returned has expired.
+func (c *CredentialsCommand) TokenSource(ctx context.Context) oauth2.TokenSource {
+	return oauth2.ReuseTokenSource(nil, &credentialsCommandTokenSource{ctx: ctx, cmd: c})
+}
+
+type credentialsCommandTokenSource struct {
+	ctx context.Context
+	cmd *CredentialsCommand
+}
+
+// credentialsCommandOutput is the JSON printed by a credentials command.
+type credentialsCommandOutput struct {
+	AccessToken string    `json:"access_token"`
+	TokenType   string    `json:"token_type"`
+	Expiry      time.Time `json:"expiry"`
+	ExpiresIn   int64     `json:"expires_in"`
+}
+
+func (ts *credentialsCommandTokenSource) Token() (*oauth2.Token, error) {
+	ctx := ts.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, credentialsCommandTimeout)
+	defer cancel()
+
+	log.Printf("[INFO] Running credentials command %q to obtain an access token", ts.cmd.Command)
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, ts.cmd.Command, ts.cmd.Args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("Error running credentials command %q: %s: %s", ts.cmd.Command, err, strings.TrimSpace(stderr.String()))
+	}
+
+	var out credentialsCommandOutput
+	if err := json.Unmarshal(stdout.Bytes(), &out); err != nil {
+		return nil, fmt.Errorf("Error parsing the output of credentials command %q: %s", ts.cmd.Command, err)
+	}
+	if out.AccessToken == "" {
+		return nil, fmt.Errorf("credentials command %q didn't return an `access_token`", ts.cmd.Command)
+	}
+
+	token := &oauth2.Token{
+		AccessToken: out.AccessToken,
+		TokenType:   out.TokenType,
+		Expiry:      out.Expiry,
+	}
+	if token.Expiry.IsZero() && out.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(out.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
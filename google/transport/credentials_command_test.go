@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestCredentialsCommandHelperProcess isn't a real test. It's run as the
+// credentials command by the tests below, printing its arguments after "--".
+func TestCredentialsCommandHelperProcess(t *testing.T) {
+	if os.Getenv("GO_WANT_CREDENTIALS_COMMAND_HELPER") != "1" {
+		return
+	}
+	args := os.Args
+	for len(args) > 0 && args[0] != "--" {
+		args = args[1:]
+	}
+	if len(args) > 0 {
+		args = args[1:]
+	}
+	if counter := os.Getenv("CREDENTIALS_COMMAND_COUNTER"); counter != "" {
+		f, err := os.OpenFile(counter, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err == nil {
+			f.WriteString("run\n")
+			f.Close()
+		}
+	}
+	if len(args) > 0 && args[0] == "fail" {
+		fmt.Fprint(os.Stderr, "broker unavailable")
+		os.Exit(1)
+	}
+	fmt.Print(strings.Join(args, " "))
+	os.Exit(0)
+}
+
+func helperCredentialsCommand(t *testing.T, output ...string) *CredentialsCommand {
+	t.Setenv("GO_WANT_CREDENTIALS_COMMAND_HELPER", "1")
+	return &CredentialsCommand{
+		Command: os.Args[0],
+		Args:    append([]string{"-test.run=TestCredentialsCommandHelperProcess", "--"}, output...),
+	}
+}
+
+func TestExpandProviderCredentialsCommand(t *testing.T) {
+	cmd, err := ExpandProviderCredentialsCommand([]interface{}{})
+	if err != nil || cmd != nil {
+		t.Fatalf("expected no command for an unset block, got %#v, %v", cmd, err)
+	}
+
+	cmd, err = ExpandProviderCredentialsCommand([]interface{}{map[string]interface{}{
+		"command": "broker",
+		"args":    []interface{}{"--role", "terraform"},
+	}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cmd.Command != "broker" || strings.Join(cmd.Args, " ") != "--role terraform" {
+		t.Fatalf("unexpected command %#v", cmd)
+	}
+
+	if _, err := ExpandProviderCredentialsCommand([]interface{}{map[string]interface{}{"command": ""}}); err == nil {
+		t.Fatal("expected an error for an empty command")
+	}
+}
+
+func TestCredentialsCommandTokenSource(t *testing.T) {
+	expiry := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	cases := map[string]struct {
+		Output      string
+		ExpectToken string
+		ExpectError string
+		ExpiresIn   bool
+	}{
+		"expiry": {
+			Output:      fmt.Sprintf(`{"access_token": "token-1", "expiry": %q}`, expiry.Format(time.RFC3339)),
+			ExpectToken: "token-1",
+		},
+		"expires_in": {
+			Output:      `{"access_token": "token-2", "expires_in": 3600}`,
+			ExpectToken: "token-2",
+			ExpiresIn:   true,
+		},
+		"no token": {
+			Output:      `{"expires_in": 3600}`,
+			ExpectError: "didn't return an `access_token`",
+		},
+		"invalid output": {
+			Output:      `token`,
+			ExpectError: "Error parsing the output",
+		},
+		"command failure": {
+			Output:      "fail",
+			ExpectError: "broker unavailable",
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			token, err := helperCredentialsCommand(t, tc.Output).TokenSource(context.Background()).Token()
+			if tc.ExpectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.ExpectError) {
+					t.Fatalf("expected error containing %q, got %v", tc.ExpectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if token.AccessToken != tc.ExpectToken {
+				t.Errorf("expected token %q, got %q", tc.ExpectToken, token.AccessToken)
+			}
+			if tc.ExpiresIn {
+				if d := time.Until(token.Expiry); d < 59*time.Minute || d > time.Hour {
+					t.Errorf("expected token to expire in an hour, got %s", token.Expiry)
+				}
+			} else if !token.Expiry.Equal(expiry) {
+				t.Errorf("expected expiry %s, got %s", expiry, token.Expiry)
+			}
+		})
+	}
+}
+
+func TestCredentialsCommandTokenSource_RefreshesExpiredTokens(t *testing.T) {
+	counter := filepath.Join(t.TempDir(), "runs")
+	t.Setenv("CREDENTIALS_COMMAND_COUNTER", counter)
+	runs := func() int {
+		raw, _ := os.ReadFile(counter)
+		return strings.Count(string(raw), "run")
+	}
+
+	// Tokens expiring within oauth2's expiry delta are treated as expired.
+	expired := helperCredentialsCommand(t, `{"access_token": "token", "expires_in": 1}`).TokenSource(context.Background())
+	for i := 0; i < 2; i++ {
+		if _, err := expired.Token(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if runs() != 2 {
+		t.Fatalf("expected the command to run for each expired token, ran %d times", runs())
+	}
+
+	valid := helperCredentialsCommand(t, `{"access_token": "token", "expires_in": 3600}`).TokenSource(context.Background())
+	for i := 0; i < 2; i++ {
+		if _, err := valid.Token(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if runs() != 3 {
+		t.Fatalf("expected a valid token to be reused, command ran %d times in total", runs())
+	}
+}
+
+func TestConfigGetCredentials_CredentialsCommand(t *testing.T) {
+	config := &Config{
+		CredentialsCommand: helperCredentialsCommand(t, `{"access_token": "command-token", "expires_in": 3600}`),
+	}
+	creds, err := config.GetCredentials([]string{"https://www.googleapis.com/auth/cloud-platform"}, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	token, err := creds.TokenSource.Token()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token.AccessToken != "command-token" {
+		t.Fatalf("expected the token returned by the command, got %q", token.AccessToken)
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DefaultLabelTemplateVariables are the variables that can be used in the
+// values of `default_label` blocks, e.g. "{{project}}".
+var DefaultLabelTemplateVariables = []string{
+	"project",
+	"region",
+	"zone",
+	"location",
+	"module_name",
+	"resource_type",
+}
+
+var defaultLabelTemplateRegexp = regexp.MustCompile(`{{([^{}]*)}}`)
+
+// DefaultLabel is a default label set by a `default_label` block of the
+// provider configuration. Unlike the labels of `default_labels`, it can be
+// scoped to some resource types or services, have a value templated from the
+// resource, and be enforced.
+type DefaultLabel struct {
+	Key   string
+	Value string
+	// ResourceTypes are the resource types the label is added to, in which
+	// `*` matches any sequence of characters. If neither ResourceTypes nor
+	// Services are set, the label is added to every resource.
+	ResourceTypes []string
+	// Services are the services, e.g. "compute", whose resources the label is
+	// added to.
+	Services []string
+	// Enforced labels can't be set to another value by resources.
+	Enforced bool
+
+	resourceTypes []*regexp.Regexp
+}
+
+func ExpandProviderDefaultLabelConfig(v interface{}) ([]*DefaultLabel, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var labels []*DefaultLabel
+	for _, raw := range v.([]interface{}) {
+		if raw == nil {
+			continue
+		}
+		cfgV := raw.(map[string]interface{})
+		label := &DefaultLabel{}
+		label.Key, _ = cfgV["key"].(string)
+		if label.Key == "" {
+			return nil, fmt.Errorf("'key' must be set for each 'default_label' block")
+		}
+		label.Value, _ = cfgV["value"].(string)
+		if err := validateDefaultLabelTemplate(label.Value); err != nil {
+			return nil, fmt.Errorf("invalid 'value' for 'default_label' block with key %q: %w", label.Key, err)
+		}
+		if typesV, ok := cfgV["resource_types"].([]interface{}); ok {
+			for _, t := range typesV {
+				resourceType, _ := t.(string)
+				label.ResourceTypes = append(label.ResourceTypes, resourceType)
+				label.resourceTypes = append(label.resourceTypes, resourceTypePatternRegexp(resourceType))
+			}
+		}
+		if servicesV, ok := cfgV["services"].([]interface{}); ok {
+			for _, s := range servicesV {
+				service, _ := s.(string)
+				label.Services = append(label.Services, service)
+			}
+		}
+		label.Enforced, _ = cfgV["enforced"].(bool)
+		labels = append(labels, label)
+	}
+
+	return labels, nil
+}
+
+func validateDefaultLabelTemplate(value string) error {
+	for _, match := range defaultLabelTemplateRegexp.FindAllStringSubmatch(value, -1) {
+		if !isDefaultLabelTemplateVariable(match[1]) {
+			return fmt.Errorf("unknown variable %q, expected one of %s", match[0], strings.Join(DefaultLabelTemplateVariables, ", "))
+		}
+	}
+	return nil
+}
+
+func isDefaultLabelTemplateVariable(name string) bool {
+	for _, v := range DefaultLabelTemplateVariables {
+		if v == name {
+			return true
+		}
+	}
+	return false
+}
+
+func resourceTypePatternRegexp(pattern string) *regexp.Regexp {
+	parts := strings.Split(pattern, "*")
+	for i, part := range parts {
+		parts[i] = regexp.QuoteMeta(part)
+	}
+	return regexp.MustCompile("^" + strings.Join(parts, ".*") + "$")
+}
+
+// AppliesTo returns whether the label is added to resources of the given
+// type. Labels scoped to some resource types or services never apply to
+// resources of an unknown type.
+func (l *DefaultLabel) AppliesTo(resourceType string) bool {
+	if len(l.resourceTypes) == 0 && len(l.Services) == 0 {
+		return true
+	}
+	for _, re := range l.resourceTypes {
+		if re.MatchString(resourceType) {
+			return true
+		}
+	}
+	for _, service := range l.Services {
+		if strings.HasPrefix(resourceType, "google_"+service+"_") {
+			return true
+		}
+	}
+	return false
+}
+
+// Variables returns the template variables used in the value of the label.
+func (l *DefaultLabel) Variables() []string {
+	var vars []string
+	for _, match := range defaultLabelTemplateRegexp.FindAllStringSubmatch(l.Value, -1) {
+		vars = append(vars, match[1])
+	}
+	return vars
+}
+
+// RenderValue returns the value of the label with its template variables
+// replaced by their values in vars.
+func (l *DefaultLabel) RenderValue(vars map[string]string) (string, error) {
+	var err error
+	value := defaultLabelTemplateRegexp.ReplaceAllStringFunc(l.Value, func(match string) string {
+		name := match[2 : len(match)-2]
+		v, ok := vars[name]
+		if !ok && err == nil {
+			err = fmt.Errorf("value of %q isn't known", match)
+		}
+		return v
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to render the value of default label %q: %w", l.Key, err)
+	}
+	return value, nil
+}
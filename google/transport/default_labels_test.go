@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"testing"
+)
+
+func TestExpandProviderDefaultLabelConfig(t *testing.T) {
+	labels, err := ExpandProviderDefaultLabelConfig([]interface{}{
+		map[string]interface{}{
+			"key":            "cost-center",
+			"value":          "{{project}}-{{location}}",
+			"resource_types": []interface{}{"google_storage_*", "google_pubsub_topic"},
+			"services":       []interface{}{"compute"},
+			"enforced":       true,
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(labels) != 1 || !labels[0].Enforced {
+		t.Fatalf("expected a single enforced label, got %+v", labels)
+	}
+
+	applies := map[string]bool{
+		"google_storage_bucket":        true,
+		"google_pubsub_topic":          true,
+		"google_compute_address":       true,
+		"google_pubsub_subscription":   false,
+		"google_container_cluster":     false,
+		"google_compute_address_extra": true,
+		"":                             false,
+	}
+	for resourceType, expected := range applies {
+		if got := labels[0].AppliesTo(resourceType); got != expected {
+			t.Errorf("expected AppliesTo(%q) to be %t, got %t", resourceType, expected, got)
+		}
+	}
+
+	value, err := labels[0].RenderValue(map[string]string{"project": "my-project", "location": "us-central1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value != "my-project-us-central1" {
+		t.Errorf("expected the value to be rendered, got %q", value)
+	}
+	if _, err := labels[0].RenderValue(map[string]string{"project": "my-project"}); err == nil {
+		t.Error("expected rendering without the value of a variable to fail")
+	}
+
+	// Labels without resource types or services apply to every resource.
+	labels, err = ExpandProviderDefaultLabelConfig([]interface{}{
+		map[string]interface{}{"key": "env", "value": "prod"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !labels[0].AppliesTo("google_compute_address") || !labels[0].AppliesTo("") {
+		t.Error("expected an unscoped label to apply to every resource")
+	}
+}
+
+func TestExpandProviderDefaultLabelConfig_invalid(t *testing.T) {
+	cases := map[string]map[string]interface{}{
+		"missing key":      {"value": "prod"},
+		"unknown variable": {"key": "env", "value": "{{environment}}"},
+	}
+	for tn, raw := range cases {
+		if _, err := ExpandProviderDefaultLabelConfig([]interface{}{raw}); err == nil {
+			t.Errorf("%s: expected an error", tn)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+// InterruptedOperation is a long-running operation that was still running
+// when Terraform was stopped while polling it.
+type InterruptedOperation struct {
+	// URL is the URL the operation can be polled from.
+	URL string
+	// Activity describes what the operation does, e.g. "Creating Instance".
+	Activity string
+}
+
+// TrackInterruptedOperations returns a copy of the configuration that calls
+// record for each operation whose polling is interrupted by Terraform being
+// stopped. Operations polled with the returned configuration stop being
+// polled as soon as Terraform is stopped, so they can be resumed by a later
+// run instead of being abandoned.
+func (c *Config) TrackInterruptedOperations(record func(InterruptedOperation)) *Config {
+	tracked := *c
+	tracked.recordInterruptedOperation = record
+	return &tracked
+}
+
+// TracksInterruptedOperations reports whether the configuration was created by
+// TrackInterruptedOperations.
+func (c *Config) TracksInterruptedOperations() bool {
+	return c != nil && c.recordInterruptedOperation != nil
+}
+
+// RecordInterruptedOperation records an operation whose polling was
+// interrupted. It returns false if the configuration doesn't track
+// interrupted operations.
+func (c *Config) RecordInterruptedOperation(op InterruptedOperation) bool {
+	if !c.TracksInterruptedOperations() {
+		return false
+	}
+	c.recordInterruptedOperation(op)
+	return true
+}
@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const defaultPageSizeParam = "pageSize"
+
+// ListRequestOptions configures a paginated list request sent by a
+// ListIterator.
+type ListRequestOptions struct {
+	Config    *Config
+	Project   string
+	RawURL    string
+	UserAgent string
+	Headers   http.Header
+	Timeout   time.Duration
+
+	// ItemsField is the field of the response holding the page's items, e.g.
+	// "items" for most Compute and Storage APIs. If unset, ListPage.Items is
+	// always empty and callers read the items from ListPage.Response.
+	ItemsField string
+
+	// PageSize is the number of items requested per page. Zero leaves the
+	// page size to the API.
+	PageSize int
+	// PageSizeParam is the query parameter carrying PageSize. Defaults to
+	// "pageSize"; some APIs such as Compute and Storage use "maxResults".
+	PageSizeParam string
+
+	Filter  string
+	OrderBy string
+
+	// MaxResults stops listing once that many items were read. Zero means
+	// all pages are listed.
+	MaxResults int
+
+	// Predicates used to retry or abort the request for each page.
+	ErrorRetryPredicates []RetryErrorPredicateFunc
+	ErrorAbortPredicates []RetryErrorPredicateFunc
+}
+
+// ListPage is a single page of results returned by a ListIterator.
+type ListPage struct {
+	Items    []interface{}
+	Response map[string]interface{}
+}
+
+// ListIterator lists the pages of a paginated list API, following
+// `nextPageToken` until the last page is reached. Each page is requested with
+// SendRequest, so failed pages are retried on their own. Callers can stop
+// early by not calling Next again.
+//
+//	it := transport_tpg.NewListIterator(opts)
+//	for !it.Done() {
+//		page, err := it.Next()
+//		...
+//	}
+type ListIterator struct {
+	opt       ListRequestOptions
+	pageToken string
+	count     int
+	done      bool
+}
+
+// NewListIterator constructs a ListIterator starting at the first page.
+func NewListIterator(opt ListRequestOptions) *ListIterator {
+	if opt.PageSizeParam == "" {
+		opt.PageSizeParam = defaultPageSizeParam
+	}
+	return &ListIterator{opt: opt}
+}
+
+// Done reports whether all pages, or MaxResults items, have been read.
+func (it *ListIterator) Done() bool {
+	return it.done
+}
+
+// Next requests the next page of results.
+func (it *ListIterator) Next() (*ListPage, error) {
+	if it.done {
+		return nil, fmt.Errorf("no more pages to list from %s", it.opt.RawURL)
+	}
+
+	params := make(map[string]string)
+	if it.opt.PageSize > 0 {
+		params[it.opt.PageSizeParam] = strconv.Itoa(it.opt.PageSize)
+	}
+	if it.opt.Filter != "" {
+		params["filter"] = it.opt.Filter
+	}
+	if it.opt.OrderBy != "" {
+		params["orderBy"] = it.opt.OrderBy
+	}
+	if it.pageToken != "" {
+		params["pageToken"] = it.pageToken
+	}
+	url, err := AddQueryParams(it.opt.RawURL, params)
+	if err != nil {
+		it.done = true
+		return nil, err
+	}
+
+	res, err := SendRequest(SendRequestOptions{
+		Config:               it.opt.Config,
+		Method:               "GET",
+		Project:              it.opt.Project,
+		RawURL:               url,
+		UserAgent:            it.opt.UserAgent,
+		Headers:              it.opt.Headers,
+		Timeout:              it.opt.Timeout,
+		ErrorRetryPredicates: it.opt.ErrorRetryPredicates,
+		ErrorAbortPredicates: it.opt.ErrorAbortPredicates,
+	})
+	if err != nil {
+		it.done = true
+		return nil, err
+	}
+
+	page := &ListPage{Response: res}
+	if it.opt.ItemsField != "" {
+		if items, ok := res[it.opt.ItemsField].([]interface{}); ok {
+			page.Items = items
+		}
+	}
+
+	it.count += len(page.Items)
+	if it.opt.MaxResults > 0 && it.count >= it.opt.MaxResults {
+		page.Items = page.Items[:len(page.Items)-(it.count-it.opt.MaxResults)]
+		it.done = true
+		return page, nil
+	}
+
+	nextPageToken, _ := res["nextPageToken"].(string)
+	if nextPageToken == "" {
+		it.done = true
+	} else if nextPageToken == it.pageToken {
+		it.done = true
+		return nil, fmt.Errorf("listing %s returned the same page token %q twice", it.opt.RawURL, nextPageToken)
+	}
+	it.pageToken = nextPageToken
+	return page, nil
+}
+
+// ListAll lists every page and returns the items of all pages. ItemsField
+// must be set.
+func ListAll(opt ListRequestOptions) ([]interface{}, error) {
+	if opt.ItemsField == "" {
+		return nil, fmt.Errorf("ItemsField must be set to list all items of %s", opt.RawURL)
+	}
+
+	items := make([]interface{}, 0)
+	it := NewListIterator(opt)
+	for !it.Done() {
+		page, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page.Items...)
+	}
+	return items, nil
+}
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+// newTestListServer serves `total` items named item-N in pages of
+// `pageSize`, and records the query of each request it receives.
+func newTestListServer(t *testing.T, total, pageSize int, queries *[]map[string]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := make(map[string]string)
+		for k := range r.URL.Query() {
+			q[k] = r.URL.Query().Get(k)
+		}
+		*queries = append(*queries, q)
+
+		start := 0
+		if token := q["pageToken"]; token != "" {
+			var err error
+			if start, err = strconv.Atoi(token); err != nil {
+				t.Errorf("unexpected page token %q", token)
+			}
+		}
+		end := start + pageSize
+		if end > total {
+			end = total
+		}
+
+		items := make([]interface{}, 0)
+		for i := start; i < end; i++ {
+			items = append(items, map[string]interface{}{"name": fmt.Sprintf("item-%d", i)})
+		}
+		res := map[string]interface{}{"items": items}
+		if end < total {
+			res["nextPageToken"] = strconv.Itoa(end)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(res)
+	}))
+}
+
+func TestListAll(t *testing.T) {
+	var queries []map[string]string
+	ts := newTestListServer(t, 5, 2, &queries)
+	defer ts.Close()
+
+	items, err := ListAll(ListRequestOptions{
+		Config:     &Config{Client: ts.Client()},
+		RawURL:     ts.URL + "/v1/items?prefix=item",
+		ItemsField: "items",
+		PageSize:   2,
+		Filter:     "name:item*",
+		OrderBy:    "name",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(items) != 5 {
+		t.Fatalf("expected 5 items, got %d", len(items))
+	}
+	for i, item := range items {
+		if name := item.(map[string]interface{})["name"]; name != fmt.Sprintf("item-%d", i) {
+			t.Errorf("expected item %d to be item-%d, got %v", i, i, name)
+		}
+	}
+
+	if len(queries) != 3 {
+		t.Fatalf("expected 3 page requests, got %d", len(queries))
+	}
+	for i, q := range queries {
+		// Query parameters already present in the URL are kept.
+		if q["prefix"] != "item" || q["pageSize"] != "2" || q["filter"] != "name:item*" || q["orderBy"] != "name" {
+			t.Errorf("unexpected query for page %d: %v", i, q)
+		}
+	}
+	if queries[0]["pageToken"] != "" || queries[1]["pageToken"] != "2" || queries[2]["pageToken"] != "4" {
+		t.Errorf("unexpected page tokens: %v", queries)
+	}
+}
+
+func TestListIterator_MaxResults(t *testing.T) {
+	var queries []map[string]string
+	ts := newTestListServer(t, 10, 4, &queries)
+	defer ts.Close()
+
+	it := NewListIterator(ListRequestOptions{
+		Config:        &Config{Client: ts.Client()},
+		RawURL:        ts.URL + "/v1/items",
+		ItemsField:    "items",
+		PageSize:      4,
+		PageSizeParam: "maxResults",
+		MaxResults:    6,
+	})
+	var items []interface{}
+	for !it.Done() {
+		page, err := it.Next()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		items = append(items, page.Items...)
+	}
+	if len(items) != 6 {
+		t.Fatalf("expected 6 items, got %d", len(items))
+	}
+	if len(queries) != 2 {
+		t.Fatalf("expected listing to stop after 2 pages, got %d requests", len(queries))
+	}
+	if queries[0]["maxResults"] != "4" {
+		t.Errorf("expected page size to be sent as maxResults, got %v", queries[0])
+	}
+	if _, err := it.Next(); err == nil {
+		t.Fatal("expected an error calling Next after the last page")
+	}
+}
+
+func TestListIterator_RepeatedPageToken(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"items": [{"name": "item"}], "nextPageToken": "same"}`))
+	}))
+	defer ts.Close()
+
+	_, err := ListAll(ListRequestOptions{
+		Config:     &Config{Client: ts.Client()},
+		RawURL:     ts.URL + "/v1/items",
+		ItemsField: "items",
+	})
+	if err == nil {
+		t.Fatal("expected an error for a repeated page token")
+	}
+}
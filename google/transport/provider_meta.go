@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import "context"
+
+type moduleNameContextKey struct{}
+
+// ContextWithModuleName returns a context carrying the `module_name` set in
+// the `provider_meta` block of the module of a resource. The SDK doesn't give
+// the provider meta to CustomizeDiff functions, so the provider server adds it
+// to the context of plans instead.
+func ContextWithModuleName(ctx context.Context, moduleName string) context.Context {
+	return context.WithValue(ctx, moduleNameContextKey{}, moduleName)
+}
+
+// ModuleNameFromContext returns the module name set by ContextWithModuleName,
+// or "" if there is none.
+func ModuleNameFromContext(ctx context.Context) string {
+	moduleName, _ := ctx.Value(moduleNameContextKey{}).(string)
+	return moduleName
+}
@@ -0,0 +1,323 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/api/googleapi"
+)
+
+// The lowest fraction of the configured rate that the adaptive limiter will
+// fall back to when a service keeps returning quota errors.
+const rateLimitMinFraction = 16
+
+// The fraction of the configured rate that is restored after each
+// successful request once a limiter has been slowed down.
+const rateLimitRecoveryFraction = 20
+
+// RateLimitConfig contains user configuration for throttling requests sent
+// to a single service.
+type RateLimitConfig struct {
+	// Service is the value the limit was configured with; either a service
+	// name matching a `*_custom_endpoint` field, an API host or a base URL.
+	Service string
+
+	// Endpoint is the host or URL prefix that requests are matched against.
+	// If unset, Service is used.
+	Endpoint string
+
+	RequestsPerSecond     float64
+	Burst                 int
+	MaxConcurrentRequests int
+}
+
+// ExpandProviderRateLimits parses the provider `rate_limits` blocks.
+func ExpandProviderRateLimits(v interface{}) ([]*RateLimitConfig, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	ls := v.([]interface{})
+	configs := make([]*RateLimitConfig, 0, len(ls))
+	seen := make(map[string]bool)
+	for _, raw := range ls {
+		if raw == nil {
+			continue
+		}
+		cfgV := raw.(map[string]interface{})
+
+		config := &RateLimitConfig{}
+		if service, ok := cfgV["service"]; ok {
+			config.Service = service.(string)
+		}
+		if config.Service == "" {
+			return nil, fmt.Errorf("'service' must be set for each 'rate_limits' block")
+		}
+		if seen[config.Service] {
+			return nil, fmt.Errorf("'rate_limits' configured more than once for service %q", config.Service)
+		}
+		seen[config.Service] = true
+
+		if rps, ok := cfgV["requests_per_second"]; ok {
+			config.RequestsPerSecond = rps.(float64)
+		}
+		if config.RequestsPerSecond < 0 {
+			return nil, fmt.Errorf("'requests_per_second' for service %q must not be negative, got %v", config.Service, config.RequestsPerSecond)
+		}
+		if burst, ok := cfgV["burst"]; ok {
+			config.Burst = burst.(int)
+		}
+		if config.Burst < 0 {
+			return nil, fmt.Errorf("'burst' for service %q must not be negative, got %d", config.Service, config.Burst)
+		}
+		if maxConcurrent, ok := cfgV["max_concurrent_requests"]; ok {
+			config.MaxConcurrentRequests = maxConcurrent.(int)
+		}
+		if config.MaxConcurrentRequests < 0 {
+			return nil, fmt.Errorf("'max_concurrent_requests' for service %q must not be negative, got %d", config.Service, config.MaxConcurrentRequests)
+		}
+
+		configs = append(configs, config)
+	}
+
+	return configs, nil
+}
+
+// NewTransportWithRateLimits constructs a rateLimitTransport that throttles
+// requests matching the given configs. Requests to other services are passed
+// through unchanged.
+func NewTransportWithRateLimits(t http.RoundTripper, configs []*RateLimitConfig) *rateLimitTransport {
+	transport := &rateLimitTransport{
+		internal: t,
+	}
+	for _, config := range configs {
+		transport.limiters = append(transport.limiters, newServiceRateLimiter(config))
+	}
+	return transport
+}
+
+// rateLimitTransport is a http.RoundTripper applying a token bucket and a
+// cap on in-flight requests per service. Quota errors returned by a service
+// slow down its limiter until requests succeed again.
+type rateLimitTransport struct {
+	limiters []*serviceRateLimiter
+	internal http.RoundTripper
+}
+
+// RoundTrip implements the RoundTripper interface method.
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	l := t.limiterFor(req.URL)
+	if l == nil {
+		return t.internal.RoundTrip(req)
+	}
+
+	ctx := req.Context()
+	if l.inFlight != nil {
+		select {
+		case l.inFlight <- struct{}{}:
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting for an in-flight request slot for %q: %w", l.endpoint, ctx.Err())
+		}
+		defer func() { <-l.inFlight }()
+	}
+
+	if l.limiter != nil {
+		if err := l.limiter.Wait(ctx); err != nil {
+			return nil, fmt.Errorf("waiting for rate limit for %q: %w", l.endpoint, err)
+		}
+	}
+
+	resp, err := t.internal.RoundTrip(req)
+	if err == nil && l.limiter != nil {
+		if isRateLimitedResponse(resp) {
+			l.slowDown()
+		} else if resp.StatusCode < 400 {
+			l.recover()
+		}
+	}
+	return resp, err
+}
+
+// limiterFor returns the limiter with the most specific endpoint matching u,
+// or nil if no limit applies.
+func (t *rateLimitTransport) limiterFor(u *url.URL) *serviceRateLimiter {
+	var match *serviceRateLimiter
+	for _, l := range t.limiters {
+		if !l.matches(u) {
+			continue
+		}
+		if match == nil || l.moreSpecificThan(match.endpointMatcher) {
+			match = l
+		}
+	}
+	return match
+}
+
+// endpointMatcher matches request URLs against a configured API host or
+// base URL.
+type endpointMatcher struct {
+	endpoint   string
+	host       string
+	pathPrefix string
+}
+
+func newEndpointMatcher(endpoint string) endpointMatcher {
+	m := endpointMatcher{
+		endpoint: endpoint,
+		host:     strings.ToLower(endpoint),
+	}
+	if strings.Contains(endpoint, "://") {
+		if u, err := url.Parse(endpoint); err == nil {
+			m.host = strings.ToLower(u.Host)
+			m.pathPrefix = strings.TrimSuffix(u.Path, "/")
+		}
+	}
+	return m
+}
+
+func (m endpointMatcher) matches(u *url.URL) bool {
+	if u == nil || strings.ToLower(u.Host) != m.host {
+		return false
+	}
+	if m.pathPrefix == "" {
+		return true
+	}
+	return u.Path == m.pathPrefix || strings.HasPrefix(u.Path, m.pathPrefix+"/")
+}
+
+func (m endpointMatcher) moreSpecificThan(other endpointMatcher) bool {
+	return len(m.pathPrefix) > len(other.pathPrefix)
+}
+
+// serviceRateLimiter holds the throttling state of a single service.
+type serviceRateLimiter struct {
+	sync.Mutex
+	endpointMatcher
+
+	// limiter is nil if no request rate was configured.
+	limiter  *rate.Limiter
+	maxLimit rate.Limit
+	minLimit rate.Limit
+
+	// inFlight is nil if no concurrency cap was configured.
+	inFlight chan struct{}
+}
+
+func newServiceRateLimiter(config *RateLimitConfig) *serviceRateLimiter {
+	endpoint := config.Endpoint
+	if endpoint == "" {
+		endpoint = config.Service
+	}
+
+	l := &serviceRateLimiter{
+		endpointMatcher: newEndpointMatcher(endpoint),
+	}
+
+	if config.RequestsPerSecond > 0 {
+		burst := config.Burst
+		if burst == 0 {
+			burst = 1
+		}
+		l.maxLimit = rate.Limit(config.RequestsPerSecond)
+		l.minLimit = l.maxLimit / rateLimitMinFraction
+		l.limiter = rate.NewLimiter(l.maxLimit, burst)
+	}
+	if config.MaxConcurrentRequests > 0 {
+		l.inFlight = make(chan struct{}, config.MaxConcurrentRequests)
+	}
+	return l
+}
+
+// slowDown halves the request rate of the limiter, down to a floor of
+// 1/rateLimitMinFraction of the configured rate.
+func (l *serviceRateLimiter) slowDown() {
+	l.Lock()
+	defer l.Unlock()
+
+	newLimit := l.limiter.Limit() / 2
+	if newLimit < l.minLimit {
+		newLimit = l.minLimit
+	}
+	log.Printf("[DEBUG] Rate Limit Transport: quota error from %q, lowering rate to %.3f requests/s", l.endpoint, float64(newLimit))
+	l.limiter.SetLimit(newLimit)
+}
+
+// recover gradually raises the request rate of a limiter that was slowed
+// down back to the configured rate.
+func (l *serviceRateLimiter) recover() {
+	l.Lock()
+	defer l.Unlock()
+
+	current := l.limiter.Limit()
+	if current >= l.maxLimit {
+		return
+	}
+	newLimit := current + l.maxLimit/rateLimitRecoveryFraction
+	if newLimit > l.maxLimit {
+		newLimit = l.maxLimit
+	}
+	l.limiter.SetLimit(newLimit)
+}
+
+// Predicates identifying quota errors that should slow down the rate limiter
+// for a service.
+var rateLimitErrorPredicates = []RetryErrorPredicateFunc{
+	Is429QuotaError,
+	is403QuotaExceededPerMinuteError,
+	is403RateLimitExceededError,
+}
+
+// isRateLimitedResponse checks whether the response is a quota error. The
+// response body is restored so it can still be read by the caller.
+func isRateLimitedResponse(resp *http.Response) bool {
+	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusForbidden {
+		return false
+	}
+
+	respToCheck := *resp
+	if resp.Body != nil && resp.Body != http.NoBody {
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		if err != nil {
+			return false
+		}
+		respToCheck.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	errToCheck := googleapi.CheckResponse(&respToCheck)
+	if errToCheck == nil {
+		return false
+	}
+	for _, pred := range rateLimitErrorPredicates {
+		if isRateLimited, _ := pred(errToCheck); isRateLimited {
+			return true
+		}
+	}
+	return false
+}
+
+// Some APIs (e.g. Cloud Storage) report rate limits as a 403 with a
+// `rateLimitExceeded` or `userRateLimitExceeded` reason.
+func is403RateLimitExceededError(err error) (bool, string) {
+	gerr, ok := err.(*googleapi.Error)
+	if !ok || gerr.Code != 403 {
+		return false, ""
+	}
+
+	for _, e := range gerr.Errors {
+		if e.Reason == "rateLimitExceeded" || e.Reason == "userRateLimitExceeded" {
+			return true, fmt.Sprintf("Rate limit exceeded: %s", e.Reason)
+		}
+	}
+	return false, ""
+}
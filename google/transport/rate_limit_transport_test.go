@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+func TestExpandProviderRateLimits(t *testing.T) {
+	cases := map[string]struct {
+		Input       interface{}
+		Expected    []*RateLimitConfig
+		ExpectError bool
+	}{
+		"nil": {
+			Input: nil,
+		},
+		"all fields": {
+			Input: []interface{}{
+				map[string]interface{}{
+					"service":                 "compute",
+					"requests_per_second":     10.0,
+					"burst":                   5,
+					"max_concurrent_requests": 4,
+				},
+			},
+			Expected: []*RateLimitConfig{
+				{
+					Service:               "compute",
+					RequestsPerSecond:     10,
+					Burst:                 5,
+					MaxConcurrentRequests: 4,
+				},
+			},
+		},
+		"missing service": {
+			Input: []interface{}{
+				map[string]interface{}{
+					"requests_per_second": 10.0,
+				},
+			},
+			ExpectError: true,
+		},
+		"duplicate service": {
+			Input: []interface{}{
+				map[string]interface{}{"service": "compute"},
+				map[string]interface{}{"service": "compute"},
+			},
+			ExpectError: true,
+		},
+		"negative rate": {
+			Input: []interface{}{
+				map[string]interface{}{
+					"service":             "compute",
+					"requests_per_second": -1.0,
+				},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			configs, err := ExpandProviderRateLimits(tc.Input)
+			if tc.ExpectError {
+				if err == nil {
+					t.Fatal("expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(configs) != len(tc.Expected) {
+				t.Fatalf("expected %d configs, got %d", len(tc.Expected), len(configs))
+			}
+			for i, config := range configs {
+				if *config != *tc.Expected[i] {
+					t.Fatalf("expected config %#v, got %#v", tc.Expected[i], config)
+				}
+			}
+		})
+	}
+}
+
+func TestRateLimitTransport_LimiterFor(t *testing.T) {
+	transport := NewTransportWithRateLimits(http.DefaultTransport, []*RateLimitConfig{
+		{Service: "compute", Endpoint: "https://compute.googleapis.com/compute/v1/", RequestsPerSecond: 1},
+		{Service: "www.googleapis.com", RequestsPerSecond: 1},
+		{Service: "https://www.googleapis.com/storage/v1/", RequestsPerSecond: 1},
+	})
+
+	cases := map[string]string{
+		"https://compute.googleapis.com/compute/v1/projects/p/global/networks": "https://compute.googleapis.com/compute/v1/",
+		"https://compute.googleapis.com/compute/beta/projects/p":               "",
+		"https://www.googleapis.com/storage/v1/b/bucket":                       "https://www.googleapis.com/storage/v1/",
+		"https://www.googleapis.com/oauth2/v1/userinfo":                        "www.googleapis.com",
+		"https://pubsub.googleapis.com/v1/projects/p/topics":                   "",
+	}
+	for rawURL, expected := range cases {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			t.Fatalf("unable to parse %q: %v", rawURL, err)
+		}
+		l := transport.limiterFor(u)
+		if expected == "" {
+			if l != nil {
+				t.Errorf("expected no limiter for %q, got %q", rawURL, l.endpoint)
+			}
+			continue
+		}
+		if l == nil || l.endpoint != expected {
+			t.Errorf("expected limiter %q for %q, got %v", expected, rawURL, l)
+		}
+	}
+}
+
+func TestRateLimitTransport_MaxConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		for {
+			observed := atomic.LoadInt32(&maxInFlight)
+			if current <= observed || atomic.CompareAndSwapInt32(&maxInFlight, observed, current) {
+				break
+			}
+		}
+		time.Sleep(50 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	client := ts.Client()
+	client.Transport = NewTransportWithRateLimits(http.DefaultTransport, []*RateLimitConfig{
+		{Service: ts.URL, MaxConcurrentRequests: 2},
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get(ts.URL)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if maxInFlight > 2 {
+		t.Fatalf("expected at most 2 requests in flight, got %d", maxInFlight)
+	}
+}
+
+func TestRateLimitTransport_AdaptiveSlowDown(t *testing.T) {
+	var throttle int32 = 1
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&throttle) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	transport := NewTransportWithRateLimits(http.DefaultTransport, []*RateLimitConfig{
+		{Service: ts.URL, RequestsPerSecond: 100, Burst: 10},
+	})
+	client := ts.Client()
+	client.Transport = transport
+	l := transport.limiters[0]
+
+	for i := 0; i < 10; i++ {
+		resp, err := client.Get(ts.URL)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		resp.Body.Close()
+	}
+	if got, want := l.limiter.Limit(), rate.Limit(100)/rateLimitMinFraction; got != want {
+		t.Fatalf("expected limit to drop to %v after repeated 429s, got %v", want, got)
+	}
+
+	atomic.StoreInt32(&throttle, 0)
+	resp, err := client.Get(ts.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if got := l.limiter.Limit(); got <= rate.Limit(100)/rateLimitMinFraction {
+		t.Fatalf("expected limit to recover after a successful request, got %v", got)
+	}
+}
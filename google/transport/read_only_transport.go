@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc"
+)
+
+// readOnlyMethods are the custom methods that can be sent with POST without
+// modifying anything.
+var readOnlyMethods = []string{
+	":getIamPolicy",
+	":testIamPermissions",
+}
+
+// readOnlyGRPCMethods are the full names of the gRPC methods that can be
+// called without modifying anything. Method names aren't matched by prefix, as
+// e.g. Bigtable's ReadModifyWriteRow writes to the table.
+var readOnlyGRPCMethods = map[string]bool{
+	"google.bigtable.admin.v2.BigtableInstanceAdmin/GetAppProfile":         true,
+	"google.bigtable.admin.v2.BigtableInstanceAdmin/GetCluster":            true,
+	"google.bigtable.admin.v2.BigtableInstanceAdmin/GetIamPolicy":          true,
+	"google.bigtable.admin.v2.BigtableInstanceAdmin/GetInstance":           true,
+	"google.bigtable.admin.v2.BigtableInstanceAdmin/GetLogicalView":        true,
+	"google.bigtable.admin.v2.BigtableInstanceAdmin/GetMaterializedView":   true,
+	"google.bigtable.admin.v2.BigtableInstanceAdmin/ListAppProfiles":       true,
+	"google.bigtable.admin.v2.BigtableInstanceAdmin/ListClusters":          true,
+	"google.bigtable.admin.v2.BigtableInstanceAdmin/ListHotTablets":        true,
+	"google.bigtable.admin.v2.BigtableInstanceAdmin/ListInstances":         true,
+	"google.bigtable.admin.v2.BigtableInstanceAdmin/ListLogicalViews":      true,
+	"google.bigtable.admin.v2.BigtableInstanceAdmin/ListMaterializedViews": true,
+	"google.bigtable.admin.v2.BigtableInstanceAdmin/TestIamPermissions":    true,
+	"google.bigtable.admin.v2.BigtableTableAdmin/CheckConsistency":         true,
+	"google.bigtable.admin.v2.BigtableTableAdmin/GetAuthorizedView":        true,
+	"google.bigtable.admin.v2.BigtableTableAdmin/GetBackup":                true,
+	"google.bigtable.admin.v2.BigtableTableAdmin/GetIamPolicy":             true,
+	"google.bigtable.admin.v2.BigtableTableAdmin/GetSchemaBundle":          true,
+	"google.bigtable.admin.v2.BigtableTableAdmin/GetSnapshot":              true,
+	"google.bigtable.admin.v2.BigtableTableAdmin/GetTable":                 true,
+	"google.bigtable.admin.v2.BigtableTableAdmin/ListAuthorizedViews":      true,
+	"google.bigtable.admin.v2.BigtableTableAdmin/ListBackups":              true,
+	"google.bigtable.admin.v2.BigtableTableAdmin/ListSchemaBundles":        true,
+	"google.bigtable.admin.v2.BigtableTableAdmin/ListSnapshots":            true,
+	"google.bigtable.admin.v2.BigtableTableAdmin/ListTables":               true,
+	"google.bigtable.admin.v2.BigtableTableAdmin/TestIamPermissions":       true,
+	"google.bigtable.v2.Bigtable/ReadRows":                                 true,
+	"google.bigtable.v2.Bigtable/SampleRowKeys":                            true,
+	"google.longrunning.Operations/GetOperation":                           true,
+	"google.longrunning.Operations/ListOperations":                         true,
+	"google.longrunning.Operations/WaitOperation":                          true,
+}
+
+// ReadOnlyError is returned by the transport of a read-only configuration for
+// requests that could modify resources.
+type ReadOnlyError struct {
+	Method   string
+	Service  string
+	Resource string
+}
+
+func (e *ReadOnlyError) Error() string {
+	return fmt.Sprintf("the provider is configured with `read_only = true`, refusing to send %s request for %q to %s", e.Method, e.Resource, e.Service)
+}
+
+// readOnlyTransport rejects requests that could modify resources before they
+// are sent.
+type readOnlyTransport struct {
+	internal http.RoundTripper
+}
+
+// NewTransportWithReadOnlyGuard returns a transport only sending requests that
+// can't modify resources: GET, HEAD and OPTIONS requests, and POST requests
+// for the custom methods reading IAM policies. Any other request fails with a
+// ReadOnlyError.
+func NewTransportWithReadOnlyGuard(t http.RoundTripper) http.RoundTripper {
+	return &readOnlyTransport{internal: t}
+}
+
+func (t *readOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isReadOnlyRequest(req) {
+		err := &ReadOnlyError{
+			Method:   req.Method,
+			Service:  req.URL.Host,
+			Resource: auditLogResource(req.URL),
+		}
+		log.Printf("[WARN] Read-only Transport: %s", err)
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+	return t.internal.RoundTrip(req)
+}
+
+func isReadOnlyRequest(req *http.Request) bool {
+	switch req.Method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	case http.MethodPost:
+		for _, m := range readOnlyMethods {
+			if strings.HasSuffix(req.URL.Path, m) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ReadOnlyGRPCUnaryInterceptor rejects calls to gRPC methods that could modify
+// resources with a ReadOnlyError.
+func ReadOnlyGRPCUnaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if err := checkReadOnlyGRPCMethod(cc.Target(), method); err != nil {
+		return err
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// ReadOnlyGRPCStreamInterceptor rejects streams of gRPC methods that could
+// modify resources with a ReadOnlyError.
+func ReadOnlyGRPCStreamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	if err := checkReadOnlyGRPCMethod(cc.Target(), method); err != nil {
+		return nil, err
+	}
+	return streamer(ctx, desc, cc, method, opts...)
+}
+
+// checkReadOnlyGRPCMethod checks a full gRPC method name such as
+// "/google.bigtable.admin.v2.BigtableInstanceAdmin/GetInstance".
+func checkReadOnlyGRPCMethod(target, method string) error {
+	if readOnlyGRPCMethods[strings.TrimPrefix(method, "/")] {
+		return nil
+	}
+	err := &ReadOnlyError{
+		Method:   "gRPC",
+		Service:  target,
+		Resource: strings.TrimPrefix(method, "/"),
+	}
+	log.Printf("[WARN] Read-only gRPC interceptor: %s", err)
+	return err
+}
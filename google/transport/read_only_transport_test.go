@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestReadOnlyTransport(t *testing.T) {
+	sent := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent++
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+	client := &http.Client{Transport: NewTransportWithReadOnlyGuard(http.DefaultTransport)}
+
+	cases := map[string]struct {
+		Method  string
+		Path    string
+		Allowed bool
+	}{
+		"get": {
+			Method:  "GET",
+			Path:    "/compute/v1/projects/p/zones/z/instances",
+			Allowed: true,
+		},
+		"get iam policy": {
+			Method:  "POST",
+			Path:    "/v1/projects/p:getIamPolicy",
+			Allowed: true,
+		},
+		"test iam permissions": {
+			Method:  "POST",
+			Path:    "/v1/projects/p/topics/t:testIamPermissions",
+			Allowed: true,
+		},
+		"create": {
+			Method: "POST",
+			Path:   "/compute/v1/projects/p/zones/z/instances",
+		},
+		"set iam policy": {
+			Method: "POST",
+			Path:   "/v1/projects/p:setIamPolicy",
+		},
+		"update": {
+			Method: "PATCH",
+			Path:   "/v1/projects/p/topics/t",
+		},
+		"delete": {
+			Method: "DELETE",
+			Path:   "/v1/projects/p/topics/t",
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			sent = 0
+			req, err := http.NewRequest(tc.Method, ts.URL+tc.Path, strings.NewReader("{}"))
+			if err != nil {
+				t.Fatalf("unable to construct request: %v", err)
+			}
+			resp, err := client.Do(req)
+			if tc.Allowed {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				resp.Body.Close()
+				if sent != 1 {
+					t.Fatalf("expected the request to be sent")
+				}
+				return
+			}
+
+			var roErr *ReadOnlyError
+			if !errors.As(err, &roErr) {
+				t.Fatalf("expected a ReadOnlyError, got %v", err)
+			}
+			if roErr.Method != tc.Method || !strings.HasSuffix(tc.Path, roErr.Resource) {
+				t.Errorf("expected the error to name the %s request for %s, got %q", tc.Method, tc.Path, roErr)
+			}
+			if sent != 0 {
+				t.Fatalf("expected the request not to be sent")
+			}
+		})
+	}
+}
+
+func TestCheckReadOnlyGRPCMethod(t *testing.T) {
+	cases := map[string]bool{
+		"/google.bigtable.admin.v2.BigtableInstanceAdmin/GetInstance":           true,
+		"/google.bigtable.admin.v2.BigtableTableAdmin/ListTables":               true,
+		"/google.bigtable.v2.Bigtable/ReadRows":                                 true,
+		"/google.bigtable.admin.v2.BigtableInstanceAdmin/TestIamPermissions":    true,
+		"/google.longrunning.Operations/GetOperation":                           true,
+		"/google.bigtable.admin.v2.BigtableInstanceAdmin/CreateInstance":        false,
+		"/google.bigtable.admin.v2.BigtableTableAdmin/ModifyColumnFamilies":     false,
+		"/google.bigtable.admin.v2.BigtableInstanceAdmin/SetIamPolicy":          false,
+		"/google.bigtable.v2.Bigtable/ReadModifyWriteRow":                       false,
+		"/google.bigtable.v2.Bigtable/MutateRows":                               false,
+		"/google.bigtable.v2.Bigtable/CheckAndMutateRow":                        false,
+		"/google.bigtable.admin.v2.BigtableTableAdmin/GenerateConsistencyToken": false,
+		"/google.example.v1.Service/GetThing":                                   false,
+	}
+	for method, allowed := range cases {
+		err := checkReadOnlyGRPCMethod("bigtableadmin.googleapis.com:443", method)
+		if allowed && err != nil {
+			t.Errorf("expected %s to be allowed, got %v", method, err)
+		}
+		if !allowed && err == nil {
+			t.Errorf("expected %s to be rejected", method)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+// ForResourceType returns a copy of the configuration used for operations on
+// resources of the given Terraform resource type, e.g.
+// "google_storage_bucket_iam_member".
+func (c *Config) ForResourceType(resourceType string) *Config {
+	copied := *c
+	copied.resourceType = resourceType
+	return &copied
+}
+
+// ResourceType returns the Terraform resource type the configuration was
+// created for by ForResourceType, or "" if it wasn't.
+func (c *Config) ResourceType() string {
+	if c == nil {
+		return ""
+	}
+	return c.resourceType
+}
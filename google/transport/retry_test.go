@@ -3,6 +3,8 @@
 package transport
 
 import (
+	"context"
+	"net/http"
 	"net/url"
 	"testing"
 	"time"
@@ -212,3 +214,156 @@ func TestRetryWithPolling_retriedAndFailed(t *testing.T) {
 		t.Errorf("expected error function to be called exactly twice, but was called %d times", retryCount)
 	}
 }
+
+func TestRetry_maxAttempts(t *testing.T) {
+	i := 0
+	f := func() error {
+		i++
+		return &googleapi.Error{
+			Code: 500,
+		}
+	}
+	err := Retry(RetryOptions{
+		RetryFunc:    f,
+		Timeout:      time.Minute,
+		PollInterval: time.Millisecond,
+		RetryConfig:  &RetryConfig{MaxAttempts: 3},
+	})
+	if err == nil || err.(*googleapi.Error).Code != 500 {
+		t.Errorf("unexpected error retrying: %v", err)
+	}
+	if i != 3 {
+		t.Errorf("expected error function to be called exactly 3 times, but was called %d times", i)
+	}
+}
+
+func TestRetry_maxElapsedTime(t *testing.T) {
+	f := func() error {
+		return &googleapi.Error{
+			Code: 500,
+		}
+	}
+
+	// The default max_elapsed_time doesn't shorten the timeout of Retry.
+	start := time.Now()
+	err := Retry(RetryOptions{
+		RetryFunc:    f,
+		Timeout:      300 * time.Millisecond,
+		PollInterval: 10 * time.Millisecond,
+		RetryConfig:  &RetryConfig{MaxElapsedTime: 50 * time.Millisecond},
+	})
+	if err == nil {
+		t.Errorf("unexpected nil error, expected an error")
+	}
+	if elapsed := time.Since(start); elapsed < 300*time.Millisecond {
+		t.Errorf("expected retries to last until the timeout of 300ms, stopped after %s", elapsed)
+	}
+
+	start = time.Now()
+	err = Retry(RetryOptions{
+		RetryFunc:    f,
+		Timeout:      time.Minute,
+		PollInterval: 10 * time.Millisecond,
+		RetryConfig:  &RetryConfig{MaxElapsedTime: 50 * time.Millisecond, maxElapsedTimeSet: true},
+	})
+	if err == nil {
+		t.Errorf("unexpected nil error, expected an error")
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("expected retries to stop after max_elapsed_time of 50ms, stopped after %s", elapsed)
+	}
+}
+
+func TestRetry_contextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	i := 0
+	f := func() error {
+		i++
+		cancel()
+		return &googleapi.Error{
+			Code:   503,
+			Header: http.Header{"Retry-After": []string{"60"}},
+		}
+	}
+	start := time.Now()
+	err := Retry(RetryOptions{
+		RetryFunc: f,
+		Timeout:   5 * time.Minute,
+		Context:   ctx,
+	})
+	if err == nil || err.(*googleapi.Error).Code != 503 {
+		t.Errorf("unexpected error retrying: %v", err)
+	}
+	if i != 1 {
+		t.Errorf("expected error function to be called exactly once, but was called %d times", i)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("expected the wait for the server retry delay to stop when the context is canceled, stopped after %s", elapsed)
+	}
+}
+
+func TestServerRetryDelay(t *testing.T) {
+	cases := map[string]struct {
+		Err      error
+		Expected time.Duration
+	}{
+		"no delay": {
+			Err:      &googleapi.Error{Code: 429},
+			Expected: 0,
+		},
+		"retry info detail": {
+			Err: &googleapi.Error{
+				Code: 429,
+				Details: []interface{}{
+					map[string]interface{}{
+						"@type":      "type.googleapis.com/google.rpc.RetryInfo",
+						"retryDelay": "1.500s",
+					},
+				},
+			},
+			Expected: 1500 * time.Millisecond,
+		},
+		"retry-after header": {
+			Err: &googleapi.Error{
+				Code:   503,
+				Header: http.Header{"Retry-After": []string{"7"}},
+			},
+			Expected: 7 * time.Second,
+		},
+		"retry info takes precedence over header": {
+			Err: &googleapi.Error{
+				Code:   429,
+				Header: http.Header{"Retry-After": []string{"7"}},
+				Details: []interface{}{
+					map[string]interface{}{
+						"@type":      "type.googleapis.com/google.rpc.RetryInfo",
+						"retryDelay": "2s",
+					},
+				},
+			},
+			Expected: 2 * time.Second,
+		},
+		"wrapped error": {
+			Err: errwrap.Wrapf("nested error: {{err}}", &googleapi.Error{
+				Code:   503,
+				Header: http.Header{"Retry-After": []string{"3"}},
+			}),
+			Expected: 3 * time.Second,
+		},
+		"invalid header": {
+			Err: &googleapi.Error{
+				Code:   503,
+				Header: http.Header{"Retry-After": []string{"soon"}},
+			},
+			Expected: 0,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			if got := ServerRetryDelay(tc.Err); got != tc.Expected {
+				t.Errorf("expected delay %s, got %s", tc.Expected, got)
+			}
+		})
+	}
+}
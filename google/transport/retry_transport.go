@@ -38,16 +38,47 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
+	"math/rand"
 	"net/http"
 	"net/http/httputil"
+	"net/url"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/api/googleapi"
 )
 
 const defaultRetryTransportTimeoutSec = 90
 
+// Backoff bounds for the retry transport. The wait before each retry is
+// picked at random between zero and an exponentially growing ceiling ("full
+// jitter") so that requests failing at the same time don't retry in lockstep.
+const (
+	retryTransportBaseBackoff = 500 * time.Millisecond
+	retryTransportMaxBackoff  = 30 * time.Second
+)
+
+// RetryConfig contains user configuration for controlling how transient
+// request errors are retried.
+type RetryConfig struct {
+	MaxAttempts     int
+	MaxElapsedTime  time.Duration
+	RetryableErrors []*RetryableErrorConfig
+
+	// maxElapsedTimeSet is whether MaxElapsedTime was set by the user. Only
+	// then does it shorten the timeouts given to Retry, which are usually
+	// those of the resource operations.
+	maxElapsedTimeSet bool
+
+	// errorRetryPredicates and scopedRetryPredicates are compiled from
+	// RetryableErrors when the provider configuration is loaded.
+	errorRetryPredicates  []RetryErrorPredicateFunc
+	scopedRetryPredicates []scopedRetryPredicate
+}
+
 // NewTransportWithDefaultRetries constructs a default retryTransport that will retry common temporary errors
 func NewTransportWithDefaultRetries(t http.RoundTripper) *retryTransport {
 	return &retryTransport{
@@ -73,9 +104,55 @@ func (t *retryTransport) WithAddedPredicates(predicates ...RetryErrorPredicateFu
 	return &copyT
 }
 
+// Returns a shallow copy of the retry transport using the attempt and time
+// limits from the given provider retry configuration.
+func (t *retryTransport) WithRetryConfig(config *RetryConfig) *retryTransport {
+	copyT := *t
+	if config != nil {
+		copyT.maxAttempts = config.MaxAttempts
+		copyT.timeout = config.MaxElapsedTime
+		copyT.retryConfig = config
+	}
+	return &copyT
+}
+
+// Returns a shallow copy of the retry transport recording each request and
+// retry attempt as a span using the given tracer. A nil tracer disables
+// tracing.
+func (t *retryTransport) WithTracer(tracer trace.Tracer) *retryTransport {
+	copyT := *t
+	copyT.tracer = tracer
+	return &copyT
+}
+
+// WithTokenRefresher returns a shallow copy of the retry transport that sends
+// a request again once after refreshing the access token, if the API rejected
+// the token it was sent with.
+func (t *retryTransport) WithTokenRefresher(refresher TokenRefresher) *retryTransport {
+	copyT := *t
+	copyT.tokenRefresher = refresher
+	return &copyT
+}
+
 type retryTransport struct {
 	retryPredicates []RetryErrorPredicateFunc
 	internal        http.RoundTripper
+
+	// maxAttempts caps the number of requests sent per RoundTrip. Zero means
+	// requests are retried until the timeout is reached.
+	maxAttempts int
+	// timeout bounds the retry loop for requests without a context deadline.
+	// Zero means defaultRetryTransportTimeoutSec is used.
+	timeout time.Duration
+	// retryConfig holds the user-configured retryable errors, some of which
+	// only apply to requests sent to a given service.
+	retryConfig *RetryConfig
+	// tracer records spans for each request and retry attempt. It is nil if
+	// tracing isn't configured.
+	tracer trace.Tracer
+
+	// tokenRefresher, if set, refreshes access tokens rejected by the API.
+	tokenRefresher TokenRefresher
 }
 
 // RoundTrip implements the RoundTripper interface method.
@@ -86,7 +163,11 @@ func (t *retryTransport) RoundTrip(req *http.Request) (resp *http.Response, resp
 	ctx := req.Context()
 	var ccancel context.CancelFunc
 	if _, ok := ctx.Deadline(); !ok {
-		ctx, ccancel = context.WithTimeout(ctx, defaultRetryTransportTimeoutSec*time.Second)
+		timeout := t.timeout
+		if timeout == 0 {
+			timeout = defaultRetryTransportTimeoutSec * time.Second
+		}
+		ctx, ccancel = context.WithTimeout(ctx, timeout)
 		defer func() {
 			if ctx.Err() == nil {
 				// Cleanup child context created for retry loop if ctx not done.
@@ -96,8 +177,17 @@ func (t *retryTransport) RoundTrip(req *http.Request) (resp *http.Response, resp
 	}
 
 	attempts := 0
-	backoff := time.Millisecond * 500
-	nextBackoff := time.Millisecond * 500
+	refreshedToken := false
+
+	reqCtx := req.Context()
+	if t.tracer != nil {
+		var span trace.Span
+		reqCtx, span = t.startRequestSpan(reqCtx, req)
+		defer func() {
+			span.SetAttributes(attribute.Int("retry.attempts", attempts))
+			endRequestSpan(span, resp, respErr)
+		}()
+	}
 
 	// VCR depends on the original request body being consumed, so
 	// consume here. Since this won't affect the request itself,
@@ -122,11 +212,31 @@ Retry:
 		}
 
 		log.Printf("[DEBUG] Retry Transport: request attempt %d", attempts)
+		attemptCtx := reqCtx
+		var attemptSpan trace.Span
+		if t.tracer != nil {
+			attemptCtx, attemptSpan = t.tracer.Start(reqCtx, fmt.Sprintf("attempt %d", attempts),
+				trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attribute.Int("retry.attempt", attempts)))
+		}
+		newRequest = newRequest.WithContext(contextWithRetryAttempt(attemptCtx, attempts))
 		// Do the wrapped Roundtrip. This is one request in the retry loop.
 		resp, respErr = t.internal.RoundTrip(newRequest)
 		attempts++
+		if attemptSpan != nil {
+			endRequestSpan(attemptSpan, resp, respErr)
+		}
+
+		// Send the request again once if its access token was rejected and
+		// can be refreshed, regardless of the retry predicates.
+		if t.tokenRefresher != nil && !refreshedToken && respErr == nil && isInvalidTokenResponse(resp) {
+			refreshedToken = true
+			if refreshRejectedToken(t.tokenRefresher, resp) {
+				resp.Body.Close()
+				continue
+			}
+		}
 
-		retryErr := t.checkForRetryableError(resp, respErr)
+		retryErr := t.checkForRetryableError(req.URL, resp, respErr)
 		if retryErr == nil {
 			log.Printf("[DEBUG] Retry Transport: Stopping retries, last request was successful")
 			break Retry
@@ -135,19 +245,30 @@ Retry:
 			log.Printf("[DEBUG] Retry Transport: Stopping retries, last request failed with non-retryable error: %s", retryErr.Err)
 			break Retry
 		}
+		if t.maxAttempts > 0 && attempts >= t.maxAttempts {
+			log.Printf("[DEBUG] Retry Transport: Stopping retries, reached maximum of %d attempts", t.maxAttempts)
+			break Retry
+		}
+
+		// Prefer the delay requested by the server, if any.
+		backoff := ServerRetryDelay(retryErr.Err)
+		if backoff == 0 {
+			backoff = jitteredBackoff(attempts)
+		}
 
 		log.Printf("[DEBUG] Retry Transport: Waiting %s before trying request again", backoff)
+		if t.tracer != nil {
+			trace.SpanFromContext(reqCtx).AddEvent("backoff", trace.WithAttributes(
+				attribute.String("retry.reason", retryErr.Err.Error()),
+				attribute.Int64("retry.backoff_ms", backoff.Milliseconds()),
+			))
+		}
 		select {
 		case <-ctx.Done():
 			log.Printf("[DEBUG] Retry Transport: Stopping retries, context done: %v", ctx.Err())
 			break Retry
 		case <-time.After(backoff):
 			log.Printf("[DEBUG] Retry Transport: Finished waiting %s before next retry", backoff)
-
-			// Fibonnaci backoff - 0.5, 1, 1.5, 2.5, 4, 6.5, 10.5, ...
-			lastBackoff := backoff
-			backoff = backoff + nextBackoff
-			nextBackoff = lastBackoff
 			continue
 		}
 	}
@@ -155,6 +276,45 @@ Retry:
 	return resp, respErr
 }
 
+// startRequestSpan starts the span covering all attempts of a request.
+func (t *retryTransport) startRequestSpan(ctx context.Context, req *http.Request) (context.Context, trace.Span) {
+	return t.tracer.Start(ctx, fmt.Sprintf("%s %s", req.Method, auditLogService(req.URL)),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", req.Method),
+			attribute.String("url.full", redactAuditLogURL(req.URL)),
+			attribute.String("server.address", req.URL.Hostname()),
+			attribute.String("gcp.service", auditLogService(req.URL)),
+			attribute.String("gcp.resource", auditLogResource(req.URL)),
+		))
+}
+
+// endRequestSpan records the outcome of a request on its span and ends it.
+func endRequestSpan(span trace.Span, resp *http.Response, respErr error) {
+	if respErr != nil {
+		span.RecordError(respErr)
+		span.SetStatus(codes.Error, respErr.Error())
+	} else if resp != nil {
+		span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+		if resp.StatusCode >= 400 {
+			span.SetStatus(codes.Error, resp.Status)
+		}
+	}
+	span.End()
+}
+
+// jitteredBackoff returns a random duration between zero and an exponentially
+// growing ceiling for the given number of attempts made so far.
+func jitteredBackoff(attempts int) time.Duration {
+	ceiling := retryTransportMaxBackoff
+	if attempts < 16 {
+		if exp := retryTransportBaseBackoff << uint(attempts-1); exp < ceiling {
+			ceiling = exp
+		}
+	}
+	return time.Duration(rand.Int63n(int64(ceiling) + 1))
+}
+
 // copyHttpRequest provides an copy of the given HTTP request for one RoundTrip.
 // If the request has a non-empty body (io.ReadCloser), the body is deep copied
 // so it can be consumed.
@@ -181,7 +341,7 @@ func copyHttpRequest(req *http.Request) (*http.Request, error) {
 // checkForRetryableError uses the googleapi.CheckResponse util to check for
 // errors in the response, and determines whether there is a retryable error.
 // in response/response error.
-func (t *retryTransport) checkForRetryableError(resp *http.Response, respErr error) *retry.RetryError {
+func (t *retryTransport) checkForRetryableError(reqURL *url.URL, resp *http.Response, respErr error) *retry.RetryError {
 	var errToCheck error
 
 	if respErr != nil {
@@ -192,13 +352,16 @@ func (t *retryTransport) checkForRetryableError(resp *http.Response, respErr err
 		// returned cannot be edited. We need to consume the Body to check for
 		// errors, so we need to create a copy if the Response has a body.
 		if resp.Body != nil && resp.Body != http.NoBody {
-			// Use httputil.DumpResponse since the only important info is
-			// error code and messages in the response body.
-			dumpBytes, err := httputil.DumpResponse(resp, true)
+			// Read the body and restore it on the original response so that
+			// googleapi.CheckResponse can parse the error details (e.g.
+			// google.rpc.RetryInfo) from the JSON body.
+			bodyBytes, err := ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+			resp.Body = ioutil.NopCloser(bytes.NewReader(bodyBytes))
 			if err != nil {
 				return retry.NonRetryableError(fmt.Errorf("unable to check response for error: %v", err))
 			}
-			respToCheck.Body = ioutil.NopCloser(bytes.NewReader(dumpBytes))
+			respToCheck.Body = ioutil.NopCloser(bytes.NewReader(bodyBytes))
 		}
 		errToCheck = googleapi.CheckResponse(&respToCheck)
 	}
@@ -206,7 +369,11 @@ func (t *retryTransport) checkForRetryableError(resp *http.Response, respErr err
 	if errToCheck == nil {
 		return nil
 	}
-	if IsRetryableError(errToCheck, t.retryPredicates, nil) {
+	retryPredicates := t.retryPredicates
+	if user := t.retryConfig.ErrorRetryPredicates(reqURL); len(user) > 0 {
+		retryPredicates = append(append([]RetryErrorPredicateFunc{}, retryPredicates...), user...)
+	}
+	if IsRetryableError(errToCheck, retryPredicates, nil) {
 		return retry.RetryableError(errToCheck)
 	}
 	return retry.NonRetryableError(errToCheck)
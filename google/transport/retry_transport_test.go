@@ -60,7 +60,7 @@ func TestRetryTransport_SuccessAfterRetries(t *testing.T) {
 		testRetryTransportHandler_returnAfter(t, time.Second*1, testRetryTransportCodeSuccess))
 	defer ts.Close()
 
-	ctx, cc := context.WithTimeout(context.Background(), time.Second*2)
+	ctx, cc := context.WithTimeout(context.Background(), time.Second*20)
 	defer cc()
 	req, err := http.NewRequestWithContext(ctx, "GET", ts.URL, nil)
 	if err != nil {
@@ -77,7 +77,7 @@ func TestRetryTransport_FailAfterRetries(t *testing.T) {
 		testRetryTransportHandler_returnAfter(t, time.Second*1, testRetryTransportCodeFailure))
 	defer ts.Close()
 
-	ctx, cc := context.WithTimeout(context.Background(), time.Second*2)
+	ctx, cc := context.WithTimeout(context.Background(), time.Second*20)
 	defer cc()
 	req, err := http.NewRequestWithContext(ctx, "GET", ts.URL, nil)
 	if err != nil {
@@ -114,7 +114,7 @@ func TestRetryTransport_SuccessWithBody(t *testing.T) {
 	defer ts.Close()
 
 	body := "body for successful request"
-	ctx, cc := context.WithTimeout(context.Background(), time.Second*2)
+	ctx, cc := context.WithTimeout(context.Background(), time.Second*20)
 	defer cc()
 	req, err := http.NewRequestWithContext(ctx, "GET", ts.URL, bytes.NewReader([]byte(body)))
 	if err != nil {
@@ -171,6 +171,72 @@ func TestRetryTransport_DoesNotRetryEmptyGetBody(t *testing.T) {
 	testRetryTransport_checkFailedWhileRetrying(t, resp, err)
 }
 
+func TestRetryTransport_MaxAttempts(t *testing.T) {
+	attempts := 0
+	ts, client := setUpRetryTransportServerClient(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			w.WriteHeader(testRetryTransportCodeRetry)
+		}))
+	defer ts.Close()
+	client.Transport = client.Transport.(*retryTransport).WithRetryConfig(&RetryConfig{MaxAttempts: 3})
+
+	ctx, cc := context.WithTimeout(context.Background(), time.Second*30)
+	defer cc()
+	req, err := http.NewRequestWithContext(ctx, "GET", ts.URL, nil)
+	if err != nil {
+		t.Fatalf("unable to construct err: %v", err)
+	}
+
+	resp, err := client.Do(req)
+	testRetryTransport_checkFailedWhileRetrying(t, resp, err)
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+// Check that the delay from a Retry-After header is waited before retrying
+func TestRetryTransport_HonorsRetryAfter(t *testing.T) {
+	var firstReqTime, secondReqTime time.Time
+	ts, client := setUpRetryTransportServerClient(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if firstReqTime.IsZero() {
+				firstReqTime = time.Now()
+				w.Header().Set("Retry-After", "1")
+				w.WriteHeader(testRetryTransportCodeRetry)
+				return
+			}
+			secondReqTime = time.Now()
+			w.WriteHeader(testRetryTransportCodeSuccess)
+		}))
+	defer ts.Close()
+
+	ctx, cc := context.WithTimeout(context.Background(), time.Second*5)
+	defer cc()
+	req, err := http.NewRequestWithContext(ctx, "GET", ts.URL, nil)
+	if err != nil {
+		t.Fatalf("unable to construct err: %v", err)
+	}
+
+	resp, err := client.Do(req)
+	testRetryTransport_checkSuccess(t, resp, err)
+	if waited := secondReqTime.Sub(firstReqTime); waited < time.Second {
+		t.Fatalf("expected to wait at least 1s before retrying, waited %s", waited)
+	}
+}
+
+func TestJitteredBackoff(t *testing.T) {
+	for attempts := 1; attempts < 100; attempts++ {
+		ceiling := retryTransportMaxBackoff
+		if attempts < 16 && retryTransportBaseBackoff<<uint(attempts-1) < ceiling {
+			ceiling = retryTransportBaseBackoff << uint(attempts-1)
+		}
+		if backoff := jitteredBackoff(attempts); backoff < 0 || backoff > ceiling {
+			t.Fatalf("expected backoff for attempt %d to be between 0 and %s, got %s", attempts, ceiling, backoff)
+		}
+	}
+}
+
 // handlers
 func testRetryTransportHandler_noRetries(t *testing.T, code int) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
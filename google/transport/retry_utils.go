@@ -3,11 +3,16 @@
 package transport
 
 import (
+	"context"
 	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/errwrap"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"google.golang.org/api/googleapi"
 )
 
 type RetryOptions struct {
@@ -16,54 +21,136 @@ type RetryOptions struct {
 	PollInterval         time.Duration
 	ErrorRetryPredicates []RetryErrorPredicateFunc
 	ErrorAbortPredicates []RetryErrorPredicateFunc
+
+	// Context cancels the waits between attempts. If unset, the waits are
+	// only bounded by Timeout.
+	Context context.Context
+	// RetryConfig is the retry configuration of the provider, capping the
+	// number of attempts and, if set by the user, the time spent retrying.
+	// Its retryable errors are retried as well.
+	RetryConfig *RetryConfig
+	// RawURL is the URL of the request sent by RetryFunc, if any. It is used
+	// to apply the retryable errors of RetryConfig scoped to a service.
+	RawURL string
 }
 
+// Retry calls opt.RetryFunc until it succeeds, returns an error that isn't
+// retryable, or opt.Timeout is reached, in which case the last error is
+// returned. Between attempts, it waits for the delay requested by the server
+// if any, else opt.PollInterval if set, else a jittered exponential backoff
+// (see jitteredBackoff).
 func Retry(opt RetryOptions) error {
 	if opt.Timeout == 0 {
 		opt.Timeout = 1 * time.Minute
 	}
-
-	if opt.PollInterval != 0 {
-		refreshFunc := func() (interface{}, string, error) {
-			err := opt.RetryFunc()
-			if err == nil {
-				return "", "done", nil
-			}
-
-			// Check if it is a retryable error.
-			if IsRetryableError(err, opt.ErrorRetryPredicates, opt.ErrorAbortPredicates) {
-				return "", "retrying", nil
-			}
-
-			// The error is not retryable.
-			return "", "done", err
+	maxAttempts := 0
+	retryPredicates := opt.ErrorRetryPredicates
+	if opt.RetryConfig != nil {
+		var u *url.URL
+		if opt.RawURL != "" {
+			u, _ = url.Parse(opt.RawURL)
 		}
-		stateChange := &retry.StateChangeConf{
-			Pending: []string{
-				"retrying",
-			},
-			Target: []string{
-				"done",
-			},
-			Refresh:      refreshFunc,
-			Timeout:      opt.Timeout,
-			PollInterval: opt.PollInterval,
+		if user := opt.RetryConfig.ErrorRetryPredicates(u); len(user) > 0 {
+			retryPredicates = append(append([]RetryErrorPredicateFunc{}, retryPredicates...), user...)
 		}
+		maxAttempts = opt.RetryConfig.MaxAttempts
+		if opt.RetryConfig.maxElapsedTimeSet && opt.RetryConfig.MaxElapsedTime > 0 && opt.RetryConfig.MaxElapsedTime < opt.Timeout {
+			opt.Timeout = opt.RetryConfig.MaxElapsedTime
+		}
+	}
 
-		_, err := stateChange.WaitForState()
-		return err
+	ctx := opt.Context
+	if ctx == nil {
+		ctx = context.Background()
 	}
+	ctx, cancel := context.WithTimeout(ctx, opt.Timeout)
+	defer cancel()
 
-	return retry.Retry(opt.Timeout, func() *retry.RetryError {
+	for attempts := 1; ; attempts++ {
 		err := opt.RetryFunc()
 		if err == nil {
 			return nil
 		}
-		if IsRetryableError(err, opt.ErrorRetryPredicates, opt.ErrorAbortPredicates) {
-			return retry.RetryableError(err)
+		if !IsRetryableError(err, retryPredicates, opt.ErrorAbortPredicates) {
+			return err
+		}
+		if maxAttempts > 0 && attempts >= maxAttempts {
+			log.Printf("[DEBUG] Stopping retries, reached maximum of %d attempts", maxAttempts)
+			return err
+		}
+
+		// Prefer the delay requested by the server, if any.
+		wait := ServerRetryDelay(err)
+		if wait == 0 {
+			wait = opt.PollInterval
+		}
+		if wait == 0 {
+			wait = jitteredBackoff(attempts)
+		}
+		log.Printf("[DEBUG] Waiting %s before retrying", wait)
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			log.Printf("[DEBUG] Stopping retries, %v", ctx.Err())
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// ServerRetryDelay returns the delay the server asked clients to wait before
+// retrying a request, read from the google.rpc.RetryInfo error detail or the
+// Retry-After header of a googleapi.Error. It returns zero if err doesn't
+// contain a retry delay.
+func ServerRetryDelay(err error) time.Duration {
+	var delay time.Duration
+	errwrap.Walk(err, func(werr error) {
+		gerr, ok := werr.(*googleapi.Error)
+		if !ok || delay != 0 {
+			return
+		}
+
+		for _, d := range gerr.Details {
+			data, ok := d.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			dType, ok := data["@type"].(string)
+			if !ok || !strings.HasSuffix(dType, "google.rpc.RetryInfo") {
+				continue
+			}
+			if v, ok := data["retryDelay"].(string); ok {
+				if parsed, err := time.ParseDuration(v); err == nil && parsed > 0 {
+					delay = parsed
+					return
+				}
+			}
 		}
-		return retry.NonRetryableError(err)
+
+		delay = parseRetryAfterHeader(gerr.Header.Get("Retry-After"))
 	})
+	return delay
+}
+
+// parseRetryAfterHeader parses a Retry-After header given either as a number
+// of seconds or as an HTTP date.
+func parseRetryAfterHeader(v string) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(v); err == nil {
+		if seconds <= 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
 }
 
 func IsRetryableError(topErr error, retryPredicates, abortPredicates []RetryErrorPredicateFunc) bool {
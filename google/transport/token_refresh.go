@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+// TokenRefresher is implemented by token sources whose token can be replaced
+// after an API rejects it, e.g. because a static access token expired.
+type TokenRefresher interface {
+	// RefreshToken replaces the rejected access token. If the current token
+	// isn't the rejected one, it was already replaced and nothing is done.
+	RefreshToken(rejected string) error
+}
+
+// RefreshableTokenSource returns the same token until RefreshToken is called,
+// at which point it obtains a new token with its refresh function.
+type RefreshableTokenSource struct {
+	mu      sync.Mutex
+	token   *oauth2.Token
+	refresh func() (*oauth2.Token, error)
+}
+
+// NewRefreshableTokenSource constructs a RefreshableTokenSource returning
+// token until it's refreshed.
+func NewRefreshableTokenSource(token *oauth2.Token, refresh func() (*oauth2.Token, error)) *RefreshableTokenSource {
+	return &RefreshableTokenSource{token: token, refresh: refresh}
+}
+
+func (ts *RefreshableTokenSource) Token() (*oauth2.Token, error) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	return ts.token, nil
+}
+
+func (ts *RefreshableTokenSource) RefreshToken(rejected string) error {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if rejected != "" && ts.token.AccessToken != rejected {
+		return nil
+	}
+
+	token, err := ts.refresh()
+	if err != nil {
+		return err
+	}
+	if token.AccessToken == ts.token.AccessToken {
+		return fmt.Errorf("refreshing the access token returned the rejected token")
+	}
+	ts.token = token
+	return nil
+}
+
+// isInvalidTokenResponse reports whether resp rejected the request's access
+// token, e.g. because it expired.
+func isInvalidTokenResponse(resp *http.Response) bool {
+	return resp != nil && resp.StatusCode == http.StatusUnauthorized &&
+		strings.Contains(resp.Header.Get("WWW-Authenticate"), "invalid_token")
+}
+
+// rejectedAccessToken returns the access token sent with the request resp
+// answers, or "" if it's unknown.
+func rejectedAccessToken(resp *http.Response) string {
+	if resp.Request == nil {
+		return ""
+	}
+	return strings.TrimPrefix(resp.Request.Header.Get("Authorization"), "Bearer ")
+}
+
+// refreshRejectedToken refreshes the access token rejected by resp, and
+// reports whether the request should be sent again.
+func refreshRejectedToken(refresher TokenRefresher, resp *http.Response) bool {
+	if err := refresher.RefreshToken(rejectedAccessToken(resp)); err != nil {
+		log.Printf("[WARN] Retry Transport: Unable to refresh the rejected access token: %s", err)
+		return false
+	}
+	log.Printf("[DEBUG] Retry Transport: Refreshed the rejected access token, sending request again")
+	return true
+}
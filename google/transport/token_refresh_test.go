@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+// newTokenCheckingServer accepts requests authenticated with the token
+// returned by valid, and rejects any other token as invalid.
+func newTokenCheckingServer(valid func() string, requests *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		if r.Header.Get("Authorization") != "Bearer "+valid() {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="https://accounts.google.com/", error="invalid_token"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+func newTokenRefreshingClient(ts oauth2.TokenSource) *http.Client {
+	base := &oauth2.Transport{Source: ts, Base: http.DefaultTransport}
+	retry := NewTransportWithDefaultRetries(base)
+	if refresher, ok := ts.(TokenRefresher); ok {
+		retry = retry.WithTokenRefresher(refresher)
+	}
+	return &http.Client{Transport: retry}
+}
+
+func TestRetryTransport_RefreshesRejectedToken(t *testing.T) {
+	requests := 0
+	ts := newTokenCheckingServer(func() string { return "fresh" }, &requests)
+	defer ts.Close()
+
+	refreshes := 0
+	source := NewRefreshableTokenSource(&oauth2.Token{AccessToken: "expired"}, func() (*oauth2.Token, error) {
+		refreshes++
+		return &oauth2.Token{AccessToken: "fresh"}, nil
+	})
+
+	resp, err := newTokenRefreshingClient(source).Get(ts.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the request to succeed with the refreshed token, got %s", resp.Status)
+	}
+	if requests != 2 || refreshes != 1 {
+		t.Fatalf("expected 2 requests and 1 refresh, got %d requests and %d refreshes", requests, refreshes)
+	}
+
+	// Requests sent with the current token don't refresh it again.
+	resp, err = newTokenRefreshingClient(source).Get(ts.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if refreshes != 1 {
+		t.Fatalf("expected the current token to be reused, got %d refreshes", refreshes)
+	}
+}
+
+func TestRetryTransport_RefreshesRejectedTokenOnce(t *testing.T) {
+	requests := 0
+	ts := newTokenCheckingServer(func() string { return "never-issued" }, &requests)
+	defer ts.Close()
+
+	refreshes := 0
+	source := NewRefreshableTokenSource(&oauth2.Token{AccessToken: "token-0"}, func() (*oauth2.Token, error) {
+		refreshes++
+		return &oauth2.Token{AccessToken: "token-" + string(rune('0'+refreshes))}, nil
+	})
+
+	resp, err := newTokenRefreshingClient(source).Get(ts.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected the request to fail, got %s", resp.Status)
+	}
+	if requests != 2 || refreshes != 1 {
+		t.Fatalf("expected the request to be sent again once, got %d requests and %d refreshes", requests, refreshes)
+	}
+}
+
+func TestRetryTransport_StaticTokenNotRefreshed(t *testing.T) {
+	requests := 0
+	ts := newTokenCheckingServer(func() string { return "fresh" }, &requests)
+	defer ts.Close()
+
+	resp, err := newTokenRefreshingClient(oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "expired"})).Get(ts.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized || requests != 1 {
+		t.Fatalf("expected a single rejected request, got %s after %d requests", resp.Status, requests)
+	}
+}
+
+func TestConfigGetCredentials_AccessTokenFileRefresh(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte("expired"), 0600); err != nil {
+		t.Fatalf("unable to write token file: %v", err)
+	}
+
+	config := &Config{AccessToken: path, Context: context.Background()}
+	creds, err := config.GetCredentials(DefaultClientScopes, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	refresher, ok := creds.TokenSource.(TokenRefresher)
+	if !ok {
+		t.Fatalf("expected an access token read from a file to be refreshable, got %T", creds.TokenSource)
+	}
+
+	if err := os.WriteFile(path, []byte("fresh"), 0600); err != nil {
+		t.Fatalf("unable to write token file: %v", err)
+	}
+	if err := refresher.RefreshToken("expired"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	token, _ := creds.TokenSource.Token()
+	if token.AccessToken != "fresh" {
+		t.Fatalf("expected the token to be read from the file again, got %q", token.AccessToken)
+	}
+
+	// Access tokens set inline can't be refreshed.
+	config = &Config{AccessToken: "inline-token"}
+	creds, err = config.GetCredentials(DefaultClientScopes, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := creds.TokenSource.(TokenRefresher); ok {
+		t.Fatal("expected an inline access token not to be refreshable")
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	tracerName         = "github.com/hashicorp/terraform-provider-google"
+	tracingServiceName = "terraform-provider-google"
+
+	// tracingShutdownTimeout bounds how long the provider waits for the
+	// remaining spans to be exported when it stops.
+	tracingShutdownTimeout = 5 * time.Second
+)
+
+// TracingConfig contains user configuration for exporting OpenTelemetry
+// traces of provider operations.
+type TracingConfig struct {
+	// OTLPEndpoint is the URL of an OTLP/HTTP collector, e.g.
+	// http://localhost:4318.
+	OTLPEndpoint string
+
+	// FilePath is a file that spans are appended to in the OTLP JSON format.
+	FilePath string
+}
+
+// ExpandProviderTracingConfig parses the provider `tracing` block. It returns
+// nil if tracing isn't configured.
+func ExpandProviderTracingConfig(v interface{}) (*TracingConfig, error) {
+	if v == nil {
+		return nil, nil
+	}
+	ls := v.([]interface{})
+	if len(ls) == 0 || ls[0] == nil {
+		return nil, nil
+	}
+
+	cfgV := ls[0].(map[string]interface{})
+	config := &TracingConfig{}
+	if endpoint, ok := cfgV["otlp_endpoint"]; ok {
+		config.OTLPEndpoint = endpoint.(string)
+	}
+	if path, ok := cfgV["file_path"]; ok {
+		config.FilePath = path.(string)
+	}
+	if config.OTLPEndpoint == "" && config.FilePath == "" {
+		return nil, fmt.Errorf("one of 'otlp_endpoint' or 'file_path' must be set in the 'tracing' block")
+	}
+	return config, nil
+}
+
+// Tracer providers are shared between provider configurations exporting to
+// the same destination, so that aliased providers don't open the same file
+// more than once.
+var tracerProviders = struct {
+	sync.Mutex
+	providers map[TracingConfig]*sdktrace.TracerProvider
+}{
+	providers: make(map[TracingConfig]*sdktrace.TracerProvider),
+}
+
+func tracerProviderFor(ctx context.Context, config *TracingConfig) (*sdktrace.TracerProvider, error) {
+	tracerProviders.Lock()
+	defer tracerProviders.Unlock()
+
+	if tp, ok := tracerProviders.providers[*config]; ok {
+		return tp, nil
+	}
+
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", tracingServiceName))),
+	}
+	if config.OTLPEndpoint != "" {
+		exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(config.OTLPEndpoint))
+		if err != nil {
+			return nil, fmt.Errorf("unable to create OTLP exporter for %q: %s", config.OTLPEndpoint, err)
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	}
+	if config.FilePath != "" {
+		exporter, err := newOTLPFileExporter(config.FilePath)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	}
+
+	tp := sdktrace.NewTracerProvider(opts...)
+	tracerProviders.providers[*config] = tp
+	return tp, nil
+}
+
+// TraceContext returns the context carrying the span of the operation the
+// configuration was created for by StartSpan.
+func (c *Config) TraceContext() context.Context {
+	if c == nil || c.traceContext == nil {
+		return context.Background()
+	}
+	return c.traceContext
+}
+
+// StartSpan starts a span as a child of the configuration's trace context. It
+// returns a shallow copy of the configuration carrying the new span, so that
+// requests sent through the copy's client are recorded as children of the
+// span. If tracing isn't configured, c and a no-op span are returned.
+func (c *Config) StartSpan(name string, attrs ...attribute.KeyValue) (*Config, trace.Span) {
+	if c == nil || c.tracer == nil {
+		return c, trace.SpanFromContext(context.Background())
+	}
+
+	ctx, span := c.tracer.Start(c.TraceContext(), name, trace.WithAttributes(attrs...))
+	copied := *c
+	copied.traceContext = ctx
+	if c.Client != nil {
+		client := *c.Client
+		internal := client.Transport
+		if t, ok := internal.(*traceContextTransport); ok {
+			internal = t.internal
+		}
+		client.Transport = &traceContextTransport{internal: internal, ctx: ctx}
+		copied.Client = &client
+	}
+	return &copied, span
+}
+
+// StartSpanContext starts a span as a child of the span in ctx, using the
+// tracer provider the parent span was created with. If ctx doesn't carry a
+// recording span, ctx and a no-op span are returned.
+func StartSpanContext(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	parent := trace.SpanFromContext(ctx)
+	if !parent.IsRecording() {
+		return ctx, trace.SpanFromContext(context.Background())
+	}
+	return parent.TracerProvider().Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan ends a span started by StartSpan or StartSpanContext, recording
+// err if the traced operation failed.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// ShutdownTracing exports the remaining spans of every configuration and
+// stops their tracer providers. It's called once when the provider stops.
+func ShutdownTracing() {
+	tracerProviders.Lock()
+	defer tracerProviders.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+	defer cancel()
+	for config, tp := range tracerProviders.providers {
+		if err := tp.Shutdown(ctx); err != nil {
+			log.Printf("[WARN] Unable to export traces: %s", err)
+		}
+		delete(tracerProviders.providers, config)
+	}
+}
+
+// traceContextTransport is a http.RoundTripper that parents requests sent
+// without a span in their context to the span in ctx. Most clients send
+// requests with a background context, so this is how their requests are
+// attributed to the resource operation that sent them.
+type traceContextTransport struct {
+	internal http.RoundTripper
+	ctx      context.Context
+}
+
+// RoundTrip implements the RoundTripper interface method.
+func (t *traceContextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !trace.SpanContextFromContext(req.Context()).IsValid() {
+		req = req.WithContext(trace.ContextWithSpan(req.Context(), trace.SpanFromContext(t.ctx)))
+	}
+	internal := t.internal
+	if internal == nil {
+		internal = http.DefaultTransport
+	}
+	return internal.RoundTrip(req)
+}
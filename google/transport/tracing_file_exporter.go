@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// otlpFileExporter is a sdktrace.SpanExporter appending each batch of spans
+// to a file as one line of OTLP JSON, the format written by the
+// OpenTelemetry Collector file exporter. Files can be loaded into tools such
+// as Jaeger without running a collector.
+type otlpFileExporter struct {
+	sync.Mutex
+	file *os.File
+}
+
+func newOTLPFileExporter(path string) (*otlpFileExporter, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open trace file %q: %s", path, err)
+	}
+	return &otlpFileExporter{file: f}, nil
+}
+
+// ExportSpans implements the sdktrace.SpanExporter interface method.
+func (e *otlpFileExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if len(spans) == 0 {
+		return nil
+	}
+	line, err := json.Marshal(otlpTracesData(spans))
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	e.Lock()
+	defer e.Unlock()
+	_, err = e.file.Write(line)
+	return err
+}
+
+// Shutdown implements the sdktrace.SpanExporter interface method.
+func (e *otlpFileExporter) Shutdown(ctx context.Context) error {
+	e.Lock()
+	defer e.Unlock()
+	return e.file.Close()
+}
+
+// The types below mirror the JSON encoding of the OTLP TracesData message.
+// The protojson encoding of the generated protos can't be used, as OTLP JSON
+// encodes trace and span IDs as hex rather than base64.
+type otlpJSONTracesData struct {
+	ResourceSpans []otlpJSONResourceSpans `json:"resourceSpans"`
+}
+
+type otlpJSONResourceSpans struct {
+	Resource   otlpJSONResource     `json:"resource"`
+	ScopeSpans []otlpJSONScopeSpans `json:"scopeSpans"`
+}
+
+type otlpJSONResource struct {
+	Attributes []otlpJSONKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpJSONScopeSpans struct {
+	Scope otlpJSONScope  `json:"scope"`
+	Spans []otlpJSONSpan `json:"spans"`
+}
+
+type otlpJSONScope struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type otlpJSONSpan struct {
+	TraceID           string             `json:"traceId"`
+	SpanID            string             `json:"spanId"`
+	ParentSpanID      string             `json:"parentSpanId,omitempty"`
+	Name              string             `json:"name"`
+	Kind              int                `json:"kind"`
+	StartTimeUnixNano string             `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string             `json:"endTimeUnixNano"`
+	Attributes        []otlpJSONKeyValue `json:"attributes,omitempty"`
+	Events            []otlpJSONEvent    `json:"events,omitempty"`
+	Status            otlpJSONStatus     `json:"status"`
+}
+
+type otlpJSONEvent struct {
+	TimeUnixNano string             `json:"timeUnixNano"`
+	Name         string             `json:"name"`
+	Attributes   []otlpJSONKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpJSONStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpJSONKeyValue struct {
+	Key   string           `json:"key"`
+	Value otlpJSONAnyValue `json:"value"`
+}
+
+type otlpJSONAnyValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+}
+
+// OTLP status codes. These differ from the values of codes.Code.
+const (
+	otlpStatusCodeOk    = 1
+	otlpStatusCodeError = 2
+)
+
+func otlpTracesData(spans []sdktrace.ReadOnlySpan) *otlpJSONTracesData {
+	resourceSpans := otlpJSONResourceSpans{}
+	if res := spans[0].Resource(); res != nil {
+		resourceSpans.Resource.Attributes = otlpAttributes(res.Attributes())
+	}
+
+	scopeIndex := make(map[string]int)
+	for _, span := range spans {
+		scope := span.InstrumentationScope()
+		i, ok := scopeIndex[scope.Name]
+		if !ok {
+			i = len(resourceSpans.ScopeSpans)
+			scopeIndex[scope.Name] = i
+			resourceSpans.ScopeSpans = append(resourceSpans.ScopeSpans, otlpJSONScopeSpans{
+				Scope: otlpJSONScope{Name: scope.Name, Version: scope.Version},
+			})
+		}
+		resourceSpans.ScopeSpans[i].Spans = append(resourceSpans.ScopeSpans[i].Spans, otlpSpan(span))
+	}
+
+	return &otlpJSONTracesData{ResourceSpans: []otlpJSONResourceSpans{resourceSpans}}
+}
+
+func otlpSpan(span sdktrace.ReadOnlySpan) otlpJSONSpan {
+	sc := span.SpanContext()
+	s := otlpJSONSpan{
+		TraceID:           sc.TraceID().String(),
+		SpanID:            sc.SpanID().String(),
+		Name:              span.Name(),
+		Kind:              int(span.SpanKind()),
+		StartTimeUnixNano: strconv.FormatInt(span.StartTime().UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(span.EndTime().UnixNano(), 10),
+		Attributes:        otlpAttributes(span.Attributes()),
+	}
+	if parent := span.Parent(); parent.IsValid() {
+		s.ParentSpanID = parent.SpanID().String()
+	}
+	for _, event := range span.Events() {
+		s.Events = append(s.Events, otlpJSONEvent{
+			TimeUnixNano: strconv.FormatInt(event.Time.UnixNano(), 10),
+			Name:         event.Name,
+			Attributes:   otlpAttributes(event.Attributes),
+		})
+	}
+	switch span.Status().Code {
+	case codes.Ok:
+		s.Status.Code = otlpStatusCodeOk
+	case codes.Error:
+		s.Status.Code = otlpStatusCodeError
+		s.Status.Message = span.Status().Description
+	}
+	return s
+}
+
+func otlpAttributes(attrs []attribute.KeyValue) []otlpJSONKeyValue {
+	if len(attrs) == 0 {
+		return nil
+	}
+	kvs := make([]otlpJSONKeyValue, 0, len(attrs))
+	for _, attr := range attrs {
+		var v otlpJSONAnyValue
+		switch attr.Value.Type() {
+		case attribute.BOOL:
+			b := attr.Value.AsBool()
+			v.BoolValue = &b
+		case attribute.INT64:
+			i := strconv.FormatInt(attr.Value.AsInt64(), 10)
+			v.IntValue = &i
+		case attribute.FLOAT64:
+			f := attr.Value.AsFloat64()
+			v.DoubleValue = &f
+		default:
+			s := attr.Value.Emit()
+			v.StringValue = &s
+		}
+		kvs = append(kvs, otlpJSONKeyValue{Key: string(attr.Key), Value: v})
+	}
+	return kvs
+}
@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestExpandProviderTracingConfig(t *testing.T) {
+	cases := map[string]struct {
+		Input       interface{}
+		Expected    *TracingConfig
+		ExpectError bool
+	}{
+		"unset": {
+			Input: []interface{}{},
+		},
+		"endpoint": {
+			Input:    []interface{}{map[string]interface{}{"otlp_endpoint": "http://localhost:4318", "file_path": ""}},
+			Expected: &TracingConfig{OTLPEndpoint: "http://localhost:4318"},
+		},
+		"file": {
+			Input:    []interface{}{map[string]interface{}{"otlp_endpoint": "", "file_path": "traces.json"}},
+			Expected: &TracingConfig{FilePath: "traces.json"},
+		},
+		"no destination": {
+			Input:       []interface{}{map[string]interface{}{"otlp_endpoint": "", "file_path": ""}},
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			config, err := ExpandProviderTracingConfig(tc.Input)
+			if tc.ExpectError {
+				if err == nil {
+					t.Fatal("expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tc.Expected == nil {
+				if config != nil {
+					t.Fatalf("expected no tracing config, got %#v", config)
+				}
+				return
+			}
+			if config == nil || *config != *tc.Expected {
+				t.Fatalf("expected %#v, got %#v", tc.Expected, config)
+			}
+		})
+	}
+}
+
+func newTestTracingConfig(exporter sdktrace.SpanExporter, client *http.Client) *Config {
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	tracer := tp.Tracer(tracerName)
+	client.Transport = NewTransportWithDefaultRetries(http.DefaultTransport).WithTracer(tracer)
+	return &Config{
+		Client:         client,
+		tracerProvider: tp,
+		tracer:         tracer,
+	}
+}
+
+func TestConfigStartSpan_TracesRequests(t *testing.T) {
+	attempts := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	exporter := tracetest.NewInMemoryExporter()
+	config := newTestTracingConfig(exporter, ts.Client())
+
+	traced, span := config.StartSpan("Create google_test_resource")
+	if traced == config {
+		t.Fatal("expected StartSpan to return a copy of the config")
+	}
+	// Requests sent without a span in their context are parented to the
+	// configuration's span.
+	req, err := http.NewRequest("GET", ts.URL+"/v1/projects/p", nil)
+	if err != nil {
+		t.Fatalf("unable to construct request: %v", err)
+	}
+	resp, err := traced.Client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	EndSpan(span, nil)
+
+	spans := exporter.GetSpans()
+	byName := make(map[string]tracetest.SpanStub)
+	for _, s := range spans {
+		byName[s.Name] = s
+	}
+	root, ok := byName["Create google_test_resource"]
+	if !ok {
+		t.Fatalf("expected a resource span, got %v", spanNames(spans))
+	}
+	request, ok := byName["GET 127"]
+	if !ok {
+		t.Fatalf("expected a request span, got %v", spanNames(spans))
+	}
+	if request.Parent.SpanID() != root.SpanContext.SpanID() {
+		t.Errorf("expected request span to be a child of the resource span")
+	}
+	for _, name := range []string{"attempt 0", "attempt 1"} {
+		attempt, ok := byName[name]
+		if !ok {
+			t.Fatalf("expected span %q, got %v", name, spanNames(spans))
+		}
+		if attempt.Parent.SpanID() != request.SpanContext.SpanID() {
+			t.Errorf("expected span %q to be a child of the request span", name)
+		}
+	}
+	if len(request.Events) != 1 || request.Events[0].Name != "backoff" {
+		t.Errorf("expected a backoff event on the request span, got %v", request.Events)
+	}
+}
+
+func TestConfigStartSpan_TracingDisabled(t *testing.T) {
+	config := &Config{Client: http.DefaultClient}
+	traced, span := config.StartSpan("Create google_test_resource")
+	if traced != config {
+		t.Fatal("expected StartSpan to return the config unchanged when tracing is disabled")
+	}
+	if span.SpanContext().IsValid() {
+		t.Fatal("expected a no-op span when tracing is disabled")
+	}
+	EndSpan(span, nil)
+	if traced.TraceContext() != context.Background() {
+		t.Fatal("expected a background trace context when tracing is disabled")
+	}
+}
+
+func TestPollingWaitTimeContext_TracesPolls(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	config := newTestTracingConfig(exporter, &http.Client{})
+
+	traced, span := config.StartSpan("Create google_test_resource")
+	polls := 0
+	pollF := func() (map[string]interface{}, error) {
+		polls++
+		return map[string]interface{}{"polls": polls}, nil
+	}
+	checkResponse := func(resp map[string]interface{}, respErr error) PollResult {
+		if resp["polls"].(int) < 2 {
+			return PendingStatusPollResult("CREATING")
+		}
+		return SuccessPollResult()
+	}
+	if err := PollingWaitTimeContext(traced.TraceContext(), pollF, checkResponse, "Creating resource", time.Minute, 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	EndSpan(span, nil)
+
+	spans := exporter.GetSpans()
+	byName := make(map[string]tracetest.SpanStub)
+	for _, s := range spans {
+		byName[s.Name] = s
+	}
+	root, ok := byName["Create google_test_resource"]
+	if !ok {
+		t.Fatalf("expected a resource span, got %v", spanNames(spans))
+	}
+	wait, ok := byName["Creating resource"]
+	if !ok {
+		t.Fatalf("expected a polling span, got %v", spanNames(spans))
+	}
+	if wait.Parent.SpanID() != root.SpanContext.SpanID() {
+		t.Errorf("expected polling span to be a child of the resource span")
+	}
+	for _, name := range []string{"poll 1", "poll 2"} {
+		poll, ok := byName[name]
+		if !ok {
+			t.Fatalf("expected span %q, got %v", name, spanNames(spans))
+		}
+		if poll.Parent.SpanID() != wait.SpanContext.SpanID() {
+			t.Errorf("expected span %q to be a child of the polling span", name)
+		}
+	}
+}
+
+func TestPollingWaitTimeContext_TracingDisabled(t *testing.T) {
+	_, span := StartSpanContext(context.Background(), "Creating resource")
+	if span.SpanContext().IsValid() {
+		t.Fatal("expected a no-op span when the context carries no span")
+	}
+}
+
+func TestOTLPFileExporter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.json")
+	exporter, err := newOTLPFileExporter(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	config := newTestTracingConfig(exporter, http.DefaultClient)
+
+	traced, parent := config.StartSpan("Delete google_test_resource")
+	_, child := traced.StartSpan("poll 1")
+	EndSpan(child, nil)
+	EndSpan(parent, os.ErrNotExist)
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unable to read trace file: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(raw)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected one line per exported batch, got %d", len(lines))
+	}
+
+	var spans []otlpJSONSpan
+	for _, line := range lines {
+		var data otlpJSONTracesData
+		if err := json.Unmarshal([]byte(line), &data); err != nil {
+			t.Fatalf("unable to parse trace file line %q: %v", line, err)
+		}
+		for _, scopeSpans := range data.ResourceSpans[0].ScopeSpans {
+			spans = append(spans, scopeSpans.Spans...)
+		}
+	}
+	child1, parent1 := spans[0], spans[1]
+	if len(parent1.TraceID) != 32 || len(parent1.SpanID) != 16 {
+		t.Errorf("expected hex encoded trace and span IDs, got %q and %q", parent1.TraceID, parent1.SpanID)
+	}
+	if child1.ParentSpanID != parent1.SpanID || child1.TraceID != parent1.TraceID {
+		t.Errorf("expected %q to be a child of %q", child1.Name, parent1.Name)
+	}
+	if parent1.Status.Code != otlpStatusCodeError {
+		t.Errorf("expected failed span to have an error status, got %d", parent1.Status.Code)
+	}
+}
+
+func spanNames(spans tracetest.SpanStubs) []string {
+	var names []string
+	for _, s := range spans {
+		names = append(names, s.Name)
+	}
+	return names
+}
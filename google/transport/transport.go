@@ -91,6 +91,9 @@ func SendRequest(opt SendRequestOptions) (map[string]interface{}, error) {
 		Timeout:              opt.Timeout,
 		ErrorRetryPredicates: opt.ErrorRetryPredicates,
 		ErrorAbortPredicates: opt.ErrorAbortPredicates,
+		Context:              opt.Config.Context,
+		RetryConfig:          opt.Config.RetryConfig,
+		RawURL:               opt.RawURL,
 	})
 	if err != nil {
 		return nil, err
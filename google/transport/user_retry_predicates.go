@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"google.golang.org/api/googleapi"
+)
+
+// RetryableErrorConfig contains user configuration for an additional error
+// condition that should be retried. All conditions that are set must match.
+type RetryableErrorConfig struct {
+	Code         int
+	Reason       string
+	MessageRegex string
+
+	// Service optionally scopes the condition to requests sent to a single
+	// service; either a service name matching a `*_custom_endpoint` field, an
+	// API host or a base URL.
+	Service string
+
+	// Endpoint is the host or URL prefix that requests are matched against
+	// when Service is set. If unset, Service is used.
+	Endpoint string
+
+	messageRegexp *regexp.Regexp
+}
+
+func expandProviderRetryableErrors(v interface{}) ([]*RetryableErrorConfig, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	ls := v.([]interface{})
+	configs := make([]*RetryableErrorConfig, 0, len(ls))
+	for _, raw := range ls {
+		if raw == nil {
+			continue
+		}
+		cfgV := raw.(map[string]interface{})
+
+		config := &RetryableErrorConfig{}
+		if code, ok := cfgV["code"]; ok {
+			config.Code = code.(int)
+		}
+		if reason, ok := cfgV["reason"]; ok {
+			config.Reason = reason.(string)
+		}
+		if messageRegex, ok := cfgV["message_regex"]; ok {
+			config.MessageRegex = messageRegex.(string)
+		}
+		if service, ok := cfgV["service"]; ok {
+			config.Service = service.(string)
+		}
+
+		if config.Code == 0 && config.Reason == "" && config.MessageRegex == "" {
+			return nil, fmt.Errorf("at least one of 'code', 'reason' or 'message_regex' must be set for each 'retryable_error' block")
+		}
+		if config.MessageRegex != "" {
+			re, err := regexp.Compile(config.MessageRegex)
+			if err != nil {
+				return nil, fmt.Errorf("unable to compile 'message_regex' value %q: %s", config.MessageRegex, err)
+			}
+			config.messageRegexp = re
+		}
+
+		configs = append(configs, config)
+	}
+
+	return configs, nil
+}
+
+// String returns a description of the condition, used in log messages.
+func (c *RetryableErrorConfig) String() string {
+	var parts []string
+	if c.Code != 0 {
+		parts = append(parts, fmt.Sprintf("code=%d", c.Code))
+	}
+	if c.Reason != "" {
+		parts = append(parts, fmt.Sprintf("reason=%q", c.Reason))
+	}
+	if c.MessageRegex != "" {
+		parts = append(parts, fmt.Sprintf("message_regex=%q", c.MessageRegex))
+	}
+	if c.Service != "" {
+		parts = append(parts, fmt.Sprintf("service=%q", c.Service))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Predicate compiles the condition into a RetryErrorPredicateFunc. The
+// service scope is not part of the predicate; see ErrorRetryPredicates for how
+// service-scoped conditions are applied.
+func (c *RetryableErrorConfig) Predicate() RetryErrorPredicateFunc {
+	description := c.String()
+	return func(err error) (bool, string) {
+		gerr, isGoogleErr := err.(*googleapi.Error)
+		if c.Code != 0 && (!isGoogleErr || gerr.Code != c.Code) {
+			return false, ""
+		}
+		if c.Reason != "" && (!isGoogleErr || !googleapiErrorHasReason(gerr, c.Reason)) {
+			return false, ""
+		}
+		if c.messageRegexp != nil {
+			matched := c.messageRegexp.MatchString(err.Error())
+			if !matched && isGoogleErr {
+				matched = c.messageRegexp.MatchString(gerr.Body)
+			}
+			if !matched {
+				return false, ""
+			}
+		}
+		return true, fmt.Sprintf("Matched user-configured retryable error (%s)", description)
+	}
+}
+
+func (c *RetryableErrorConfig) endpointMatcher() endpointMatcher {
+	if c.Endpoint != "" {
+		return newEndpointMatcher(c.Endpoint)
+	}
+	return newEndpointMatcher(c.Service)
+}
+
+// googleapiErrorHasReason checks the reasons of the legacy error items and of
+// any google.rpc.ErrorInfo detail in the error.
+func googleapiErrorHasReason(gerr *googleapi.Error, reason string) bool {
+	for _, e := range gerr.Errors {
+		if e.Reason == reason {
+			return true
+		}
+	}
+	for _, d := range gerr.Details {
+		data, ok := d.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		dType, ok := data["@type"].(string)
+		if !ok || !strings.HasSuffix(dType, "google.rpc.ErrorInfo") {
+			continue
+		}
+		if v, ok := data["reason"].(string); ok && v == reason {
+			return true
+		}
+	}
+	return false
+}
+
+// compileRetryableErrors compiles the retryable error conditions into the
+// predicates returned by ErrorRetryPredicates.
+func (c *RetryConfig) compileRetryableErrors() {
+	c.errorRetryPredicates = nil
+	for _, config := range c.RetryableErrors {
+		if config.Service != "" {
+			continue
+		}
+		c.errorRetryPredicates = append(c.errorRetryPredicates, config.Predicate())
+	}
+	c.scopedRetryPredicates = newScopedRetryPredicates(c.RetryableErrors)
+}
+
+// ErrorRetryPredicates returns the predicates of the user-configured
+// retryable errors that apply to a request sent to u. If u is nil, only the
+// conditions that aren't scoped to a service are returned.
+func (c *RetryConfig) ErrorRetryPredicates(u *url.URL) []RetryErrorPredicateFunc {
+	if c == nil {
+		return nil
+	}
+	predicates := c.errorRetryPredicates
+	if u != nil {
+		if scoped := scopedRetryPredicatesFor(c.scopedRetryPredicates, u); len(scoped) > 0 {
+			predicates = append(append([]RetryErrorPredicateFunc{}, predicates...), scoped...)
+		}
+	}
+	return predicates
+}
+
+// scopedRetryPredicate is a service-scoped retryable error condition. Errors
+// returned by the API don't carry the URL of the request, so these are only
+// applied where the URL of the failed request is known.
+type scopedRetryPredicate struct {
+	endpointMatcher
+	predicate RetryErrorPredicateFunc
+}
+
+func newScopedRetryPredicates(configs []*RetryableErrorConfig) []scopedRetryPredicate {
+	var scoped []scopedRetryPredicate
+	for _, config := range configs {
+		if config.Service == "" {
+			continue
+		}
+		scoped = append(scoped, scopedRetryPredicate{
+			endpointMatcher: config.endpointMatcher(),
+			predicate:       config.Predicate(),
+		})
+	}
+	return scoped
+}
+
+func scopedRetryPredicatesFor(scoped []scopedRetryPredicate, u *url.URL) []RetryErrorPredicateFunc {
+	var predicates []RetryErrorPredicateFunc
+	for _, s := range scoped {
+		if s.matches(u) {
+			predicates = append(predicates, s.predicate)
+		}
+	}
+	return predicates
+}
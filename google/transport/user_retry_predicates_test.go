@@ -0,0 +1,266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"google.golang.org/api/googleapi"
+)
+
+func TestExpandProviderRetryableErrors(t *testing.T) {
+	cases := map[string]struct {
+		Input       interface{}
+		ExpectCount int
+		ExpectError bool
+	}{
+		"nil": {
+			Input: nil,
+		},
+		"valid conditions": {
+			Input: []interface{}{
+				map[string]interface{}{"code": 409},
+				map[string]interface{}{"reason": "resourceNotReady", "service": "compute"},
+				map[string]interface{}{"message_regex": "try again"},
+			},
+			ExpectCount: 3,
+		},
+		"no condition": {
+			Input: []interface{}{
+				map[string]interface{}{"service": "compute"},
+			},
+			ExpectError: true,
+		},
+		"invalid regex": {
+			Input: []interface{}{
+				map[string]interface{}{"message_regex": "("},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			configs, err := expandProviderRetryableErrors(tc.Input)
+			if tc.ExpectError {
+				if err == nil {
+					t.Fatal("expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(configs) != tc.ExpectCount {
+				t.Fatalf("expected %d conditions, got %d", tc.ExpectCount, len(configs))
+			}
+		})
+	}
+}
+
+func TestRetryableErrorConfig_Predicate(t *testing.T) {
+	errorInfo := &googleapi.Error{
+		Code: 400,
+		Body: `{"error": {"message": "Resource is not ready, try again later"}}`,
+		Details: []interface{}{
+			map[string]interface{}{
+				"@type":  "type.googleapis.com/google.rpc.ErrorInfo",
+				"reason": "RESOURCE_NOT_READY",
+			},
+		},
+	}
+	legacyReason := &googleapi.Error{
+		Code:   403,
+		Errors: []googleapi.ErrorItem{{Reason: "backendError"}},
+	}
+
+	cases := map[string]struct {
+		Input    map[string]interface{}
+		Err      error
+		Expected bool
+	}{
+		"code matches": {
+			Input:    map[string]interface{}{"code": 400},
+			Err:      errorInfo,
+			Expected: true,
+		},
+		"code doesn't match": {
+			Input:    map[string]interface{}{"code": 409},
+			Err:      errorInfo,
+			Expected: false,
+		},
+		"error info reason matches": {
+			Input:    map[string]interface{}{"reason": "RESOURCE_NOT_READY"},
+			Err:      errorInfo,
+			Expected: true,
+		},
+		"legacy error reason matches": {
+			Input:    map[string]interface{}{"reason": "backendError"},
+			Err:      legacyReason,
+			Expected: true,
+		},
+		"message regex matches body": {
+			Input:    map[string]interface{}{"message_regex": "not ready, try again"},
+			Err:      errorInfo,
+			Expected: true,
+		},
+		"message regex matches non-googleapi error": {
+			Input:    map[string]interface{}{"message_regex": "^flaky"},
+			Err:      errors.New("flaky network"),
+			Expected: true,
+		},
+		"all conditions must match": {
+			Input:    map[string]interface{}{"code": 400, "reason": "backendError"},
+			Err:      errorInfo,
+			Expected: false,
+		},
+		"code doesn't match non-googleapi error": {
+			Input:    map[string]interface{}{"code": 400},
+			Err:      errors.New("flaky network"),
+			Expected: false,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			configs, err := expandProviderRetryableErrors([]interface{}{tc.Input})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got, _ := configs[0].Predicate()(tc.Err); got != tc.Expected {
+				t.Fatalf("expected predicate to return %v for %v, got %v", tc.Expected, tc.Err, got)
+			}
+		})
+	}
+}
+
+func TestRetryTransport_ScopedRetryableErrors(t *testing.T) {
+	attempts := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	config := testRetryConfig(t, map[string]interface{}{"code": 409, "service": ts.URL})
+
+	client := ts.Client()
+	client.Transport = NewTransportWithDefaultRetries(http.DefaultTransport).WithRetryConfig(config)
+
+	ctx, cc := context.WithTimeout(context.Background(), time.Second*10)
+	defer cc()
+	req, err := http.NewRequestWithContext(ctx, "GET", ts.URL, nil)
+	if err != nil {
+		t.Fatalf("unable to construct request: %v", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 409 to be retried, got status %d after %d attempts", resp.StatusCode, attempts)
+	}
+
+	// Requests to other services aren't affected by the scoped condition.
+	otherService := NewTransportWithDefaultRetries(http.DefaultTransport).WithRetryConfig(
+		testRetryConfig(t, map[string]interface{}{"code": 409, "service": "compute.googleapis.com"}),
+	)
+	attempts = 0
+	client.Transport = otherService
+	resp, err = client.Get(ts.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusConflict || attempts != 1 {
+		t.Fatalf("expected a single attempt returning 409, got status %d after %d attempts", resp.StatusCode, attempts)
+	}
+}
+
+func TestRetry_userRetryableErrors(t *testing.T) {
+	teapot := &googleapi.Error{Code: 418}
+	retryFunc := func(attempts *int) func() error {
+		return func() error {
+			*attempts++
+			if *attempts == 1 {
+				return teapot
+			}
+			return nil
+		}
+	}
+
+	cases := map[string]struct {
+		config         *RetryConfig
+		rawURL         string
+		expectAttempts int
+	}{
+		"no retry config": {
+			expectAttempts: 1,
+		},
+		"other provider configuration": {
+			config:         testRetryConfig(t, map[string]interface{}{"code": 409}),
+			expectAttempts: 1,
+		},
+		"unscoped": {
+			config:         testRetryConfig(t, map[string]interface{}{"code": 418}),
+			expectAttempts: 2,
+		},
+		"scoped to the request's service": {
+			config:         testRetryConfig(t, map[string]interface{}{"code": 418, "service": "compute.googleapis.com"}),
+			rawURL:         "https://compute.googleapis.com/compute/v1/projects/p/zones/z/instances/i",
+			expectAttempts: 2,
+		},
+		"scoped to another service": {
+			config:         testRetryConfig(t, map[string]interface{}{"code": 418, "service": "compute.googleapis.com"}),
+			rawURL:         "https://storage.googleapis.com/storage/v1/b/bucket",
+			expectAttempts: 1,
+		},
+		"scoped without a request URL": {
+			config:         testRetryConfig(t, map[string]interface{}{"code": 418, "service": "compute.googleapis.com"}),
+			expectAttempts: 1,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			attempts := 0
+			Retry(RetryOptions{
+				RetryFunc:    retryFunc(&attempts),
+				Timeout:      10 * time.Second,
+				PollInterval: time.Millisecond,
+				RetryConfig:  tc.config,
+				RawURL:       tc.rawURL,
+			})
+			if attempts != tc.expectAttempts {
+				t.Errorf("expected %d attempts, got %d", tc.expectAttempts, attempts)
+			}
+		})
+	}
+
+	if IsRetryableError(teapot, nil, nil) {
+		t.Error("expected retryable errors of a provider configuration not to apply globally")
+	}
+}
+
+func testRetryConfig(t *testing.T, retryableErrors ...map[string]interface{}) *RetryConfig {
+	raw := make([]interface{}, 0, len(retryableErrors))
+	for _, e := range retryableErrors {
+		raw = append(raw, e)
+	}
+	config, err := ExpandProviderRetryConfig([]interface{}{
+		map[string]interface{}{"retryable_error": raw},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	config.compileRetryableErrors()
+	return config
+}
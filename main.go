@@ -14,6 +14,7 @@ import (
 
 	"github.com/hashicorp/terraform-provider-google/google/fwprovider"
 	"github.com/hashicorp/terraform-provider-google/google/provider"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
 )
 
 func main() {
@@ -26,7 +27,7 @@ func main() {
 	primary := provider.Provider()
 
 	providers := []func() tfprotov5.ProviderServer{
-		primary.GRPCProvider, // sdk provider
+		provider.GRPCProviderServer(primary),                 // sdk provider
 		providerserver.NewProtocol5(fwprovider.New(primary)), // framework provider
 	}
 
@@ -48,6 +49,10 @@ func main() {
 		serveOpts...,
 	)
 
+	// Terraform stops the provider once it has the results of all operations,
+	// so spans that haven't been exported yet are flushed here.
+	transport_tpg.ShutdownTracing()
+
 	if err != nil {
 		log.Fatal(err)
 	}
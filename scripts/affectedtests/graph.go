@@ -0,0 +1,534 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"log"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+const modulePath = "github.com/hashicorp/terraform-provider-google"
+
+// registryPackages are the packages registering the resources of the provider.
+// References from their registrations don't make every test depend on every
+// resource: resources are instead matched to tests by their type names.
+var registryPackages = map[string]bool{
+	modulePath + "/google/provider":   true,
+	modulePath + "/google/fwprovider": true,
+}
+
+// frameworkRegistrations are the methods of the framework provider returning
+// the constructors of its resources, data sources, ephemeral resources and
+// functions.
+var frameworkRegistrations = map[string]bool{
+	"Resources":          true,
+	"DataSources":        true,
+	"EphemeralResources": true,
+	"Functions":          true,
+}
+
+var (
+	// configNameRe matches the type names of the blocks of test configs, and
+	// the provider functions they call.
+	configNameRe  = regexp.MustCompile(`(?:resource|data|ephemeral)\s+"(google_\w+)"|(provider::google::\w+)`)
+	fixtureRe     = regexp.MustCompile(`test-fixtures/[\w./-]+`)
+	loadBatchSize = 8
+)
+
+// graph is the graph of the references between the package-level
+// declarations of the provider, including its tests. Nodes are package-level
+// functions, variables, constants and types, and methods, keyed by e.g.
+// github.com/hashicorp/terraform-provider-google/google/tpgresource.SetLabels
+// or .../google/transport.Config.NewComputeClient.
+type graph struct {
+	ids  map[string]int
+	keys []string
+	// users are the nodes referencing each node.
+	users [][]int
+	// uses are the nodes referenced by each node.
+	uses  [][]int
+	tests map[int]bool
+
+	// names are the type names of the resources registered by each node,
+	// e.g. google_compute_instance for compute.ResourceComputeInstance.
+	names map[int][]string
+	// configs are the nodes whose test configs use each type name.
+	configs map[string][]int
+	// fixtures are the nodes using each file of test-fixtures, by path
+	// relative to the repository.
+	fixtures map[string][]int
+
+	// decls are the declarations and registrations of each file, relative
+	// to the repository.
+	decls map[string][]decl
+	// frameworkCtors are the constructors registered with the framework
+	// provider, and whether they are of functions.
+	frameworkCtors map[int]bool
+	// methods are the methods of each type.
+	methods map[int][]int
+	// receivers are the types of each method. Methods called through
+	// interfaces have no users, so they affect the users of their type.
+	receivers map[int][]int
+	// nameFields are the values of the name fields set by each function,
+	// for the names of provider functions.
+	nameFields map[int][]string
+
+	root string
+}
+
+// decl is the range of lines of a declaration, or of a registration in
+// registryPackages. Changes to the lines of a registration only affect the
+// registered resources, by name or by constructor.
+type decl struct {
+	start, end int
+	nodes      []int
+	names      []string
+	ctors      []int
+}
+
+func newGraph(root string) *graph {
+	return &graph{
+		ids:            map[string]int{},
+		tests:          map[int]bool{},
+		names:          map[int][]string{},
+		configs:        map[string][]int{},
+		fixtures:       map[string][]int{},
+		decls:          map[string][]decl{},
+		frameworkCtors: map[int]bool{},
+		nameFields:     map[int][]string{},
+		methods:        map[int][]int{},
+		receivers:      map[int][]int{},
+		root:           root,
+	}
+}
+
+func (g *graph) node(key string) int {
+	if id, ok := g.ids[key]; ok {
+		return id
+	}
+	id := len(g.keys)
+	g.ids[key] = id
+	g.keys = append(g.keys, key)
+	g.users = append(g.users, nil)
+	g.uses = append(g.uses, nil)
+	return id
+}
+
+func (g *graph) addUse(user, used int) {
+	if user == used {
+		return
+	}
+	g.users[used] = append(g.users[used], user)
+	g.uses[user] = append(g.uses[user], used)
+}
+
+// loadGraph type checks the packages of the repository at root with their
+// tests, in batches to bound memory, and returns the graph of their
+// references.
+func loadGraph(root string) (*graph, error) {
+	cfg := &packages.Config{Mode: packages.NeedName, Dir: root}
+	pkgs, err := packages.Load(cfg, "./...")
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, p := range pkgs {
+		paths = append(paths, p.PkgPath)
+	}
+	sort.Strings(paths)
+
+	g := newGraph(root)
+	seen := map[string]bool{}
+	for i := 0; i < len(paths); i += loadBatchSize {
+		batch := paths[i:min(i+loadBatchSize, len(paths))]
+		log.Printf("Loading %s", strings.Join(batch, ", "))
+		cfg := &packages.Config{
+			Mode:  packages.NeedName | packages.NeedFiles | packages.NeedCompiledGoFiles | packages.NeedImports | packages.NeedTypes | packages.NeedSyntax | packages.NeedTypesInfo | packages.NeedExportFile,
+			Dir:   root,
+			Tests: true,
+		}
+		pkgs, err := packages.Load(cfg, batch...)
+		if err != nil {
+			return nil, err
+		}
+		// Test variants, e.g. "p [p.test]", include the files of the
+		// package, so they are read first and files are only read once.
+		sort.SliceStable(pkgs, func(i, j int) bool {
+			return strings.Contains(pkgs[i].ID, " [") && !strings.Contains(pkgs[j].ID, " [")
+		})
+		for _, p := range pkgs {
+			if strings.HasSuffix(p.PkgPath, ".test") {
+				continue
+			}
+			for _, e := range p.Errors {
+				return nil, fmt.Errorf("loading %s: %v", p.ID, e)
+			}
+			for i, f := range p.Syntax {
+				name := p.CompiledGoFiles[i]
+				if seen[name] {
+					continue
+				}
+				seen[name] = true
+				g.addFile(p, name, f)
+			}
+		}
+	}
+	g.resolveFrameworkNames()
+	return g, nil
+}
+
+// objectKey returns the key of the node of a package-level object or a method
+// of the provider, or "" for other objects.
+func objectKey(obj types.Object) string {
+	if obj == nil || obj.Pkg() == nil || !strings.HasPrefix(obj.Pkg().Path(), modulePath) {
+		return ""
+	}
+	if f, ok := obj.(*types.Func); ok {
+		if recv := f.Type().(*types.Signature).Recv(); recv != nil {
+			t := recv.Type()
+			if p, ok := t.(*types.Pointer); ok {
+				t = p.Elem()
+			}
+			named, ok := t.(*types.Named)
+			if !ok {
+				return ""
+			}
+			return obj.Pkg().Path() + "." + named.Obj().Name() + "." + obj.Name()
+		}
+	}
+	if obj.Parent() != obj.Pkg().Scope() {
+		return ""
+	}
+	return obj.Pkg().Path() + "." + obj.Name()
+}
+
+// usedNodes returns the nodes referenced in n.
+func (g *graph) usedNodes(info *types.Info, n ast.Node) []int {
+	var used []int
+	ast.Inspect(n, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok {
+			if key := objectKey(info.Uses[id]); key != "" {
+				used = append(used, g.node(key))
+			}
+		}
+		return true
+	})
+	return used
+}
+
+func (g *graph) addFile(p *packages.Package, filename string, f *ast.File) {
+	rel, err := filepath.Rel(g.root, filename)
+	if err != nil {
+		rel = filename
+	}
+	rel = filepath.ToSlash(rel)
+	isTest := strings.HasSuffix(filename, "_test.go")
+	registry := registryPackages[p.PkgPath] && !isTest
+
+	for _, d := range f.Decls {
+		var nodes []int
+		switch d := d.(type) {
+		case *ast.FuncDecl:
+			key := objectKey(p.TypesInfo.Defs[d.Name])
+			if key == "" {
+				continue
+			}
+			id := g.node(key)
+			nodes = append(nodes, id)
+			if d.Recv == nil && isTest && strings.HasPrefix(d.Name.Name, "Test") {
+				g.tests[id] = true
+			}
+			if d.Recv != nil {
+				for _, t := range g.usedNodes(p.TypesInfo, d.Recv) {
+					g.methods[t] = append(g.methods[t], id)
+					g.receivers[id] = append(g.receivers[id], t)
+					if d.Name.Name == "Metadata" {
+						g.names[t] = append(g.names[t], metadataNames(d)...)
+					}
+				}
+			}
+			if names := nameFields(d); len(names) > 0 {
+				g.nameFields[id] = names
+			}
+			if registry && d.Recv != nil && frameworkRegistrations[d.Name.Name] {
+				g.addFrameworkRegistrations(p, rel, d)
+				break
+			}
+			for _, used := range g.usedNodes(p.TypesInfo, d) {
+				g.addUse(id, used)
+			}
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				var specNodes []int
+				switch spec := spec.(type) {
+				case *ast.ValueSpec:
+					for _, name := range spec.Names {
+						if key := objectKey(p.TypesInfo.Defs[name]); key != "" {
+							specNodes = append(specNodes, g.node(key))
+						}
+					}
+				case *ast.TypeSpec:
+					if key := objectKey(p.TypesInfo.Defs[spec.Name]); key != "" {
+						specNodes = append(specNodes, g.node(key))
+					}
+				}
+				var used []int
+				if vs, ok := spec.(*ast.ValueSpec); ok && registry {
+					used = g.addRegistrations(p, rel, vs)
+				} else {
+					used = g.usedNodes(p.TypesInfo, spec)
+				}
+				for _, id := range specNodes {
+					for _, u := range used {
+						g.addUse(id, u)
+					}
+				}
+				nodes = append(nodes, specNodes...)
+			}
+		}
+		if len(nodes) == 0 {
+			continue
+		}
+		g.addLiterals(rel, d, nodes)
+		g.decls[rel] = append(g.decls[rel], decl{
+			start: p.Fset.Position(d.Pos()).Line,
+			end:   p.Fset.Position(d.End()).Line,
+			nodes: nodes,
+		})
+	}
+}
+
+// addLiterals records the type names and test fixtures used by the string
+// literals of a declaration.
+func (g *graph) addLiterals(file string, d ast.Decl, nodes []int) {
+	ast.Inspect(d, func(n ast.Node) bool {
+		lit, ok := n.(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			return true
+		}
+		for _, m := range configNameRe.FindAllStringSubmatch(lit.Value, -1) {
+			name := m[1] + m[2]
+			g.configs[name] = append(g.configs[name], nodes...)
+		}
+		for _, m := range fixtureRe.FindAllString(lit.Value, -1) {
+			path := filepath.ToSlash(filepath.Join(filepath.Dir(file), m))
+			g.fixtures[path] = append(g.fixtures[path], nodes...)
+		}
+		return true
+	})
+}
+
+// addRegistrations records the entries of a map of resources keyed by type
+// name, e.g. "google_compute_instance": compute.ResourceComputeInstance(), and
+// returns the nodes referenced by the rest of the declaration.
+func (g *graph) addRegistrations(p *packages.Package, file string, vs *ast.ValueSpec) []int {
+	var used []int
+	if vs.Type != nil {
+		used = append(used, g.usedNodes(p.TypesInfo, vs.Type)...)
+	}
+	for _, v := range vs.Values {
+		lit, ok := v.(*ast.CompositeLit)
+		if !ok {
+			used = append(used, g.usedNodes(p.TypesInfo, v)...)
+			continue
+		}
+		for _, elt := range lit.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			key, isString := kv.Key.(*ast.BasicLit)
+			if !ok || !isString || key.Kind != token.STRING || !strings.HasPrefix(key.Value, `"google_`) {
+				used = append(used, g.usedNodes(p.TypesInfo, elt)...)
+				continue
+			}
+			name := strings.Trim(key.Value, `"`)
+			for _, id := range g.usedNodes(p.TypesInfo, kv.Value) {
+				g.names[id] = append(g.names[id], name)
+			}
+			g.decls[file] = append(g.decls[file], decl{
+				start: p.Fset.Position(kv.Pos()).Line,
+				end:   p.Fset.Position(kv.End()).Line,
+				names: []string{name},
+			})
+		}
+	}
+	return used
+}
+
+// addFrameworkRegistrations records the constructors returned by a method of
+// frameworkRegistrations.
+func (g *graph) addFrameworkRegistrations(p *packages.Package, file string, fn *ast.FuncDecl) {
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		lit, ok := n.(*ast.CompositeLit)
+		if !ok {
+			return true
+		}
+		for _, elt := range lit.Elts {
+			ctors := g.usedNodes(p.TypesInfo, elt)
+			for _, id := range ctors {
+				g.frameworkCtors[id] = fn.Name.Name == "Functions"
+			}
+			g.decls[file] = append(g.decls[file], decl{
+				start: p.Fset.Position(elt.Pos()).Line,
+				end:   p.Fset.Position(elt.End()).Line,
+				ctors: ctors,
+			})
+		}
+		return false
+	})
+}
+
+// metadataNames returns the type names set by the Metadata method of a
+// framework resource, e.g. google_client_config for
+// resp.TypeName = req.ProviderTypeName + "_client_config".
+func metadataNames(fn *ast.FuncDecl) []string {
+	var names []string
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		b, ok := n.(*ast.BinaryExpr)
+		if !ok || b.Op != token.ADD {
+			return true
+		}
+		if lit, ok := b.Y.(*ast.BasicLit); ok && lit.Kind == token.STRING && strings.HasPrefix(lit.Value, `"_`) {
+			names = append(names, "google"+strings.Trim(lit.Value, `"`))
+		}
+		return true
+	})
+	return names
+}
+
+// nameFields returns the values of the name fields set by a function, e.g.
+// location_from_id for &LocationFromIdFunction{name: "location_from_id"}.
+func nameFields(fn *ast.FuncDecl) []string {
+	var names []string
+	ast.Inspect(fn, func(n ast.Node) bool {
+		kv, ok := n.(*ast.KeyValueExpr)
+		if !ok {
+			return true
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		lit, isString := kv.Value.(*ast.BasicLit)
+		if ok && isString && key.Name == "name" && lit.Kind == token.STRING {
+			names = append(names, strings.Trim(lit.Value, `"`))
+		}
+		return true
+	})
+	return names
+}
+
+// resolveFrameworkNames sets the type names of the framework resources to
+// the methods of their types, which are called through interfaces, and to the
+// constructors registered with the framework provider. Provider functions are
+// named by the name fields set by their constructors.
+func (g *graph) resolveFrameworkNames() {
+	for ctor, isFunction := range g.frameworkCtors {
+		if !isFunction {
+			continue
+		}
+		for _, name := range g.nameFields[ctor] {
+			g.names[ctor] = append(g.names[ctor], "provider::google::"+name)
+			for _, t := range g.uses[ctor] {
+				g.names[t] = append(g.names[t], "provider::google::"+name)
+			}
+		}
+	}
+	typeNames := make(map[int][]string)
+	for t, names := range g.names {
+		if len(g.methods[t]) > 0 {
+			typeNames[t] = names
+		}
+	}
+	for t, names := range typeNames {
+		for _, m := range g.methods[t] {
+			g.names[m] = append(g.names[m], names...)
+		}
+	}
+	for ctor, isFunction := range g.frameworkCtors {
+		if isFunction {
+			continue
+		}
+		for _, t := range g.uses[ctor] {
+			g.names[ctor] = append(g.names[ctor], typeNames[t]...)
+		}
+	}
+}
+
+// affectedTests returns the keys of the tests affected by the changed lines of
+// each file, relative to the repository. A test is affected if it references,
+// directly or not, a changed declaration, or if its configs use a resource
+// whose implementation references one. A changed method affects the users of
+// its type, as it may be called through an interface the type implements.
+func (g *graph) affectedTests(changes map[string][]int) []string {
+	visited := map[int]bool{}
+	visitedNames := map[string]bool{}
+	var queue []int
+	visit := func(id int) {
+		if !visited[id] {
+			visited[id] = true
+			queue = append(queue, id)
+		}
+	}
+	visitName := func(name string) {
+		if visitedNames[name] {
+			return
+		}
+		visitedNames[name] = true
+		log.Printf("Resource %s is affected", name)
+		for _, id := range g.configs[name] {
+			visit(id)
+		}
+	}
+
+	for file, lines := range changes {
+		if strings.Contains(file, "/test-fixtures/") {
+			for _, id := range g.fixtures[file] {
+				visit(id)
+			}
+			continue
+		}
+		for _, d := range g.decls[file] {
+			if !overlaps(d, lines) {
+				continue
+			}
+			for _, id := range append(d.nodes, d.ctors...) {
+				visit(id)
+			}
+			for _, name := range d.names {
+				visitName(name)
+			}
+		}
+	}
+
+	var tests []string
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if g.tests[id] {
+			tests = append(tests, g.keys[id])
+		}
+		for _, name := range g.names[id] {
+			visitName(name)
+		}
+		for _, user := range g.users[id] {
+			visit(user)
+		}
+		for _, t := range g.receivers[id] {
+			visit(t)
+		}
+	}
+	sort.Strings(tests)
+	return tests
+}
+
+func overlaps(d decl, lines []int) bool {
+	for _, l := range lines {
+		if d.start <= l && l <= d.end {
+			return true
+		}
+	}
+	return false
+}
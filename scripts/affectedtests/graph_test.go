@@ -0,0 +1,197 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"golang.org/x/tools/go/packages"
+)
+
+type testPackage struct {
+	path  string
+	files map[string]string
+}
+
+// testGraph returns the graph of the given packages, type checked in order,
+// as loadGraph would for a repository at /repo.
+func testGraph(t *testing.T, pkgs []testPackage) *graph {
+	root := "/repo"
+	g := newGraph(root)
+	fset := token.NewFileSet()
+	checked := map[string]*types.Package{}
+	importer := importerFunc(func(path string) (*types.Package, error) {
+		return checked[path], nil
+	})
+	for _, tp := range pkgs {
+		p := &packages.Package{
+			PkgPath: tp.path,
+			Fset:    fset,
+			TypesInfo: &types.Info{
+				Defs: map[*ast.Ident]types.Object{},
+				Uses: map[*ast.Ident]types.Object{},
+			},
+		}
+		dir := filepath.Join(root, tp.path[len(modulePath)+1:])
+		for _, name := range sortedFileNames(tp.files) {
+			filename := filepath.Join(dir, name)
+			f, err := parser.ParseFile(fset, filename, tp.files[name], 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			p.Syntax = append(p.Syntax, f)
+			p.CompiledGoFiles = append(p.CompiledGoFiles, filename)
+		}
+		conf := types.Config{Importer: importer}
+		typesPkg, err := conf.Check(tp.path, fset, p.Syntax, p.TypesInfo)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checked[tp.path] = typesPkg
+		for i, f := range p.Syntax {
+			g.addFile(p, p.CompiledGoFiles[i], f)
+		}
+	}
+	g.resolveFrameworkNames()
+	return g
+}
+
+type importerFunc func(path string) (*types.Package, error)
+
+func (f importerFunc) Import(path string) (*types.Package, error) {
+	return f(path)
+}
+
+// sortedFileNames returns the names of the files of a package in order, so
+// that e.g. thing.go is read before thing_test.go as with packages.Load.
+func sortedFileNames(files map[string]string) []string {
+	var names []string
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestGraph_affectedTests(t *testing.T) {
+	g := testGraph(t, []testPackage{
+		{
+			path: modulePath + "/google/services/thing",
+			files: map[string]string{
+				"thing.go": `package thing
+
+func helper() string {
+	return "thing"
+}
+
+func ResourceThing() int {
+	return len(helper())
+}
+
+func unused() {}
+`,
+				"thing_test.go": `package thing
+
+func TestHelper(t interface{}) {
+	_ = helper()
+}
+
+func TestAccThing_basic(t interface{}) {
+	_ = testAccThingConfig()
+}
+
+func testAccThingConfig() string {
+	return ` + "`" + `
+resource "google_thing" "default" {
+  source = "test-fixtures/thing.json"
+}
+` + "`" + `
+}
+`,
+				"waiter.go": `package thing
+
+type waiter interface {
+	IsDone() bool
+}
+
+type operationWaiter struct{}
+
+func (w *operationWaiter) IsDone() bool {
+	return true
+}
+
+func wait(w waiter) bool {
+	return w.IsDone()
+}
+
+func operationDone() bool {
+	return wait(&operationWaiter{})
+}
+`,
+				"waiter_test.go": `package thing
+
+func TestOperationDone(t interface{}) {
+	_ = operationDone()
+}
+`,
+			},
+		},
+		{
+			path: modulePath + "/google/provider",
+			files: map[string]string{
+				"provider.go": `package provider
+
+import "github.com/hashicorp/terraform-provider-google/google/services/thing"
+
+var resources = map[string]int{
+	"google_thing": thing.ResourceThing(),
+}
+`,
+			},
+		},
+	})
+
+	cases := map[string]struct {
+		changes  map[string][]int
+		expected []string
+	}{
+		"helper changed": {
+			changes: map[string][]int{"google/services/thing/thing.go": {4}},
+			expected: []string{
+				modulePath + "/google/services/thing.TestAccThing_basic",
+				modulePath + "/google/services/thing.TestHelper",
+			},
+		},
+		"resource changed": {
+			changes:  map[string][]int{"google/services/thing/thing.go": {8}},
+			expected: []string{modulePath + "/google/services/thing.TestAccThing_basic"},
+		},
+		"registration changed": {
+			changes:  map[string][]int{"google/provider/provider.go": {6}},
+			expected: []string{modulePath + "/google/services/thing.TestAccThing_basic"},
+		},
+		"test fixture changed": {
+			changes:  map[string][]int{"google/services/thing/test-fixtures/thing.json": {1}},
+			expected: []string{modulePath + "/google/services/thing.TestAccThing_basic"},
+		},
+		"method called through an interface changed": {
+			changes:  map[string][]int{"google/services/thing/waiter.go": {10}},
+			expected: []string{modulePath + "/google/services/thing.TestOperationDone"},
+		},
+		"unused function changed": {
+			changes: map[string][]int{"google/services/thing/thing.go": {11}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := g.affectedTests(tc.changes); !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("Expected affected tests %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
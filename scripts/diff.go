@@ -1,46 +1,118 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
+	"os"
 	"reflect"
 	"runtime"
 	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	googleOldFw "github.com/hashicorp/terraform-provider-clean-google/google/fwprovider"
 	googleOld "github.com/hashicorp/terraform-provider-clean-google/google/provider"
 	// "github.com/hashicorp/terraform-provider-google/google/provider" will be replaced with corresponding package based on the version when generating the provider package
+	googleFw "github.com/hashicorp/terraform-provider-google/google/fwprovider"
 	google "github.com/hashicorp/terraform-provider-google/google/provider"
+	"github.com/hashicorp/terraform-provider-google/google/schemacompat"
 )
 
 var verbose bool
 var vFlag = flag.Bool("verbose", false, "set to true to produce more verbose diffs")
-var resourceFlag = flag.String("resource", "", "the name of the terraform resource to diff")
+var resourceFlag = flag.String("resource", "", "the name of the terraform resource to diff. If not set, all resources, data sources and ephemeral resources are checked for breaking changes")
+var formatFlag = flag.String("format", "markdown", "the format of the breaking changes report, json or markdown")
+var oldImportFormatsFlag = flag.String("old-import-formats", "", "JSON file of the import formats of the old provider, from scripts/importformats -json")
+var newImportFormatsFlag = flag.String("new-import-formats", "", "JSON file of the import formats of the new provider, from scripts/importformats -json")
 
 func main() {
 	flag.Parse()
-	if resourceFlag == nil || *resourceFlag == "" {
-		fmt.Print("resource flag not specified\n")
-		panic("the resource to diff must be specified")
+	verbose = *vFlag
+	if *resourceFlag == "" {
+		breaking, err := checkBreakingChanges()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+		if breaking {
+			os.Exit(1)
+		}
+		return
 	}
+
 	resourceName := *resourceFlag
-	verbose = *vFlag
 	m := google.ResourceMap()
 	res, ok := m[resourceName]
 	if !ok {
-		panic(fmt.Sprintf("Unable to find resource in TPGB: %s", resourceName))
+		fmt.Fprintf(os.Stderr, "Unable to find resource in TPGB: %s\n", resourceName)
+		os.Exit(2)
 	}
 	m2 := googleOld.ResourceMap()
 	res2, ok := m2[resourceName]
 	if !ok {
-		panic(fmt.Sprintf("Unable to find resource in clean TPGB: %s", resourceName))
+		fmt.Fprintf(os.Stderr, "Unable to find resource in clean TPGB: %s\n", resourceName)
+		os.Exit(2)
 	}
 	fmt.Printf("------------Diffing resource %s------------\n", resourceName)
 	diffSchema(res2.Schema, res.Schema, []string{})
 	fmt.Print("------------Done------------\n")
 }
 
+// checkBreakingChanges writes the report of the changes to the schemas of the
+// SDK and framework providers, and returns whether any of them is breaking.
+// Import formats are only compared if the files of both versions are given.
+func checkBreakingChanges() (bool, error) {
+	if *formatFlag != "json" && *formatFlag != "markdown" {
+		return false, fmt.Errorf("unknown format %q, expected json or markdown", *formatFlag)
+	}
+	ctx := context.Background()
+	old := schemacompat.NewSnapshot()
+	oldPrimary := googleOld.Provider()
+	old.AddSdkProvider(oldPrimary)
+	if err := old.AddFrameworkProvider(ctx, googleOldFw.New(oldPrimary)); err != nil {
+		return false, fmt.Errorf("reading the schemas of clean TPGB: %w", err)
+	}
+	new := schemacompat.NewSnapshot()
+	newPrimary := google.Provider()
+	new.AddSdkProvider(newPrimary)
+	if err := new.AddFrameworkProvider(ctx, googleFw.New(newPrimary)); err != nil {
+		return false, fmt.Errorf("reading the schemas of TPGB: %w", err)
+	}
+	if *oldImportFormatsFlag != "" && *newImportFormatsFlag != "" {
+		var err error
+		if old.ImportFormats, err = readImportFormats(*oldImportFormatsFlag); err != nil {
+			return false, err
+		}
+		if new.ImportFormats, err = readImportFormats(*newImportFormatsFlag); err != nil {
+			return false, err
+		}
+	}
+
+	report := schemacompat.NewReport(schemacompat.Compare(old, new))
+	write := report.WriteMarkdown
+	if *formatFlag == "json" {
+		write = report.WriteJSON
+	}
+	if err := write(os.Stdout); err != nil {
+		return false, err
+	}
+	return report.Breaking, nil
+}
+
+func readImportFormats(path string) (map[string][]string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var formats map[string][]string
+	if err := json.Unmarshal(b, &formats); err != nil {
+		return nil, fmt.Errorf("reading import formats from %s: %w", path, err)
+	}
+	return formats, nil
+}
+
 // Diffs a Terraform resource schema. Calls itself recursively as some fields
 // are implemented using schema.Resource as their element type
 func diffSchema(old, new map[string]*schema.Schema, path []string) {
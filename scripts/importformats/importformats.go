@@ -0,0 +1,291 @@
+// importformats generates the list of import id formats of each resource of the
+// provider, as given to tpgresource.ParseImportId by the resource's importer.
+// The provider derives the identity schemas of resources from these formats.
+//
+// Example usage: make importformats, or from the scripts directory: go run ./importformats -root ..
+//
+// With -json, the formats are written as a JSON object instead, e.g. for
+// scripts/diff.go to compare the import formats of two versions of the provider:
+// go run ./importformats -root .. -json formats.json
+//
+// Only resources whose importer calls tpgresource.ParseImportId directly with a
+// literal list of formats are included.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const modulePath = "github.com/hashicorp/terraform-provider-google"
+
+// resourceMapFiles are the files of the provider package defining the maps of
+// resources, relative to the provider package.
+var resourceMapFiles = []string{
+	"provider_mmv1_resources.go",
+	"provider_dcl_resources.go",
+}
+
+// resourceMaps are the names of the maps of resources in resourceMapFiles.
+// IAM resources are left out as their importers don't use ParseImportId.
+var resourceMaps = map[string]bool{
+	"generatedResources":   true,
+	"handwrittenResources": true,
+	"dclResources":         true,
+}
+
+func main() {
+	root := flag.String("root", ".", "path to the root of the provider repository")
+	out := flag.String("out", "google/provider/provider_import_formats.go", "file to write, relative to -root")
+	jsonOut := flag.String("json", "", "if set, the JSON file to write the formats to, instead of -out")
+	flag.Parse()
+
+	resources, err := readResourceMaps(filepath.Join(*root, "google", "provider"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	packages := make(map[string]map[string]*ast.FuncDecl)
+	formats := make(map[string][]string)
+	for name, ref := range resources {
+		funcs, ok := packages[ref.importPath]
+		if !ok {
+			dir := filepath.Join(*root, filepath.FromSlash(strings.TrimPrefix(ref.importPath, modulePath+"/")))
+			funcs, err = readFuncs(dir)
+			if err != nil {
+				log.Fatal(err)
+			}
+			packages[ref.importPath] = funcs
+		}
+		if f := importFormats(funcs, ref.funcName); len(f) > 0 {
+			formats[name] = f
+		}
+	}
+
+	path := filepath.Join(*root, *out)
+	src, err := render(formats)
+	if *jsonOut != "" {
+		path = *jsonOut
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(formats)
+		src = buf.Bytes()
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(path, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("Wrote the import formats of %d of %d resources to %s\n", len(formats), len(resources), path)
+}
+
+// resourceRef is the function returning the schema of a resource.
+type resourceRef struct {
+	importPath string
+	funcName   string
+}
+
+// readResourceMaps returns the resource functions of the entries of
+// resourceMaps that are plain calls to a function of a service package, e.g.
+// "google_compute_instance": compute.ResourceComputeInstance().
+func readResourceMaps(dir string) (map[string]resourceRef, error) {
+	resources := make(map[string]resourceRef)
+	for _, file := range resourceMapFiles {
+		f, err := parser.ParseFile(token.NewFileSet(), filepath.Join(dir, file), nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		imports := make(map[string]string)
+		for _, imp := range f.Imports {
+			path, _ := strconv.Unquote(imp.Path.Value)
+			name := filepath.Base(path)
+			if imp.Name != nil {
+				name = imp.Name.Name
+			}
+			imports[name] = path
+		}
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.VAR {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				vs := spec.(*ast.ValueSpec)
+				if len(vs.Names) != 1 || !resourceMaps[vs.Names[0].Name] || len(vs.Values) != 1 {
+					continue
+				}
+				lit, ok := vs.Values[0].(*ast.CompositeLit)
+				if !ok {
+					continue
+				}
+				for _, elt := range lit.Elts {
+					kv, ok := elt.(*ast.KeyValueExpr)
+					if !ok {
+						continue
+					}
+					key, ok := kv.Key.(*ast.BasicLit)
+					if !ok {
+						continue
+					}
+					name, _ := strconv.Unquote(key.Value)
+					call, ok := kv.Value.(*ast.CallExpr)
+					if !ok || len(call.Args) != 0 {
+						continue
+					}
+					sel, ok := call.Fun.(*ast.SelectorExpr)
+					if !ok {
+						continue
+					}
+					pkg, ok := sel.X.(*ast.Ident)
+					if !ok || imports[pkg.Name] == "" {
+						continue
+					}
+					resources[name] = resourceRef{importPath: imports[pkg.Name], funcName: sel.Sel.Name}
+				}
+			}
+		}
+	}
+	return resources, nil
+}
+
+// readFuncs returns the top-level functions of the non-test files of the
+// package in dir.
+func readFuncs(dir string) (map[string]*ast.FuncDecl, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+	funcs := make(map[string]*ast.FuncDecl)
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Files {
+			for _, decl := range f.Decls {
+				if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil {
+					funcs[fn.Name.Name] = fn
+				}
+			}
+		}
+	}
+	return funcs, nil
+}
+
+// importFormats returns the formats given to ParseImportId by the importer of
+// the resource returned by the function resourceFunc.
+func importFormats(funcs map[string]*ast.FuncDecl, resourceFunc string) []string {
+	fn, ok := funcs[resourceFunc]
+	if !ok || fn.Body == nil {
+		return nil
+	}
+	var importer string
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		kv, ok := n.(*ast.KeyValueExpr)
+		if !ok || importer != "" {
+			return importer == ""
+		}
+		if key, ok := kv.Key.(*ast.Ident); !ok || key.Name != "Importer" {
+			return true
+		}
+		ast.Inspect(kv.Value, func(n ast.Node) bool {
+			field, ok := n.(*ast.KeyValueExpr)
+			if !ok {
+				return true
+			}
+			key, ok := field.Key.(*ast.Ident)
+			if !ok || (key.Name != "State" && key.Name != "StateContext") {
+				return true
+			}
+			if value, ok := field.Value.(*ast.Ident); ok {
+				importer = value.Name
+			}
+			return false
+		})
+		return false
+	})
+	fn, ok = funcs[importer]
+	if !ok || fn.Body == nil {
+		return nil
+	}
+
+	var formats []string
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		if formats != nil {
+			return false
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) == 0 {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "ParseImportId" {
+			return true
+		}
+		lit, ok := call.Args[0].(*ast.CompositeLit)
+		if !ok {
+			return true
+		}
+		var f []string
+		for _, elt := range lit.Elts {
+			s, ok := elt.(*ast.BasicLit)
+			if !ok || s.Kind != token.STRING {
+				return false
+			}
+			v, err := strconv.Unquote(s.Value)
+			if err != nil {
+				return false
+			}
+			f = append(f, v)
+		}
+		formats = f
+		return false
+	})
+	return formats
+}
+
+func render(formats map[string][]string) ([]byte, error) {
+	names := make([]string, 0, len(formats))
+	for name := range formats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	buf.WriteString(`// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by scripts/importformats (make importformats). DO NOT EDIT.
+
+package provider
+
+// importFormats are the import id formats of resources, as given to
+// tpgresource.ParseImportId by their importers. The first format is the one
+// their identity schema is derived from.
+var importFormats = map[string][]string{
+`)
+	for _, name := range names {
+		fmt.Fprintf(&buf, "%q: {\n", name)
+		for _, f := range formats[name] {
+			fmt.Fprintf(&buf, "%s,\n", strconv.Quote(f))
+		}
+		buf.WriteString("},\n")
+	}
+	buf.WriteString("}\n")
+	return format.Source(buf.Bytes())
+}
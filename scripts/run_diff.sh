@@ -1,10 +1,10 @@
 #!/bin/bash
 set -e
 set -x
-if [ -z "$1" ]; then
-  echo "Must provide 1 argument - name of resource to diff, e.g. 'google_compute_forwarding_rule'"
-  exit 1
-fi
+# With a resource name, e.g. 'google_compute_forwarding_rule', prints the diff of
+# its schema. Without one, reports the breaking changes to every schema, and exits
+# with status 1 if there are any. Extra arguments are passed to scripts/diff.go,
+# e.g. -format json.
 
 function cleanup() {
   go mod edit -dropreplace=github.com/hashicorp/terraform-provider-clean-google
@@ -27,4 +27,15 @@ fi
 
 go mod edit -require=github.com/hashicorp/terraform-provider-clean-google@v0.0.0
 go mod edit -replace github.com/hashicorp/terraform-provider-clean-google=$(realpath ~/go/src/github.com/hashicorp/terraform-provider-clean-google)
-go run scripts/diff.go --resource $1 --verbose
+if [[ -n "$1" && "$1" != -* ]]; then
+  go run scripts/diff.go --resource $1 --verbose
+  exit
+fi
+
+CLEAN=$(realpath ~/go/src/github.com/hashicorp/terraform-provider-clean-google)
+FORMATS=$(mktemp -d)
+pushd scripts
+go run ./importformats -root $CLEAN -json $FORMATS/old.json
+go run ./importformats -root .. -json $FORMATS/new.json
+popd
+go run scripts/diff.go --old-import-formats $FORMATS/old.json --new-import-formats $FORMATS/new.json "$@"